@@ -0,0 +1,108 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/render-oss/cli/pkg/auditlog"
+	"github.com/render-oss/cli/pkg/client"
+	"github.com/render-oss/cli/pkg/command"
+	"github.com/render-oss/cli/pkg/dependencies"
+	"github.com/render-oss/cli/pkg/text"
+	auditlogtypes "github.com/render-oss/cli/pkg/types/auditlog"
+)
+
+func newAuditLogsCmd(deps *dependencies.Dependencies) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "audit-logs",
+		Short:        "View workspace audit logs",
+		Args:         cobra.NoArgs,
+		SilenceUsage: true,
+		GroupID:      GroupCore.ID,
+		Long: `View audit log entries for the active workspace, newest first.
+
+Use --start and --end to choose a time range. Times are either relative (30m,
+12h, 7d) or RFC3339 timestamps. Filter by who acted with --actor (an email or
+user ID) and by what happened with --event. Both flags can be repeated, and an
+entry matches if it matches any of the given values.
+
+By default, the newest 100 matching entries are shown. Pass --limit 0 to fetch
+every entry in the time range.
+
+Pass --organization to view an organization's audit log instead of the
+workspace's.
+
+Pass --export jsonl or --export csv to write entries to stdout for archiving or
+analysis. JSONL writes one API object per line. CSV writes a header row and
+encodes each entry's metadata as a JSON object.
+
+This command only runs non-interactively. If --output interactive is requested,
+it falls back to text output.`,
+		Example: `  # Show recent audit log entries
+  render audit-logs
+
+  # Show env var changes made by one user in the last week
+  render audit-logs --start 7d --actor alice@example.com --event UpdateEnvVarsEvent
+
+  # Export a month of entries as CSV
+  render audit-logs --start 30d --limit 0 --export csv > audit.csv
+
+  # Export an organization's failed actions as JSON lines
+  render audit-logs --organization org-abc123 --status error --export jsonl`,
+	}
+
+	cmd.Flags().Var(command.NewTimeInput(), "start", "Start of the time range")
+	cmd.Flags().Var(command.NewTimeInput(), "end", "End of the time range (default now)")
+	cmd.Flags().StringSlice("actor", nil, "Only show entries by this actor email or ID (repeatable)")
+	cmd.Flags().StringSlice("event", nil, "Only show entries of this event type (repeatable)")
+	cmd.Flags().Var(command.NewEnumInput([]string{string(client.AuditLogStatusSuccess), string(client.AuditLogStatusError)}, false), "status", "Only show entries with this status")
+	cmd.Flags().String("organization", "", "Organization ID to view instead of the active workspace")
+	cmd.Flags().Int("limit", auditlogtypes.DefaultLimit, "Maximum number of entries to show (0 for no limit)")
+	cmd.Flags().Var(command.NewEnumInput(auditlog.ExportFormats(), false), "export", "Write entries to stdout as jsonl or csv")
+	setAllFlagPlaceholders(cmd, map[string]string{
+		"start":        "TIME",
+		"end":          "TIME",
+		"actor":        "ACTOR",
+		"event":        "EVENT",
+		"status":       "STATUS",
+		"organization": "ORG_ID",
+		"limit":        "N",
+		"export":       "FORMAT",
+	})
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		command.DefaultFormatNonInteractive(cmd)
+		ctx := cmd.Context()
+
+		var input auditlogtypes.ListInput
+		if err := command.ParseCommand(cmd, args, &input); err != nil {
+			return err
+		}
+
+		query, err := input.Query()
+		if err != nil {
+			return err
+		}
+
+		if input.Export != "" {
+			entries, err := deps.AuditLogRepo().ListAuditLogs(ctx, query)
+			if err != nil {
+				return err
+			}
+			return auditlog.Export(cmd.OutOrStdout(), input.Export, entries)
+		}
+
+		_, err = command.NonInteractive(cmd, func() (*auditlog.ListOut, error) {
+			entries, err := deps.AuditLogRepo().ListAuditLogs(ctx, query)
+			if err != nil {
+				return nil, err
+			}
+			if entries == nil {
+				entries = []*client.AuditLog{}
+			}
+			return &auditlog.ListOut{Data: entries}, nil
+		}, text.AuditLogTable)
+		return err
+	}
+
+	return cmd
+}
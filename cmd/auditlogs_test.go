@@ -0,0 +1,152 @@
+package cmd
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	renderapi "github.com/render-oss/cli/internal/fakes/renderapi"
+	"github.com/render-oss/cli/pkg/client"
+	"github.com/render-oss/cli/pkg/pointers"
+)
+
+func executeAuditLogs(t *testing.T, server *renderapi.Server, args ...string) (CommandResult, error) {
+	t.Helper()
+	return executeInWorkspace(t, server, "", setupAuditLogCommands, append([]string{"audit-logs"}, args...)...)
+}
+
+var auditLogBase = time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+
+func seedAuditLog(server *renderapi.Server, id string, event client.AuditLogEvent, email string, minutesAgo int) {
+	server.AuditLogs.Add(serviceTestWorkspaceID, client.AuditLog{
+		Id:        id,
+		Event:     event,
+		Status:    client.AuditLogStatusSuccess,
+		Timestamp: auditLogBase.Add(-time.Duration(minutesAgo) * time.Minute),
+		Actor:     client.AuditLogActor{Type: client.AuditLogActorTypeUser, Email: pointers.From(email)},
+		Metadata:  map[string]string{"service": "srv-1"},
+	})
+}
+
+func auditLogIDs(t *testing.T, stdout string) []string {
+	t.Helper()
+	var out struct {
+		Data []client.AuditLog `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(stdout), &out))
+	ids := make([]string, 0, len(out.Data))
+	for _, entry := range out.Data {
+		ids = append(ids, entry.Id)
+	}
+	return ids
+}
+
+func TestAuditLogs_FiltersByActorAndEvent(t *testing.T) {
+	server := renderapi.NewServer(t)
+	seedAuditLog(server, "aud-1", client.UpdateEnvVarsEvent, "alice@example.com", 30)
+	seedAuditLog(server, "aud-2", client.SuspendServiceEvent, "alice@example.com", 20)
+	seedAuditLog(server, "aud-3", client.UpdateEnvVarsEvent, "bob@example.com", 10)
+	seedAuditLog(server, "aud-4", client.UpdateEnvVarsEvent, "alice@example.com", 5)
+
+	result, err := executeAuditLogs(t, server,
+		"--actor", "alice@example.com", "--event", "updateEnvVars", "--output", "json")
+	require.NoError(t, err, "stderr: %s", result.Stderr)
+
+	assert.Equal(t, []string{"aud-4", "aud-1"}, auditLogIDs(t, result.Stdout))
+}
+
+func TestAuditLogs_SendsTimeRange(t *testing.T) {
+	server := renderapi.NewServer(t)
+	seedAuditLog(server, "aud-old", client.UpdateEnvVarsEvent, "alice@example.com", 120)
+	seedAuditLog(server, "aud-new", client.UpdateEnvVarsEvent, "alice@example.com", 10)
+
+	start := auditLogBase.Add(-time.Hour).Format(time.RFC3339)
+	result, err := executeAuditLogs(t, server, "--start", start, "--output", "json")
+	require.NoError(t, err, "stderr: %s", result.Stderr)
+
+	assert.Equal(t, []string{"aud-new"}, auditLogIDs(t, result.Stdout))
+}
+
+func TestAuditLogs_PagesUntilLimit(t *testing.T) {
+	server := renderapi.NewServer(t)
+	for i := range 250 {
+		seedAuditLog(server, fmt.Sprintf("aud-%03d", i), client.UpdateEnvVarsEvent, "alice@example.com", i)
+	}
+
+	result, err := executeAuditLogs(t, server, "--limit", "0", "--output", "json")
+	require.NoError(t, err, "stderr: %s", result.Stderr)
+	ids := auditLogIDs(t, result.Stdout)
+	require.Len(t, ids, 250)
+	assert.Equal(t, "aud-000", ids[0])
+	assert.Equal(t, "aud-249", ids[249])
+
+	server.Requests = nil
+	result, err = executeAuditLogs(t, server, "--limit", "150", "--output", "json")
+	require.NoError(t, err)
+	assert.Len(t, auditLogIDs(t, result.Stdout), 150)
+	var pages int
+	for _, req := range server.Requests {
+		if strings.HasPrefix(req.URI, "/owners/"+serviceTestWorkspaceID+"/audit-logs") {
+			pages++
+		}
+	}
+	assert.Equal(t, 2, pages)
+}
+
+func TestAuditLogs_Organization(t *testing.T) {
+	server := renderapi.NewServer(t)
+	seedAuditLog(server, "aud-workspace", client.UpdateEnvVarsEvent, "alice@example.com", 10)
+	server.AuditLogs.Add("org-abc123", client.AuditLog{
+		Id: "aud-org", Event: client.AddOrgMemberEvent, Status: client.AuditLogStatusSuccess,
+		Timestamp: auditLogBase, Actor: client.AuditLogActor{Type: client.AuditLogActorTypeSystem},
+	})
+
+	result, err := executeAuditLogs(t, server, "--organization", "org-abc123", "--output", "json")
+	require.NoError(t, err, "stderr: %s", result.Stderr)
+
+	assert.Equal(t, []string{"aud-org"}, auditLogIDs(t, result.Stdout))
+}
+
+func TestAuditLogs_Export(t *testing.T) {
+	t.Run("csv", func(t *testing.T) {
+		server := renderapi.NewServer(t)
+		seedAuditLog(server, "aud-1", client.UpdateEnvVarsEvent, "alice@example.com", 10)
+
+		result, err := executeAuditLogs(t, server, "--export", "csv")
+		require.NoError(t, err, "stderr: %s", result.Stderr)
+
+		rows, err := csv.NewReader(strings.NewReader(result.Stdout)).ReadAll()
+		require.NoError(t, err)
+		require.Len(t, rows, 2)
+		assert.Equal(t, "timestamp", rows[0][0])
+		assert.Equal(t, []string{"2026-10-01T11:50:00Z", "aud-1", "UpdateEnvVarsEvent", "success", "user", "", "alice@example.com", `{"service":"srv-1"}`}, rows[1])
+	})
+
+	t.Run("jsonl ignores --output", func(t *testing.T) {
+		server := renderapi.NewServer(t)
+		seedAuditLog(server, "aud-1", client.UpdateEnvVarsEvent, "alice@example.com", 10)
+		seedAuditLog(server, "aud-2", client.UpdateEnvVarsEvent, "alice@example.com", 5)
+
+		result, err := executeAuditLogs(t, server, "--export", "jsonl", "--output", "yaml")
+		require.NoError(t, err, "stderr: %s", result.Stderr)
+
+		lines := strings.Split(strings.TrimSpace(result.Stdout), "\n")
+		require.Len(t, lines, 2)
+		var entry client.AuditLog
+		require.NoError(t, json.Unmarshal([]byte(lines[0]), &entry))
+		assert.Equal(t, "aud-2", entry.Id)
+	})
+}
+
+func TestAuditLogs_RejectsUnknownEvent(t *testing.T) {
+	server := renderapi.NewServer(t)
+
+	_, err := executeAuditLogs(t, server, "--event", "MadeUpEvent")
+	require.ErrorContains(t, err, `unknown audit log event "MadeUpEvent"`)
+}
@@ -20,13 +20,16 @@ import (
 
 var deployCmd = &cobra.Command{
 	Use:     "deploys",
-	Short:   "List, create, and cancel deploys",
+	Short:   "List, create, cancel, and roll back deploys",
 	GroupID: GroupCore.ID,
 	Example: `  # List deploys for a service
   render deploys list srv-abc123
 
   # Trigger a deploy for a service
-  render deploys create srv-abc123`,
+  render deploys create srv-abc123
+
+  # Roll back to an earlier deploy
+  render deploys rollback srv-abc123 dep-xyz789 --confirm`,
 }
 
 var deployCreateCmd = &cobra.Command{
@@ -0,0 +1,101 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/render-oss/cli/pkg/command"
+	"github.com/render-oss/cli/pkg/dependencies"
+	"github.com/render-oss/cli/pkg/deploy"
+	"github.com/render-oss/cli/pkg/pointers"
+	"github.com/render-oss/cli/pkg/text"
+	"github.com/render-oss/cli/pkg/tui"
+)
+
+type deployRollbackInput struct {
+	ServiceIDOrName string `cli:"arg:0"`
+	DeployID        string `cli:"arg:1"`
+}
+
+func newDeployRollbackCmd(deps *dependencies.Dependencies) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "rollback <serviceID|serviceName> <deployID>",
+		Short:        "Roll back a service to an earlier deploy",
+		Args:         cobra.ExactArgs(2),
+		SilenceUsage: true,
+		Long: `Roll back a service to an earlier deploy. Render starts a new deploy that reuses
+the build from the given deploy, so no rebuild is needed.
+
+Only deploys that went live can be rolled back to. Rolling back also disables
+auto-deploy for the service until you re-enable it in the Render Dashboard.
+
+Without --confirm, this command previews the rollback and makes no changes.
+Pass --confirm to actually start the rollback.
+
+The first argument accepts a service ID or a name. Name lookup is scoped to
+your active workspace. Find deploy IDs with 'render deploys list'.
+
+This command only runs non-interactively. If --output interactive is requested,
+it falls back to text output.`,
+		Example: `  # Preview a rollback (no changes made)
+  render deploys rollback my-api dep-abc123def456ghi789jkl0
+
+  # Roll back
+  render deploys rollback srv-abc123def456ghi789jkl0 dep-abc123def456ghi789jkl0 --confirm
+
+  # JSON output
+  render deploys rollback my-api dep-abc123def456ghi789jkl0 --confirm --output json`,
+	}
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		command.DefaultFormatNonInteractive(cmd)
+
+		var input deployRollbackInput
+		if err := command.ParseCommand(cmd, args, &input); err != nil {
+			return err
+		}
+		confirm := command.GetConfirmFromContext(cmd.Context())
+
+		loadData := func() (*deploy.RollbackOut, error) {
+			serviceID, err := resolveEnvVarServiceID(cmd.Context(), deps, input.ServiceIDOrName)
+			if err != nil {
+				return nil, err
+			}
+			target, err := deps.DeployRepo().GetDeploy(cmd.Context(), serviceID, input.DeployID)
+			if err != nil {
+				return nil, err
+			}
+			if !deploy.IsSuccessful(target.Status) {
+				return nil, tui.UserFacingError{Message: fmt.Sprintf(
+					"Deploy %s has status %s. Only deploys that went live can be rolled back to.",
+					target.Id, pointers.ValueOrDefault(target.Status, "unknown"),
+				)}
+			}
+
+			out := deploy.RollbackOut{
+				Data: deploy.RollbackOutData{
+					ServiceID: serviceID,
+					Target:    target,
+				},
+				Meta: deploy.RollbackOutMeta{
+					RolledBack: confirm,
+				},
+			}
+			if !confirm {
+				out.Meta.Message = "re-run with --confirm to roll back"
+				return &out, nil
+			}
+			out.Data.Deploy, err = deps.DeployRepo().RollbackDeploy(cmd.Context(), serviceID, target.Id)
+			if err != nil {
+				return nil, err
+			}
+			return &out, nil
+		}
+
+		_, err := command.NonInteractive(cmd, loadData, text.DeployRollback)
+		return err
+	}
+
+	return cmd
+}
@@ -0,0 +1,78 @@
+package cmd
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	renderapi "github.com/render-oss/cli/internal/fakes/renderapi"
+	"github.com/render-oss/cli/pkg/client"
+	"github.com/render-oss/cli/pkg/dependencies"
+	"github.com/render-oss/cli/pkg/deploy"
+	"github.com/render-oss/cli/pkg/pointers"
+)
+
+func executeDeployRollback(t *testing.T, server *renderapi.Server, args ...string) (CommandResult, error) {
+	t.Helper()
+	return executeInWorkspace(t, server, "", func(root *cobra.Command, deps *dependencies.Dependencies) {
+		deploys := &cobra.Command{Use: "deploys"}
+		deploys.AddCommand(newDeployRollbackCmd(deps))
+		root.AddCommand(deploys)
+	}, append([]string{"deploys", "rollback"}, args...)...)
+}
+
+func seedDeploy(t *testing.T, server *renderapi.Server, serviceID, commit string, status client.DeployStatus) *client.Deploy {
+	t.Helper()
+	// Deploy.Commit is an anonymous struct, so build it from JSON.
+	var dep client.Deploy
+	require.NoError(t, json.Unmarshal([]byte(`{"commit":{"id":"`+commit+`"}}`), &dep))
+	dep.Status = pointers.From(status)
+	return server.Services.AddDeploy(serviceID, dep)
+}
+
+func TestDeployRollback_PreviewWithoutConfirm(t *testing.T) {
+	server := renderapi.NewServer(t)
+	svc := seedService(server, "my-api")
+	target := seedDeploy(t, server, svc.Id, "abc1234", client.DeployStatusDeactivated)
+	seedDeploy(t, server, svc.Id, "def5678", client.DeployStatusLive)
+
+	result, err := executeDeployRollback(t, server, "my-api", target.Id)
+	require.NoError(t, err, "stderr: %s", result.Stderr)
+
+	assert.Contains(t, result.Stdout, "would roll back service "+svc.Id)
+	assert.Contains(t, result.Stdout, "abc1234")
+	assert.Contains(t, result.Stdout, "Re-run with --confirm to proceed")
+	assert.Len(t, server.Services.Deploys[svc.Id], 2)
+}
+
+func TestDeployRollback_CreatesDeployWithConfirm(t *testing.T) {
+	server := renderapi.NewServer(t)
+	svc := seedService(server, "my-api")
+	target := seedDeploy(t, server, svc.Id, "abc1234", client.DeployStatusDeactivated)
+	seedDeploy(t, server, svc.Id, "def5678", client.DeployStatusLive)
+
+	result, err := executeDeployRollback(t, server, svc.Id, target.Id, "--confirm", "--output", "json")
+	require.NoError(t, err, "stderr: %s", result.Stderr)
+
+	var out deploy.RollbackOut
+	require.NoError(t, json.Unmarshal([]byte(result.Stdout), &out), result.Stdout)
+	assert.True(t, out.Meta.RolledBack)
+	assert.Equal(t, target.Id, out.Data.Target.Id)
+	require.NotNil(t, out.Data.Deploy)
+	assert.Equal(t, client.DeployTriggerRollback, *out.Data.Deploy.Trigger)
+	assert.Equal(t, "abc1234", *out.Data.Deploy.Commit.Id)
+	assert.Len(t, server.Services.Deploys[svc.Id], 3)
+}
+
+func TestDeployRollback_RejectsFailedDeploy(t *testing.T) {
+	server := renderapi.NewServer(t)
+	svc := seedService(server, "my-api")
+	failed := seedDeploy(t, server, svc.Id, "abc1234", client.DeployStatusBuildFailed)
+
+	_, err := executeDeployRollback(t, server, svc.Id, failed.Id, "--confirm")
+	require.ErrorContains(t, err, "Only deploys that went live can be rolled back to")
+	assert.Len(t, server.Services.Deploys[svc.Id], 1)
+}
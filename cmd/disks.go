@@ -0,0 +1,94 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/spf13/cobra"
+
+	disks "github.com/render-oss/cli/pkg/client/disks"
+	"github.com/render-oss/cli/pkg/command"
+	"github.com/render-oss/cli/pkg/dependencies"
+	"github.com/render-oss/cli/pkg/tui"
+)
+
+func newDisksCmd(children ...*cobra.Command) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "disks",
+		Aliases: []string{"disk"},
+		Short:   "Manage persistent disks and snapshots",
+		Long: `Manage persistent disks attached to services in the active workspace.
+
+A persistent disk keeps its data across deploys and restarts. Render takes a
+snapshot of each disk every day, and you can restore a disk from any snapshot to
+recover lost or corrupted data.
+
+Each subcommand that takes a disk accepts either its ID (dsk-...) or its name.
+Disk names are only unique per service, so pass the ID if a name is shared.
+
+Deleting a disk and restoring a snapshot destroy data. Both ask you to type the
+disk's name to confirm. Pass --confirm to skip the prompt in scripts.`,
+		GroupID: GroupCore.ID,
+	}
+	cmd.AddCommand(children...)
+	return cmd
+}
+
+func newDisksSnapshotsCmd(children ...*cobra.Command) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "snapshots",
+		Aliases: []string{"snapshot"},
+		Short:   "List and restore disk snapshots",
+		Long: `List and restore the snapshots Render takes of a persistent disk.
+
+Services with more than one instance have a disk per instance, and each
+instance's disk has its own snapshots.`,
+	}
+	cmd.AddCommand(children...)
+	return cmd
+}
+
+// diskTarget resolves a disk argument at most once, so the confirmation prompt
+// and the action it guards act on the same disk.
+type diskTarget struct {
+	deps     *dependencies.Dependencies
+	idOrName string
+	disk     *disks.DiskDetails
+}
+
+func (t *diskTarget) resolve(ctx context.Context) (*disks.DiskDetails, error) {
+	if t.disk == nil {
+		d, err := t.deps.DiskRepo().ResolveDisk(ctx, t.idOrName)
+		if err != nil {
+			return nil, err
+		}
+		t.disk = d
+	}
+	return t.disk, nil
+}
+
+// confirmation returns a typed confirmation that asks the user to enter the
+// disk's name before action runs.
+func (t *diskTarget) confirmation(ctx context.Context, action string) command.TypedConfirmFunc {
+	return func() (string, string, error) {
+		d, err := t.resolve(ctx)
+		if err != nil {
+			return "", "", err
+		}
+		return fmt.Sprintf("This will %s disk %s (%s). This cannot be undone.", action, d.Name, d.Id), d.Name, nil
+	}
+}
+
+// interactiveDiskAction runs a destructive disk action in the TUI. Unless
+// --confirm was passed, the user must type the disk's name in a confirmation
+// dialog first.
+func interactiveDiskAction[T any](ctx context.Context, cmd *cobra.Command, breadcrumb string, input *T, run func() (string, error), confirm command.TypedConfirmFunc) tea.Cmd {
+	action := command.LoadCmd(ctx, func(context.Context, *T) (string, error) {
+		return run()
+	}, input)
+	if !command.GetConfirmFromContext(ctx) {
+		action = command.WrapInTypedConfirm(action, confirm)
+	}
+	return command.AddToStackFunc(ctx, cmd, breadcrumb, input, tui.NewSimpleModel(action))
+}
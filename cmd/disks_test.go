@@ -0,0 +1,232 @@
+package cmd
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	renderapi "github.com/render-oss/cli/internal/fakes/renderapi"
+	"github.com/render-oss/cli/internal/testids"
+	"github.com/render-oss/cli/pkg/client"
+	disks "github.com/render-oss/cli/pkg/client/disks"
+	"github.com/render-oss/cli/pkg/pointers"
+)
+
+// executeDisks runs `render disks <args>` with stdin as the user's input to
+// any confirmation prompt.
+func executeDisks(t *testing.T, server *renderapi.Server, stdin string, args ...string) (CommandResult, error) {
+	t.Helper()
+	return executeInWorkspace(t, server, stdin, setupDiskCommands, append([]string{"disks"}, args...)...)
+}
+
+func seedDisk(server *renderapi.Server, svc *client.Service, name string, sizeGB int) *disks.DiskDetails {
+	return server.Disks.Add(renderapi.NewDisk(disks.DiskDetails{
+		Name:      name,
+		SizeGB:    sizeGB,
+		ServiceId: pointers.From(svc.Id),
+	}))
+}
+
+func TestDisksList_ScopedToActiveWorkspace(t *testing.T) {
+	server := renderapi.NewServer(t)
+	seedDisk(server, seedService(server, "my-db"), "data", 10)
+	other := server.Services.Add(renderapi.NewWebService(renderapi.WebServiceAttrs{
+		Service: renderapi.CommonServiceAttrs{Name: "other", OwnerID: testids.WorkspaceID("other")},
+	}))
+	seedDisk(server, other, "other-data", 5)
+
+	result, err := executeDisks(t, server, "", "list", "--output", "text")
+	require.NoError(t, err, "stderr: %s", result.Stderr)
+
+	assert.Contains(t, result.Stdout, "data")
+	assert.Contains(t, result.Stdout, "10 GB")
+	assert.NotContains(t, result.Stdout, "other-data")
+}
+
+func TestDisksAdd_AttachesToService(t *testing.T) {
+	server := renderapi.NewServer(t)
+	svc := seedService(server, "my-db")
+
+	result, err := executeDisks(t, server, "", "add", "my-db", "--name", "data", "--mount-path", "/var/data", "--size", "20", "--output", "json")
+	require.NoError(t, err, "stderr: %s", result.Stderr)
+
+	d := server.Disks.Only(t)
+	assert.Equal(t, "data", d.Name)
+	assert.Equal(t, 20, d.SizeGB)
+	assert.Equal(t, svc.Id, pointers.StringValue(d.ServiceId))
+}
+
+func TestDisksAdd_RejectsRelativeMountPath(t *testing.T) {
+	server := renderapi.NewServer(t)
+	seedService(server, "my-db")
+
+	_, err := executeDisks(t, server, "", "add", "my-db", "--name", "data", "--mount-path", "data", "--size", "20")
+	require.ErrorContains(t, err, "--mount-path must be an absolute path")
+	assert.Empty(t, server.Disks.Instances)
+}
+
+func TestDisksResize(t *testing.T) {
+	t.Run("previews without --confirm", func(t *testing.T) {
+		server := renderapi.NewServer(t)
+		d := seedDisk(server, seedService(server, "my-db"), "data", 10)
+
+		result, err := executeDisks(t, server, "", "resize", "data", "--size", "20")
+		require.NoError(t, err, "stderr: %s", result.Stderr)
+
+		assert.Contains(t, result.Stdout, "Size: 10 GB → 20 GB")
+		assert.Contains(t, result.Stdout, "Re-run with --confirm to proceed")
+		assert.Equal(t, 10, d.SizeGB)
+	})
+
+	t.Run("resizes with --confirm", func(t *testing.T) {
+		server := renderapi.NewServer(t)
+		d := seedDisk(server, seedService(server, "my-db"), "data", 10)
+
+		result, err := executeDisks(t, server, "", "resize", d.Id, "--size", "20", "--confirm")
+		require.NoError(t, err, "stderr: %s", result.Stderr)
+
+		assert.Contains(t, result.Stdout, "Resized disk data")
+		assert.Equal(t, 20, d.SizeGB)
+	})
+
+	t.Run("rejects shrinking", func(t *testing.T) {
+		server := renderapi.NewServer(t)
+		seedDisk(server, seedService(server, "my-db"), "data", 10)
+
+		_, err := executeDisks(t, server, "", "resize", "data", "--size", "5", "--confirm")
+		require.ErrorContains(t, err, "Disks can only grow")
+	})
+}
+
+func TestDisksDelete(t *testing.T) {
+	t.Run("deletes when the typed name matches", func(t *testing.T) {
+		server := renderapi.NewServer(t)
+		seedDisk(server, seedService(server, "my-db"), "data", 10)
+
+		result, err := executeDisks(t, server, "data\n", "delete", "data", "--output", "text")
+		require.NoError(t, err, "stderr: %s", result.Stderr)
+
+		assert.Contains(t, result.Stderr, "Type data to confirm")
+		assert.Contains(t, result.Stdout, "Deleted this disk")
+		assert.Empty(t, server.Disks.Instances)
+	})
+
+	t.Run("keeps JSON output parseable after a typed confirmation", func(t *testing.T) {
+		server := renderapi.NewServer(t)
+		seedDisk(server, seedService(server, "my-db"), "data", 10)
+
+		result, err := executeDisks(t, server, "data\n", "delete", "data", "--output", "json")
+		require.NoError(t, err, "stderr: %s", result.Stderr)
+
+		var body map[string]any
+		require.NoError(t, json.Unmarshal([]byte(result.Stdout), &body), "expected valid JSON, got: %s", result.Stdout)
+		assert.Contains(t, result.Stderr, "Type data to confirm")
+		assert.Empty(t, server.Disks.Instances)
+	})
+
+	t.Run("aborts when the typed name does not match", func(t *testing.T) {
+		server := renderapi.NewServer(t)
+		seedDisk(server, seedService(server, "my-db"), "data", 10)
+
+		result, err := executeDisks(t, server, "y\n", "delete", "data", "--output", "text")
+		require.NoError(t, err, "stderr: %s", result.Stderr)
+
+		assert.Contains(t, result.Stderr, "Aborted")
+		assert.Len(t, server.Disks.Instances, 1)
+	})
+
+	t.Run("fails without a prompt answer or --confirm", func(t *testing.T) {
+		server := renderapi.NewServer(t)
+		seedDisk(server, seedService(server, "my-db"), "data", 10)
+
+		_, err := executeDisks(t, server, "", "delete", "data", "--output", "json")
+		require.ErrorContains(t, err, "pass --confirm")
+		assert.Len(t, server.Disks.Instances, 1)
+	})
+
+	t.Run("skips the prompt with --confirm", func(t *testing.T) {
+		server := renderapi.NewServer(t)
+		d := seedDisk(server, seedService(server, "my-db"), "data", 10)
+
+		result, err := executeDisks(t, server, "", "delete", d.Id, "--confirm", "--output", "json")
+		require.NoError(t, err, "stderr: %s", result.Stderr)
+
+		var out struct {
+			Data struct {
+				ID string `json:"id"`
+			} `json:"data"`
+			Meta struct {
+				Deleted bool `json:"deleted"`
+			} `json:"meta"`
+		}
+		require.NoError(t, json.Unmarshal([]byte(result.Stdout), &out))
+		assert.Equal(t, d.Id, out.Data.ID)
+		assert.True(t, out.Meta.Deleted)
+		assert.Empty(t, server.Disks.Instances)
+	})
+}
+
+func TestDisksDelete_AmbiguousName(t *testing.T) {
+	server := renderapi.NewServer(t)
+	seedDisk(server, seedService(server, "api-a"), "data", 10)
+	seedDisk(server, seedService(server, "api-b"), "data", 10)
+
+	_, err := executeDisks(t, server, "", "delete", "data", "--confirm")
+	require.ErrorContains(t, err, "Multiple disks found with name 'data'")
+	assert.Len(t, server.Disks.Instances, 2)
+}
+
+func TestDisksSnapshots(t *testing.T) {
+	older := time.Date(2026, 10, 15, 3, 0, 0, 0, time.UTC)
+	newer := older.Add(24 * time.Hour)
+	seed := func(t *testing.T) (*renderapi.Server, *disks.DiskDetails) {
+		server := renderapi.NewServer(t)
+		d := seedDisk(server, seedService(server, "my-db"), "data", 10)
+		server.Disks.AddSnapshot(d.Id, client.DiskSnapshot{CreatedAt: &older, SnapshotKey: pointers.From("snap-older")})
+		server.Disks.AddSnapshot(d.Id, client.DiskSnapshot{CreatedAt: &newer, SnapshotKey: pointers.From("snap-newer")})
+		return server, d
+	}
+
+	t.Run("lists newest first", func(t *testing.T) {
+		server, _ := seed(t)
+
+		result, err := executeDisks(t, server, "", "snapshots", "list", "data", "--output", "text")
+		require.NoError(t, err, "stderr: %s", result.Stderr)
+
+		assert.Less(t, strings.Index(result.Stdout, "snap-newer"), strings.Index(result.Stdout, "snap-older"))
+	})
+
+	t.Run("restores when the typed name matches", func(t *testing.T) {
+		server, d := seed(t)
+
+		result, err := executeDisks(t, server, "data\n", "snapshots", "restore", "data", "snap-older", "--output", "text")
+		require.NoError(t, err, "stderr: %s", result.Stderr)
+
+		assert.Contains(t, result.Stdout, "Restoring disk data from snapshot snap-older")
+		require.Len(t, server.Disks.Restores, 1)
+		assert.Equal(t, d.Id, server.Disks.Restores[0].DiskID)
+		assert.Equal(t, "snap-older", server.Disks.Restores[0].Body.SnapshotKey)
+	})
+
+	t.Run("aborts when the typed name does not match", func(t *testing.T) {
+		server, _ := seed(t)
+
+		result, err := executeDisks(t, server, "dat\n", "snapshots", "restore", "data", "snap-older", "--output", "text")
+		require.NoError(t, err, "stderr: %s", result.Stderr)
+
+		assert.Contains(t, result.Stderr, "Aborted")
+		assert.Empty(t, server.Disks.Restores)
+	})
+
+	t.Run("rejects unknown snapshot keys", func(t *testing.T) {
+		server, _ := seed(t)
+
+		_, err := executeDisks(t, server, "", "snapshots", "restore", "data", "snap-missing", "--confirm")
+		require.ErrorContains(t, err, "Snapshot snap-missing not found")
+		assert.Empty(t, server.Disks.Restores)
+	})
+}
@@ -0,0 +1,69 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	disks "github.com/render-oss/cli/pkg/client/disks"
+	"github.com/render-oss/cli/pkg/command"
+	"github.com/render-oss/cli/pkg/dependencies"
+	"github.com/render-oss/cli/pkg/disk"
+	"github.com/render-oss/cli/pkg/text"
+	disktypes "github.com/render-oss/cli/pkg/types/disk"
+)
+
+func newDisksAddCmd(deps *dependencies.Dependencies) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "add <serviceID|serviceName>",
+		Short:        "Attach a new persistent disk to a service",
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+		Long: `Create a persistent disk and attach it to a service.
+
+A service can have at most one disk, and services with a disk cannot scale to
+more than one instance. The service redeploys with the disk mounted at
+--mount-path. Only files written under the mount path persist.
+
+This command only runs non-interactively. If --output interactive is requested,
+it falls back to text output.`,
+		Example: `  # Attach a 10 GB disk at /var/data
+  render disks add my-db --name data --mount-path /var/data --size 10`,
+	}
+
+	cmd.Flags().String("name", "", "Name of the disk (required)")
+	cmd.Flags().String("mount-path", "", "Absolute path to mount the disk at (required)")
+	cmd.Flags().Int("size", 0, "Size of the disk in GB (required)")
+	setAllFlagPlaceholders(cmd, map[string]string{
+		"name":       "NAME",
+		"mount-path": "PATH",
+		"size":       "GB",
+	})
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		command.DefaultFormatNonInteractive(cmd)
+
+		var input disktypes.AddInput
+		if err := command.ParseCommand(cmd, args, &input); err != nil {
+			return err
+		}
+
+		_, err := command.NonInteractive(cmd, func() (*disk.AddOut, error) {
+			serviceID, err := resolveEnvVarServiceID(cmd.Context(), deps, input.ServiceIDOrName)
+			if err != nil {
+				return nil, err
+			}
+			d, err := deps.DiskRepo().AddDisk(cmd.Context(), disks.DiskPOST{
+				ServiceId: serviceID,
+				Name:      input.Name,
+				MountPath: input.MountPath,
+				SizeGB:    input.SizeGB,
+			})
+			if err != nil {
+				return nil, err
+			}
+			return &disk.AddOut{Data: d}, nil
+		}, text.DiskAdd)
+		return err
+	}
+
+	return cmd
+}
@@ -0,0 +1,67 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/render-oss/cli/pkg/command"
+	"github.com/render-oss/cli/pkg/dependencies"
+	"github.com/render-oss/cli/pkg/disk"
+	"github.com/render-oss/cli/pkg/text"
+	disktypes "github.com/render-oss/cli/pkg/types/disk"
+)
+
+func newDisksDeleteCmd(deps *dependencies.Dependencies) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "delete <diskID|diskName>",
+		Short:        "Delete a persistent disk and its snapshots",
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+		Long: `Delete a persistent disk. The disk's data and all of its snapshots are deleted
+permanently, and the service redeploys without the disk.
+
+You are asked to type the disk's name to confirm. Pass --confirm to skip the
+prompt in scripts.`,
+		Example: `  # Delete, typing the disk name to confirm
+  render disks delete data
+
+  # Delete without a prompt
+  render disks delete dsk-abc123def456ghi789jkl0 --confirm`,
+	}
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		var input disktypes.DeleteInput
+		if err := command.ParseCommand(cmd, args, &input); err != nil {
+			return err
+		}
+		target := &diskTarget{deps: deps, idOrName: input.IDOrName}
+		confirm := target.confirmation(cmd.Context(), "permanently delete")
+
+		loadData := func() (*disk.DeleteOut, error) {
+			d, err := target.resolve(cmd.Context())
+			if err != nil {
+				return nil, err
+			}
+			if err := deps.DiskRepo().DeleteDisk(cmd.Context(), d.Id); err != nil {
+				return nil, err
+			}
+			return &disk.DeleteOut{Data: d, Meta: disk.DeleteOutMeta{Deleted: true}}, nil
+		}
+
+		if nonInteractive, err := command.NonInteractiveWithTypedConfirm(cmd, loadData, text.DiskDelete, confirm); err != nil {
+			return err
+		} else if nonInteractive {
+			return nil
+		}
+
+		interactiveDiskAction(cmd.Context(), cmd, "Delete disk", &input, func() (string, error) {
+			out, err := loadData()
+			if err != nil {
+				return "", err
+			}
+			return text.DiskDelete(out), nil
+		}, confirm)
+		return nil
+	}
+
+	return cmd
+}
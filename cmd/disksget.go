@@ -0,0 +1,49 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/render-oss/cli/pkg/command"
+	"github.com/render-oss/cli/pkg/dependencies"
+	"github.com/render-oss/cli/pkg/disk"
+	"github.com/render-oss/cli/pkg/text"
+	disktypes "github.com/render-oss/cli/pkg/types/disk"
+)
+
+func newDisksGetCmd(deps *dependencies.Dependencies) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "get <diskID|diskName>",
+		Short:        "Get details of a persistent disk",
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+		Long: `Show a persistent disk's size, mount path, and the service it is attached to.
+
+This command only runs non-interactively. If --output interactive is requested,
+it falls back to text output.`,
+		Example: `  # Get by ID
+  render disks get dsk-abc123def456ghi789jkl0
+
+  # Get by name, as JSON
+  render disks get data --output json`,
+	}
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		command.DefaultFormatNonInteractive(cmd)
+
+		var input disktypes.GetInput
+		if err := command.ParseCommand(cmd, args, &input); err != nil {
+			return err
+		}
+
+		_, err := command.NonInteractive(cmd, func() (*disk.GetOut, error) {
+			d, err := deps.DiskRepo().ResolveDisk(cmd.Context(), input.IDOrName)
+			if err != nil {
+				return nil, err
+			}
+			return &disk.GetOut{Data: d}, nil
+		}, text.DiskGet)
+		return err
+	}
+
+	return cmd
+}
@@ -0,0 +1,72 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/render-oss/cli/pkg/client"
+	disks "github.com/render-oss/cli/pkg/client/disks"
+	"github.com/render-oss/cli/pkg/command"
+	"github.com/render-oss/cli/pkg/dependencies"
+	"github.com/render-oss/cli/pkg/disk"
+	"github.com/render-oss/cli/pkg/text"
+	disktypes "github.com/render-oss/cli/pkg/types/disk"
+)
+
+func newDisksListCmd(deps *dependencies.Dependencies) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "list",
+		Short:        "List persistent disks",
+		Args:         cobra.NoArgs,
+		SilenceUsage: true,
+		Long: `List persistent disks in the active workspace.
+
+Use --service to show only the disk attached to one service.
+
+This command only runs non-interactively. If --output interactive is requested,
+it falls back to text output.`,
+		Example: `  # List disks in the active workspace
+  render disks list
+
+  # Show the disk attached to a service
+  render disks list --service my-db
+
+  # JSON output
+  render disks list --output json`,
+	}
+
+	cmd.Flags().String("service", "", "Only list the disk attached to this service (ID or name)")
+	setAllFlagPlaceholders(cmd, map[string]string{
+		"service": "SERVICE",
+	})
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		command.DefaultFormatNonInteractive(cmd)
+
+		var input disktypes.ListInput
+		if err := command.ParseCommand(cmd, args, &input); err != nil {
+			return err
+		}
+
+		_, err := command.NonInteractive(cmd, func() (*disk.ListOut, error) {
+			params := &client.ListDisksParams{}
+			if input.ServiceIDOrName != nil {
+				serviceID, err := resolveEnvVarServiceID(cmd.Context(), deps, *input.ServiceIDOrName)
+				if err != nil {
+					return nil, err
+				}
+				params.ServiceId = &client.ServiceIdsParam{serviceID}
+			}
+			found, err := deps.DiskRepo().ListDisks(cmd.Context(), params)
+			if err != nil {
+				return nil, err
+			}
+			if found == nil {
+				found = []*disks.DiskDetails{}
+			}
+			return &disk.ListOut{Data: found}, nil
+		}, text.DiskTable)
+		return err
+	}
+
+	return cmd
+}
@@ -0,0 +1,88 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/render-oss/cli/pkg/command"
+	"github.com/render-oss/cli/pkg/dependencies"
+	"github.com/render-oss/cli/pkg/disk"
+	"github.com/render-oss/cli/pkg/text"
+	"github.com/render-oss/cli/pkg/tui"
+	disktypes "github.com/render-oss/cli/pkg/types/disk"
+)
+
+func newDisksResizeCmd(deps *dependencies.Dependencies) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "resize <diskID|diskName>",
+		Short:        "Increase the size of a persistent disk",
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+		Long: `Increase the size of a persistent disk. Disks can grow but never shrink, so
+--size must be larger than the current size. The new size takes effect on the
+service's next deploy.
+
+Without --confirm, this command previews the change and makes no changes. Pass
+--confirm to actually resize the disk.
+
+This command only runs non-interactively. If --output interactive is requested,
+it falls back to text output.`,
+		Example: `  # Preview growing a disk to 20 GB (no changes made)
+  render disks resize data --size 20
+
+  # Resize
+  render disks resize dsk-abc123def456ghi789jkl0 --size 20 --confirm`,
+	}
+
+	cmd.Flags().Int("size", 0, "New size of the disk in GB (required)")
+	setAllFlagPlaceholders(cmd, map[string]string{
+		"size": "GB",
+	})
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		command.DefaultFormatNonInteractive(cmd)
+
+		var input disktypes.ResizeInput
+		if err := command.ParseCommand(cmd, args, &input); err != nil {
+			return err
+		}
+		confirm := command.GetConfirmFromContext(cmd.Context())
+
+		loadData := func() (*disk.ResizeOut, error) {
+			d, err := deps.DiskRepo().ResolveDisk(cmd.Context(), input.IDOrName)
+			if err != nil {
+				return nil, err
+			}
+			if input.SizeGB <= d.SizeGB {
+				return nil, tui.UserFacingError{Message: fmt.Sprintf(
+					"Disk %s is already %d GB. Disks can only grow, so --size must be larger than %d.",
+					d.Name, d.SizeGB, d.SizeGB,
+				)}
+			}
+
+			out := disk.ResizeOut{
+				Data: d,
+				Meta: disk.ResizeOutMeta{
+					BeforeGB: d.SizeGB,
+					AfterGB:  input.SizeGB,
+					Resized:  confirm,
+				},
+			}
+			if !confirm {
+				out.Meta.Message = "re-run with --confirm to resize"
+				return &out, nil
+			}
+			out.Data, err = deps.DiskRepo().ResizeDisk(cmd.Context(), d.Id, input.SizeGB)
+			if err != nil {
+				return nil, err
+			}
+			return &out, nil
+		}
+
+		_, err := command.NonInteractive(cmd, loadData, text.DiskResize)
+		return err
+	}
+
+	return cmd
+}
@@ -0,0 +1,63 @@
+package cmd
+
+import (
+	"sort"
+
+	"github.com/spf13/cobra"
+
+	"github.com/render-oss/cli/pkg/command"
+	"github.com/render-oss/cli/pkg/dependencies"
+	"github.com/render-oss/cli/pkg/disk"
+	"github.com/render-oss/cli/pkg/text"
+	disktypes "github.com/render-oss/cli/pkg/types/disk"
+)
+
+func newDisksSnapshotsListCmd(deps *dependencies.Dependencies) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "list <diskID|diskName>",
+		Short:        "List snapshots of a persistent disk",
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+		Long: `List the snapshots available for a persistent disk, newest first. Pass a
+snapshot key to 'render disks snapshots restore' to restore it.
+
+This command only runs non-interactively. If --output interactive is requested,
+it falls back to text output.`,
+		Example: `  # List snapshots
+  render disks snapshots list data
+
+  # JSON output
+  render disks snapshots list dsk-abc123def456ghi789jkl0 --output json`,
+	}
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		command.DefaultFormatNonInteractive(cmd)
+
+		var input disktypes.SnapshotListInput
+		if err := command.ParseCommand(cmd, args, &input); err != nil {
+			return err
+		}
+
+		_, err := command.NonInteractive(cmd, func() (*disk.SnapshotListOut, error) {
+			d, err := deps.DiskRepo().ResolveDisk(cmd.Context(), input.IDOrName)
+			if err != nil {
+				return nil, err
+			}
+			snapshots, err := deps.DiskRepo().ListSnapshots(cmd.Context(), d.Id)
+			if err != nil {
+				return nil, err
+			}
+			sort.SliceStable(snapshots, func(i, j int) bool {
+				a, b := snapshots[i].CreatedAt, snapshots[j].CreatedAt
+				return a != nil && (b == nil || a.After(*b))
+			})
+			return &disk.SnapshotListOut{
+				Data: snapshots,
+				Meta: disk.SnapshotListOutMeta{DiskID: d.Id, DiskName: d.Name},
+			}, nil
+		}, text.DiskSnapshotTable)
+		return err
+	}
+
+	return cmd
+}
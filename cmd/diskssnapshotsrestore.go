@@ -0,0 +1,118 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/render-oss/cli/pkg/client"
+	"github.com/render-oss/cli/pkg/command"
+	"github.com/render-oss/cli/pkg/dependencies"
+	"github.com/render-oss/cli/pkg/disk"
+	"github.com/render-oss/cli/pkg/text"
+	"github.com/render-oss/cli/pkg/tui"
+	disktypes "github.com/render-oss/cli/pkg/types/disk"
+)
+
+func newDisksSnapshotsRestoreCmd(deps *dependencies.Dependencies) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "restore <diskID|diskName> <snapshotKey>",
+		Short:        "Restore a persistent disk from a snapshot",
+		Args:         cobra.ExactArgs(2),
+		SilenceUsage: true,
+		Long: `Replace the contents of a persistent disk with a snapshot. Any data written
+after the snapshot was taken is lost. The service redeploys with the restored
+data.
+
+Find snapshot keys with 'render disks snapshots list'. If the snapshot belongs
+to a specific instance, pass --instance with its instance ID.
+
+You are asked to type the disk's name to confirm. Pass --confirm to skip the
+prompt in scripts.`,
+		Example: `  # Restore, typing the disk name to confirm
+  render disks snapshots restore data 2026-10-16T03:00:00Z
+
+  # Restore without a prompt
+  render disks snapshots restore dsk-abc123def456ghi789jkl0 2026-10-16T03:00:00Z --confirm`,
+	}
+
+	cmd.Flags().String("instance", "", "Instance whose snapshot to restore, for services with multiple instances")
+	setAllFlagPlaceholders(cmd, map[string]string{
+		"instance": "INSTANCE_ID",
+	})
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		var input disktypes.SnapshotRestoreInput
+		if err := command.ParseCommand(cmd, args, &input); err != nil {
+			return err
+		}
+		target := &diskTarget{deps: deps, idOrName: input.IDOrName}
+		confirm := target.confirmation(cmd.Context(), "overwrite all data on")
+
+		loadData := func() (*disk.SnapshotRestoreOut, error) {
+			d, err := target.resolve(cmd.Context())
+			if err != nil {
+				return nil, err
+			}
+			if err := checkSnapshotExists(cmd, deps, d.Id, input); err != nil {
+				return nil, err
+			}
+			restored, err := deps.DiskRepo().RestoreSnapshot(cmd.Context(), d.Id, client.SnapshotRestorePOST{
+				SnapshotKey: input.SnapshotKey,
+				InstanceId:  input.InstanceID,
+			})
+			if err != nil {
+				return nil, err
+			}
+			if restored == nil {
+				restored = d
+			}
+			return &disk.SnapshotRestoreOut{
+				Data: restored,
+				Meta: disk.SnapshotRestoreOutMeta{
+					SnapshotKey: input.SnapshotKey,
+					InstanceID:  input.InstanceID,
+					Restored:    true,
+				},
+			}, nil
+		}
+
+		if nonInteractive, err := command.NonInteractiveWithTypedConfirm(cmd, loadData, text.DiskSnapshotRestore, confirm); err != nil {
+			return err
+		} else if nonInteractive {
+			return nil
+		}
+
+		interactiveDiskAction(cmd.Context(), cmd, "Restore snapshot", &input, func() (string, error) {
+			out, err := loadData()
+			if err != nil {
+				return "", err
+			}
+			return text.DiskSnapshotRestore(out), nil
+		}, confirm)
+		return nil
+	}
+
+	return cmd
+}
+
+// checkSnapshotExists rejects unknown snapshot keys before anything is
+// restored, so a typo fails with a clear message.
+func checkSnapshotExists(cmd *cobra.Command, deps *dependencies.Dependencies, diskID string, input disktypes.SnapshotRestoreInput) error {
+	snapshots, err := deps.DiskRepo().ListSnapshots(cmd.Context(), diskID)
+	if err != nil {
+		return err
+	}
+	for _, s := range snapshots {
+		if s.SnapshotKey == nil || *s.SnapshotKey != input.SnapshotKey {
+			continue
+		}
+		if input.InstanceID == nil || (s.InstanceId != nil && *s.InstanceId == *input.InstanceID) {
+			return nil
+		}
+	}
+	return tui.UserFacingError{Message: fmt.Sprintf(
+		"Snapshot %s not found for this disk. Run 'render disks snapshots list %s' to see available snapshots.",
+		input.SnapshotKey, diskID,
+	)}
+}
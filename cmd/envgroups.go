@@ -0,0 +1,56 @@
+package cmd
+
+import (
+	"context"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/spf13/cobra"
+
+	"github.com/render-oss/cli/pkg/client"
+	"github.com/render-oss/cli/pkg/command"
+	"github.com/render-oss/cli/pkg/tui"
+	"github.com/render-oss/cli/pkg/tui/flows"
+	"github.com/render-oss/cli/pkg/tui/views"
+)
+
+func newEnvGroupsCmd(children ...*cobra.Command) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "env-groups",
+		Aliases: []string{"env-group", "envgroups"},
+		Short:   "Manage environment groups",
+		Long: `Manage environment groups in the active workspace.
+
+An environment group is a named set of environment variables and secret files
+that can be linked to any number of services. Linked services receive the
+group's variables alongside their own.
+
+Each subcommand that takes an env group accepts either its ID (evg-...) or its
+name. Name lookup is scoped to your active workspace.`,
+		GroupID: GroupCore.ID,
+	}
+	cmd.AddCommand(children...)
+	return cmd
+}
+
+// InteractiveEnvGroupList shows the env group table. Selecting a group lists
+// the keys it defines.
+func InteractiveEnvGroupList(ctx context.Context, cmd *cobra.Command, input views.EnvGroupInput) tea.Cmd {
+	getCmd := cmd
+	if parent := cmd.Parent(); parent != nil {
+		if found, _, err := parent.Find([]string{"get"}); err == nil {
+			getCmd = found
+		}
+	}
+
+	return command.AddToStackFunc(ctx, cmd, "Env Groups", &input, views.NewEnvGroupList(ctx, input,
+		func(ctx context.Context, g *client.EnvGroupMeta) tea.Cmd {
+			return command.AddToStackFunc(ctx, getCmd, g.Name, &views.EnvGroupEnvVarInput{EnvGroupID: g.Id},
+				views.NewEnvGroupEnvVarList(ctx, views.EnvGroupEnvVarInput{EnvGroupID: g.Id}),
+			)
+		},
+		tui.WithCustomOptions[*client.EnvGroupMeta]([]tui.CustomOption{
+			flows.WithCopyID(ctx, cmd),
+			flows.WithWorkspaceSelection(ctx),
+		}),
+	))
+}
@@ -0,0 +1,168 @@
+package cmd
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	renderapi "github.com/render-oss/cli/internal/fakes/renderapi"
+	"github.com/render-oss/cli/internal/testids"
+	"github.com/render-oss/cli/pkg/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func executeEnvGroups(t *testing.T, server *renderapi.Server, args ...string) (CommandResult, error) {
+	t.Helper()
+	return executeInWorkspace(t, server, "", setupEnvGroupCommands, append([]string{"env-groups"}, args...)...)
+}
+
+func seedEnvGroup(server *renderapi.Server, name string, envVars ...client.EnvVar) *client.EnvGroup {
+	return server.EnvGroups.Add(renderapi.NewEnvGroup(client.EnvGroup{
+		Name:    name,
+		OwnerId: serviceTestWorkspaceID,
+		EnvVars: envVars,
+	}))
+}
+
+func TestEnvGroupsList_ScopedToActiveWorkspace(t *testing.T) {
+	server := renderapi.NewServer(t)
+	seedEnvGroup(server, "shared-config")
+	server.EnvGroups.Add(renderapi.NewEnvGroup(client.EnvGroup{
+		Name:    "other-team",
+		OwnerId: testids.WorkspaceID("other"),
+	}))
+
+	result, err := executeEnvGroups(t, server, "list", "--output", "text")
+	require.NoError(t, err)
+
+	assert.Contains(t, result.Stdout, "shared-config")
+	assert.NotContains(t, result.Stdout, "other-team")
+}
+
+func TestEnvGroupsGet_MasksValuesByName(t *testing.T) {
+	server := renderapi.NewServer(t)
+	g := seedEnvGroup(server, "shared-config", client.EnvVar{Key: "API_KEY", Value: "hunter2"})
+
+	result, err := executeEnvGroups(t, server, "get", "shared-config", "--output", "text")
+	require.NoError(t, err)
+
+	assert.Contains(t, result.Stdout, g.Id)
+	assert.Contains(t, result.Stdout, "API_KEY")
+	assert.NotContains(t, result.Stdout, "hunter2")
+
+	result, err = executeEnvGroups(t, server, "get", g.Id, "--output", "json")
+	require.NoError(t, err)
+
+	var out struct {
+		Data client.EnvGroup `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(result.Stdout), &out))
+	assert.Equal(t, []client.EnvVar{{Key: "API_KEY", Value: "hunter2"}}, out.Data.EnvVars)
+}
+
+func TestEnvGroupsGet_UnknownName_Errors(t *testing.T) {
+	server := renderapi.NewServer(t)
+
+	_, err := executeEnvGroups(t, server, "get", "missing", "--output", "text")
+	require.Error(t, err)
+
+	assert.Contains(t, err.Error(), "No env group named 'missing'")
+}
+
+func TestEnvGroupsCreate_WithVarsSecretFilesAndServices(t *testing.T) {
+	server := renderapi.NewServer(t)
+	svc := seedService(server, "my-api")
+	certPath := filepath.Join(t.TempDir(), "cert.pem")
+	require.NoError(t, os.WriteFile(certPath, []byte("CERT"), 0o600))
+
+	_, err := executeEnvGroups(t, server, "create", "shared-config", "LOG_LEVEL=info",
+		"--service", "my-api", "--secret-file", "cert.pem:"+certPath, "--output", "text")
+	require.NoError(t, err)
+
+	g := server.EnvGroups.Only(t)
+	assert.Equal(t, "shared-config", g.Name)
+	assert.Equal(t, serviceTestWorkspaceID, g.OwnerId)
+	assert.Equal(t, []client.EnvVar{{Key: "LOG_LEVEL", Value: "info"}}, g.EnvVars)
+	assert.Equal(t, []client.SecretFile{{Name: "cert.pem", Content: "CERT"}}, g.SecretFiles)
+	require.Len(t, g.ServiceLinks, 1)
+	assert.Equal(t, svc.Id, g.ServiceLinks[0].Id)
+}
+
+func TestEnvGroupsDelete_RequiresConfirm(t *testing.T) {
+	server := renderapi.NewServer(t)
+	seedEnvGroup(server, "shared-config")
+
+	result, err := executeEnvGroups(t, server, "delete", "shared-config", "--output", "text")
+	require.NoError(t, err)
+	assert.Len(t, server.EnvGroups.Instances, 1)
+	assert.Contains(t, result.Stdout, "--confirm")
+
+	_, err = executeEnvGroups(t, server, "delete", "shared-config", "--confirm", "--output", "text")
+	require.NoError(t, err)
+	assert.Empty(t, server.EnvGroups.Instances)
+}
+
+func TestEnvGroupsSet_ReportsChangedKeys(t *testing.T) {
+	server := renderapi.NewServer(t)
+	g := seedEnvGroup(server, "shared-config", client.EnvVar{Key: "LOG_LEVEL", Value: "info"})
+
+	result, err := executeEnvGroups(t, server, "set", "shared-config", "LOG_LEVEL=debug", "NEW=1", "--output", "text")
+	require.NoError(t, err)
+
+	assert.ElementsMatch(t, []client.EnvVar{
+		{Key: "LOG_LEVEL", Value: "debug"},
+		{Key: "NEW", Value: "1"},
+	}, g.EnvVars)
+	assert.Contains(t, result.Stdout, "+ NEW")
+	assert.Contains(t, result.Stdout, "~ LOG_LEVEL")
+	assert.NotContains(t, result.Stdout, "debug")
+}
+
+func TestEnvGroupsSet_RequiresAChange(t *testing.T) {
+	server := renderapi.NewServer(t)
+	seedEnvGroup(server, "shared-config")
+
+	_, err := executeEnvGroups(t, server, "set", "shared-config", "--output", "text")
+	require.ErrorContains(t, err, "at least one KEY=VALUE")
+}
+
+func TestEnvGroupsUnset_PreviewThenConfirm(t *testing.T) {
+	server := renderapi.NewServer(t)
+	g := seedEnvGroup(server, "shared-config",
+		client.EnvVar{Key: "OLD", Value: "x"},
+		client.EnvVar{Key: "KEEP", Value: "y"},
+	)
+
+	result, err := executeEnvGroups(t, server, "unset", "shared-config", "OLD", "--output", "text")
+	require.NoError(t, err)
+	assert.Len(t, g.EnvVars, 2)
+	assert.Contains(t, result.Stdout, "- OLD")
+
+	_, err = executeEnvGroups(t, server, "unset", "shared-config", "OLD", "--confirm", "--output", "text")
+	require.NoError(t, err)
+	assert.Equal(t, []client.EnvVar{{Key: "KEEP", Value: "y"}}, g.EnvVars)
+}
+
+func TestEnvGroupsLinkAndUnlink(t *testing.T) {
+	server := renderapi.NewServer(t)
+	svc := seedService(server, "my-api")
+	g := seedEnvGroup(server, "shared-config")
+
+	result, err := executeEnvGroups(t, server, "link", "shared-config", "my-api", "--output", "text")
+	require.NoError(t, err)
+	require.Len(t, g.ServiceLinks, 1)
+	assert.Equal(t, svc.Id, g.ServiceLinks[0].Id)
+	assert.Contains(t, result.Stdout, "Linked service "+svc.Id)
+
+	result, err = executeEnvGroups(t, server, "unlink", "shared-config", "my-api", "--output", "text")
+	require.NoError(t, err)
+	assert.Len(t, g.ServiceLinks, 1, "preview must not unlink")
+	assert.Contains(t, result.Stdout, "--confirm")
+
+	_, err = executeEnvGroups(t, server, "unlink", "shared-config", svc.Id, "--confirm", "--output", "text")
+	require.NoError(t, err)
+	assert.Empty(t, g.ServiceLinks)
+	assert.True(t, server.HasRequest("DELETE", "/env-groups/"+g.Id+"/services/"+svc.Id))
+}
@@ -0,0 +1,103 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/render-oss/cli/pkg/client"
+	"github.com/render-oss/cli/pkg/command"
+	"github.com/render-oss/cli/pkg/dependencies"
+	"github.com/render-oss/cli/pkg/envgroup"
+	"github.com/render-oss/cli/pkg/service"
+	"github.com/render-oss/cli/pkg/text"
+	envgrouptypes "github.com/render-oss/cli/pkg/types/envgroup"
+	servicetypes "github.com/render-oss/cli/pkg/types/service"
+)
+
+func newEnvGroupsCreateCmd(deps *dependencies.Dependencies) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "create <name> [KEY=VALUE]...",
+		Short:        "Create an environment group",
+		Args:         cobra.MinimumNArgs(1),
+		SilenceUsage: true,
+		Long: `Create an environment group in the active workspace.
+
+Initial variables are given as KEY=VALUE pairs after the name. Values are taken
+verbatim after the first '='. Secret files are read from local paths with
+--secret-file NAME:LOCAL_PATH.
+
+Pass --service (repeatable) to link services when the group is created, and
+--environment to scope the group to an environment.
+
+This command only runs non-interactively. If --output interactive is requested,
+it falls back to text output.`,
+		Example: `  # Create an empty group
+  render env-groups create shared-config
+
+  # Create with variables and link two services
+  render env-groups create shared-config LOG_LEVEL=info REGION=oregon --service my-api --service my-worker
+
+  # Include a secret file
+  render env-groups create tls --secret-file cert.pem:./certs/cert.pem
+
+  # Scope to an environment
+  render env-groups create shared-config --environment production`,
+	}
+
+	cmd.Flags().String("project", "",
+		"Project (ID or name) used to resolve --environment.")
+	cmd.Flags().String("environment", "",
+		"Environment (ID or name) to create the group in.")
+	cmd.Flags().StringArray("service", nil,
+		"Service (ID or name) to link to the new group (can be specified multiple times)")
+	cmd.Flags().StringArray("secret-file", nil,
+		"Secret file in NAME:LOCAL_PATH format (can be specified multiple times)")
+	setAllFlagPlaceholders(cmd, map[string]string{
+		"project":     "PROJECT",
+		"environment": "ENVIRONMENT",
+		"service":     "SERVICE",
+		"secret-file": "NAME_PATH",
+	})
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		command.DefaultFormatNonInteractive(cmd)
+
+		var input envgrouptypes.CreateInput
+		if err := command.ParseCommand(cmd, args, &input); err != nil {
+			return err
+		}
+		input = envgrouptypes.NormalizeCreateInput(input)
+
+		var envVars []client.EnvVar
+		if len(args) > 1 {
+			parsed, err := servicetypes.ParseEnvVarAssignments(args[1:])
+			if err != nil {
+				return err
+			}
+			for _, ev := range parsed {
+				envVars = append(envVars, client.EnvVar{Key: ev.Key, Value: ev.Value})
+			}
+		}
+		secretFiles, err := service.ResolveSecretFileInputs(input.SecretFiles)
+		if err != nil {
+			return err
+		}
+
+		_, err = command.NonInteractive(cmd, func() (*envgroup.CreateOut, error) {
+			g, err := deps.EnvGroupService().Create(cmd.Context(), envgroup.CreateInput{
+				Name:                input.Name,
+				EnvVars:             envVars,
+				SecretFiles:         secretFiles,
+				ProjectIDOrName:     input.ProjectIDOrName,
+				EnvironmentIDOrName: input.EnvironmentIDOrName,
+				ServiceIDsOrNames:   input.Services,
+			})
+			if err != nil {
+				return nil, err
+			}
+			return &envgroup.CreateOut{Data: g}, nil
+		}, text.EnvGroupCreate)
+		return err
+	}
+
+	return cmd
+}
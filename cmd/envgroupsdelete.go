@@ -0,0 +1,61 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/render-oss/cli/pkg/command"
+	"github.com/render-oss/cli/pkg/dependencies"
+	"github.com/render-oss/cli/pkg/envgroup"
+	"github.com/render-oss/cli/pkg/text"
+	envgrouptypes "github.com/render-oss/cli/pkg/types/envgroup"
+)
+
+func newEnvGroupsDeleteCmd(deps *dependencies.Dependencies) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "delete <envGroupID|envGroupName>",
+		Short:        "Delete an environment group",
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+		Long: `Delete an environment group. Linked services lose the group's variables.
+
+Without --confirm, this command previews what would be deleted and makes no
+changes. Pass --confirm to actually delete the group.`,
+		Example: `  # Preview deletion (no changes made)
+  render env-groups delete shared-config
+
+  # Delete
+  render env-groups delete shared-config --confirm`,
+	}
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		command.DefaultFormatNonInteractive(cmd)
+
+		var input envgrouptypes.DeleteInput
+		if err := command.ParseCommand(cmd, args, &input); err != nil {
+			return err
+		}
+		confirm := command.GetConfirmFromContext(cmd.Context())
+
+		_, err := command.NonInteractive(cmd, func() (*envgroup.DeleteOut, error) {
+			g, err := deps.EnvGroupService().Resolve(cmd.Context(), input.IDOrName)
+			if err != nil {
+				return nil, err
+			}
+			out := &envgroup.DeleteOut{
+				Data: g,
+				Meta: envgroup.DeleteOutMeta{Deleted: confirm},
+			}
+			if confirm {
+				if err := deps.EnvGroupRepo().DeleteEnvGroup(cmd.Context(), g.Id); err != nil {
+					return nil, err
+				}
+			} else {
+				out.Meta.Message = "re-run with --confirm to delete"
+			}
+			return out, nil
+		}, text.EnvGroupDelete)
+		return err
+	}
+
+	return cmd
+}
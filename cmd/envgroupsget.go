@@ -0,0 +1,62 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/render-oss/cli/pkg/command"
+	"github.com/render-oss/cli/pkg/dependencies"
+	"github.com/render-oss/cli/pkg/envgroup"
+	"github.com/render-oss/cli/pkg/service"
+	"github.com/render-oss/cli/pkg/text"
+	envgrouptypes "github.com/render-oss/cli/pkg/types/envgroup"
+)
+
+func newEnvGroupsGetCmd(deps *dependencies.Dependencies) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "get <envGroupID|envGroupName>",
+		Short:        "Get details of an environment group",
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+		Long: `Show an environment group's linked services, environment variables, and
+secret file names.
+
+Text output masks variable values. Pass --show-values to reveal them. JSON and
+YAML output always include values.
+
+This command only runs non-interactively. If --output interactive is requested,
+it falls back to text output.`,
+		Example: `  # Get by name
+  render env-groups get shared-config
+
+  # Reveal values
+  render env-groups get shared-config --show-values
+
+  # JSON output
+  render env-groups get evg-abc123def456ghi789jkl0 --output json`,
+	}
+
+	cmd.Flags().Bool("show-values", false, "Show variable values in text output")
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		command.DefaultFormatNonInteractive(cmd)
+
+		var input envgrouptypes.GetInput
+		if err := command.ParseCommand(cmd, args, &input); err != nil {
+			return err
+		}
+
+		_, err := command.NonInteractive(cmd, func() (*envgroup.GetOut, error) {
+			g, err := deps.EnvGroupService().Resolve(cmd.Context(), input.IDOrName)
+			if err != nil {
+				return nil, err
+			}
+			service.SortEnvVars(g.EnvVars)
+			return &envgroup.GetOut{Data: g, ShowValues: input.ShowValues}, nil
+		}, func(out *envgroup.GetOut) string {
+			return text.EnvGroupDetail(out.Data, out.ShowValues)
+		})
+		return err
+	}
+
+	return cmd
+}
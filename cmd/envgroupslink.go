@@ -0,0 +1,64 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/render-oss/cli/pkg/command"
+	"github.com/render-oss/cli/pkg/dependencies"
+	"github.com/render-oss/cli/pkg/envgroup"
+	"github.com/render-oss/cli/pkg/text"
+	envgrouptypes "github.com/render-oss/cli/pkg/types/envgroup"
+)
+
+func newEnvGroupsLinkCmd(deps *dependencies.Dependencies) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "link <envGroupID|envGroupName> <serviceID|serviceName>",
+		Short:        "Link a service to an environment group",
+		Args:         cobra.ExactArgs(2),
+		SilenceUsage: true,
+		Long: `Link a service to an environment group so the service receives the group's
+variables and secret files.
+
+This command only runs non-interactively. If --output interactive is requested,
+it falls back to text output.`,
+		Example: `  # Link by name
+  render env-groups link shared-config my-api
+
+  # JSON output
+  render env-groups link evg-abc123def456ghi789jkl0 srv-abc123def456ghi789jkl0 --output json`,
+	}
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		command.DefaultFormatNonInteractive(cmd)
+
+		var input envgrouptypes.LinkInput
+		if err := command.ParseCommand(cmd, args, &input); err != nil {
+			return err
+		}
+
+		_, err := command.NonInteractive(cmd, func() (*envgroup.LinkOut, error) {
+			g, err := deps.EnvGroupService().Resolve(cmd.Context(), input.IDOrName)
+			if err != nil {
+				return nil, err
+			}
+			serviceID, err := deps.EnvGroupService().ResolveServiceID(cmd.Context(), input.ServiceIDOrName)
+			if err != nil {
+				return nil, err
+			}
+			updated, err := deps.EnvGroupRepo().LinkService(cmd.Context(), g.Id, serviceID)
+			if err != nil {
+				return nil, err
+			}
+			if updated == nil {
+				updated = g
+			}
+			return &envgroup.LinkOut{
+				Data: updated,
+				Meta: envgroup.LinkOutMeta{ServiceID: serviceID, Linked: true, Applied: true},
+			}, nil
+		}, text.EnvGroupLink)
+		return err
+	}
+
+	return cmd
+}
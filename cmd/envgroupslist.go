@@ -0,0 +1,78 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/render-oss/cli/pkg/command"
+	"github.com/render-oss/cli/pkg/dependencies"
+	"github.com/render-oss/cli/pkg/envgroup"
+	"github.com/render-oss/cli/pkg/text"
+	"github.com/render-oss/cli/pkg/tui/views"
+	envgrouptypes "github.com/render-oss/cli/pkg/types/envgroup"
+)
+
+func newEnvGroupsListCmd(deps *dependencies.Dependencies) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "list",
+		Short:        "List environment groups",
+		Args:         cobra.NoArgs,
+		SilenceUsage: true,
+		Long: `List environment groups in the active workspace.
+
+Use --project to narrow results to a single project, --environment to narrow
+to a single environment, or both — when both are supplied, the environment is
+resolved within that project.
+
+In interactive mode, select a group to see the keys it defines.`,
+		Example: `  # List env groups in the active workspace
+  render env-groups list
+
+  # Filter by environment name
+  render env-groups list --environment production
+
+  # JSON output
+  render env-groups list --output json`,
+	}
+
+	cmd.Flags().String("project", "",
+		"Narrow results to environments in a project (ID or name, optional).")
+	cmd.Flags().String("environment", "",
+		"Narrow results to a single environment (ID or name, optional).")
+	setAllFlagPlaceholders(cmd, map[string]string{
+		"project":     "PROJECT",
+		"environment": "ENVIRONMENT",
+	})
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		var input envgrouptypes.ListInput
+		if err := command.ParseCommand(cmd, args, &input); err != nil {
+			return err
+		}
+		input = envgrouptypes.NormalizeListInput(input)
+
+		if nonInteractive, err := command.NonInteractive(cmd, func() (*envgroup.ListOut, error) {
+			groups, err := deps.EnvGroupService().List(cmd.Context(), envgroup.ListInput{
+				ProjectIDOrName:     input.ProjectIDOrName,
+				EnvironmentIDOrName: input.EnvironmentIDOrName,
+			})
+			if err != nil {
+				return nil, err
+			}
+			return &envgroup.ListOut{Data: groups}, nil
+		}, func(out *envgroup.ListOut) string {
+			return text.EnvGroupTable(out.Data)
+		}); err != nil {
+			return err
+		} else if nonInteractive {
+			return nil
+		}
+
+		InteractiveEnvGroupList(cmd.Context(), cmd, views.EnvGroupInput{
+			ProjectIDOrName:     input.ProjectIDOrName,
+			EnvironmentIDOrName: input.EnvironmentIDOrName,
+		})
+		return nil
+	}
+
+	return cmd
+}
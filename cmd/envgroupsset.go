@@ -0,0 +1,118 @@
+package cmd
+
+import (
+	"errors"
+
+	"github.com/spf13/cobra"
+
+	"github.com/render-oss/cli/pkg/client"
+	"github.com/render-oss/cli/pkg/command"
+	"github.com/render-oss/cli/pkg/dependencies"
+	"github.com/render-oss/cli/pkg/envgroup"
+	"github.com/render-oss/cli/pkg/service"
+	"github.com/render-oss/cli/pkg/text"
+	envgrouptypes "github.com/render-oss/cli/pkg/types/envgroup"
+	servicetypes "github.com/render-oss/cli/pkg/types/service"
+)
+
+func newEnvGroupsSetCmd(deps *dependencies.Dependencies) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "set <envGroupID|envGroupName> [KEY=VALUE]...",
+		Short:        "Set variables or secret files on an environment group",
+		Args:         cobra.MinimumNArgs(1),
+		SilenceUsage: true,
+		Long: `Add or update environment variables and secret files on an environment group.
+Variables not named on the command line are left unchanged.
+
+Values are taken verbatim after the first '='. If a key is repeated, the last
+value wins. Secret files are read from local paths with --secret-file
+NAME:LOCAL_PATH and replace any existing file with the same name.
+
+The output lists which keys changed but never prints values.
+
+This command only runs non-interactively. If --output interactive is requested,
+it falls back to text output.`,
+		Example: `  # Set a variable
+  render env-groups set shared-config LOG_LEVEL=debug
+
+  # Set several variables and a secret file
+  render env-groups set shared-config LOG_LEVEL=info REGION=oregon --secret-file cert.pem:./cert.pem`,
+	}
+
+	cmd.Flags().StringArray("secret-file", nil,
+		"Secret file in NAME:LOCAL_PATH format (can be specified multiple times)")
+	setAllFlagPlaceholders(cmd, map[string]string{
+		"secret-file": "NAME_PATH",
+	})
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		command.DefaultFormatNonInteractive(cmd)
+
+		var input envgrouptypes.SetInput
+		if err := command.ParseCommand(cmd, args, &input); err != nil {
+			return err
+		}
+		if len(args) == 1 && len(input.SecretFiles) == 0 {
+			return errors.New("at least one KEY=VALUE pair or --secret-file is required")
+		}
+		var envVars []client.EnvVar
+		if len(args) > 1 {
+			parsed, err := servicetypes.ParseEnvVarAssignments(args[1:])
+			if err != nil {
+				return err
+			}
+			for _, ev := range parsed {
+				envVars = append(envVars, client.EnvVar{Key: ev.Key, Value: ev.Value})
+			}
+		}
+		secretFiles, err := service.ResolveSecretFileInputs(input.SecretFiles)
+		if err != nil {
+			return err
+		}
+
+		_, err = command.NonInteractive(cmd, func() (*envgroup.EnvVarChangeOut, error) {
+			repo := deps.EnvGroupRepo()
+			g, err := deps.EnvGroupService().Resolve(cmd.Context(), input.IDOrName)
+			if err != nil {
+				return nil, err
+			}
+			before := g.EnvVars
+
+			for _, ev := range envVars {
+				if _, err := repo.SetEnvVar(cmd.Context(), g.Id, ev.Key, ev.Value); err != nil {
+					return nil, err
+				}
+			}
+			secretFileNames := []string{}
+			for _, f := range secretFiles {
+				if _, err := repo.SetSecretFile(cmd.Context(), g.Id, f); err != nil {
+					return nil, err
+				}
+				secretFileNames = append(secretFileNames, f.Name)
+			}
+
+			return envGroupChangeOutAfterApply(cmd, deps, g.Id, before, secretFileNames)
+		}, text.EnvGroupEnvVarChanges)
+		return err
+	}
+
+	return cmd
+}
+
+// envGroupChangeOutAfterApply re-reads the env group after a change and diffs
+// its variables against before.
+func envGroupChangeOutAfterApply(cmd *cobra.Command, deps *dependencies.Dependencies, id string, before []client.EnvVar, secretFiles []string) (*envgroup.EnvVarChangeOut, error) {
+	after, err := deps.EnvGroupRepo().GetEnvGroup(cmd.Context(), id)
+	if err != nil {
+		return nil, err
+	}
+	service.SortEnvVars(after.EnvVars)
+	return &envgroup.EnvVarChangeOut{
+		Data: after,
+		Meta: envgroup.EnvVarChangeOutMeta{
+			Applied:     true,
+			Changes:     service.DiffEnvVars(before, after.EnvVars),
+			SecretFiles: secretFiles,
+		},
+	}, nil
+}
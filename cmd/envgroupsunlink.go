@@ -0,0 +1,75 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/render-oss/cli/pkg/command"
+	"github.com/render-oss/cli/pkg/dependencies"
+	"github.com/render-oss/cli/pkg/envgroup"
+	"github.com/render-oss/cli/pkg/text"
+	envgrouptypes "github.com/render-oss/cli/pkg/types/envgroup"
+)
+
+func newEnvGroupsUnlinkCmd(deps *dependencies.Dependencies) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "unlink <envGroupID|envGroupName> <serviceID|serviceName>",
+		Short:        "Unlink a service from an environment group",
+		Args:         cobra.ExactArgs(2),
+		SilenceUsage: true,
+		Long: `Unlink a service from an environment group. The service stops receiving the
+group's variables and secret files.
+
+Without --confirm, this command previews the change and makes no changes.
+
+This command only runs non-interactively. If --output interactive is requested,
+it falls back to text output.`,
+		Example: `  # Preview (no changes made)
+  render env-groups unlink shared-config my-api
+
+  # Unlink
+  render env-groups unlink shared-config my-api --confirm`,
+	}
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		command.DefaultFormatNonInteractive(cmd)
+
+		var input envgrouptypes.LinkInput
+		if err := command.ParseCommand(cmd, args, &input); err != nil {
+			return err
+		}
+		confirm := command.GetConfirmFromContext(cmd.Context())
+
+		_, err := command.NonInteractive(cmd, func() (*envgroup.LinkOut, error) {
+			g, err := deps.EnvGroupService().Resolve(cmd.Context(), input.IDOrName)
+			if err != nil {
+				return nil, err
+			}
+			serviceID, err := deps.EnvGroupService().ResolveServiceID(cmd.Context(), input.ServiceIDOrName)
+			if err != nil {
+				return nil, err
+			}
+			out := &envgroup.LinkOut{
+				Data: g,
+				Meta: envgroup.LinkOutMeta{ServiceID: serviceID, Linked: true},
+			}
+			if !confirm {
+				out.Meta.Message = "re-run with --confirm to unlink"
+				return out, nil
+			}
+			if err := deps.EnvGroupRepo().UnlinkService(cmd.Context(), g.Id, serviceID); err != nil {
+				return nil, err
+			}
+			updated, err := deps.EnvGroupRepo().GetEnvGroup(cmd.Context(), g.Id)
+			if err != nil {
+				return nil, err
+			}
+			out.Data = updated
+			out.Meta.Linked = false
+			out.Meta.Applied = true
+			return out, nil
+		}, text.EnvGroupLink)
+		return err
+	}
+
+	return cmd
+}
@@ -0,0 +1,88 @@
+package cmd
+
+import (
+	"slices"
+
+	"github.com/spf13/cobra"
+
+	"github.com/render-oss/cli/pkg/client"
+	"github.com/render-oss/cli/pkg/command"
+	"github.com/render-oss/cli/pkg/dependencies"
+	"github.com/render-oss/cli/pkg/envgroup"
+	"github.com/render-oss/cli/pkg/service"
+	"github.com/render-oss/cli/pkg/text"
+	envgrouptypes "github.com/render-oss/cli/pkg/types/envgroup"
+	servicetypes "github.com/render-oss/cli/pkg/types/service"
+)
+
+func newEnvGroupsUnsetCmd(deps *dependencies.Dependencies) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "unset <envGroupID|envGroupName> <KEY>...",
+		Short:        "Remove variables from an environment group",
+		Args:         cobra.MinimumNArgs(2),
+		SilenceUsage: true,
+		Long: `Remove one or more environment variables from an environment group.
+
+Without --confirm, this command previews which keys would be removed and makes
+no changes. If any key is not set on the group, the command fails before
+removing anything.
+
+This command only runs non-interactively. If --output interactive is requested,
+it falls back to text output.`,
+		Example: `  # Preview (no changes made)
+  render env-groups unset shared-config LEGACY_TOKEN
+
+  # Remove two variables
+  render env-groups unset shared-config LEGACY_TOKEN OLD_FLAG --confirm`,
+	}
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		command.DefaultFormatNonInteractive(cmd)
+
+		var input envgrouptypes.UnsetInput
+		if err := command.ParseCommand(cmd, args, &input); err != nil {
+			return err
+		}
+		keys, err := servicetypes.ParseEnvVarKeys(args[1:])
+		if err != nil {
+			return err
+		}
+		confirm := command.GetConfirmFromContext(cmd.Context())
+
+		_, err = command.NonInteractive(cmd, func() (*envgroup.EnvVarChangeOut, error) {
+			g, err := deps.EnvGroupService().Resolve(cmd.Context(), input.IDOrName)
+			if err != nil {
+				return nil, err
+			}
+			before := g.EnvVars
+			if err := requireEnvVarKeysSet("env group "+g.Name, before, keys); err != nil {
+				return nil, err
+			}
+
+			if !confirm {
+				remaining := slices.DeleteFunc(slices.Clone(before), func(ev client.EnvVar) bool {
+					return slices.Contains(keys, ev.Key)
+				})
+				service.SortEnvVars(g.EnvVars)
+				return &envgroup.EnvVarChangeOut{
+					Data: g,
+					Meta: envgroup.EnvVarChangeOutMeta{
+						Changes:     service.DiffEnvVars(before, remaining),
+						SecretFiles: []string{},
+						Message:     "re-run with --confirm to unset",
+					},
+				}, nil
+			}
+
+			for _, key := range keys {
+				if err := deps.EnvGroupRepo().DeleteEnvVar(cmd.Context(), g.Id, key); err != nil {
+					return nil, err
+				}
+			}
+			return envGroupChangeOutAfterApply(cmd, deps, g.Id, before, []string{})
+		}, text.EnvGroupEnvVarChanges)
+		return err
+	}
+
+	return cmd
+}
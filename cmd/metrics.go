@@ -0,0 +1,84 @@
+package cmd
+
+import (
+	"context"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/spf13/cobra"
+
+	"github.com/render-oss/cli/pkg/command"
+	"github.com/render-oss/cli/pkg/dependencies"
+	"github.com/render-oss/cli/pkg/metrics"
+	"github.com/render-oss/cli/pkg/text"
+	"github.com/render-oss/cli/pkg/tui/views"
+)
+
+func newMetricsCmd(deps *dependencies.Dependencies) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "metrics <resourceID|resourceName>",
+		Short: "View metrics for services and datastores",
+		Long: `View CPU, memory, HTTP, and other metrics for a service, Postgres database, or
+Key Value instance.
+
+By default, CPU and memory usage for the last hour are shown. Use --metric to
+choose metrics and --start/--end to change the time range. Each instance is
+reported as a separate series.
+
+In interactive mode, each series is drawn as a chart. Use shift+left/right to
+switch between metrics and r to reload. In text mode, each series is summarized
+with a sparkline.
+
+Available metrics: ` + strings.Join(metrics.Names(), ", ") + `
+
+HTTP metrics, bandwidth, and instance count are only available for services.
+Active connections are available for Postgres and Key Value, and replication lag
+for Postgres.`,
+		GroupID: GroupCore.ID,
+		Args:    cobra.ExactArgs(1),
+		Example: `  # Show CPU and memory charts for a service
+  render metrics srv-abc123
+
+  # Show HTTP latency and request counts over the last 6 hours
+  render metrics my-api --metric http-latency,http-requests --start 6h
+
+  # Output memory usage as JSON with 5 minute resolution
+  render metrics srv-abc123 --metric memory --resolution 300 --output json`,
+	}
+
+	cmd.Flags().Var(command.NewEnumInput(metrics.Names(), true), "metric", "Comma-separated metrics to show (default cpu,memory)")
+	cmd.Flags().Var(command.NewTimeInput(), "start", "Start of the time range (default 1 hour ago)")
+	cmd.Flags().Var(command.NewTimeInput(), "end", "End of the time range (default now)")
+	cmd.Flags().Int("resolution", 0, "Seconds between datapoints (default chosen by the API)")
+	setAllFlagPlaceholders(cmd, map[string]string{
+		"metric":     "METRICS",
+		"start":      "TIME",
+		"end":        "TIME",
+		"resolution": "SECONDS",
+	})
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		var input views.MetricsInput
+		if err := command.ParseCommand(cmd, args, &input); err != nil {
+			return err
+		}
+
+		if nonInteractive, err := command.NonInteractive(cmd, func() (*metrics.Out, error) {
+			return deps.MetricsLoader().LoadMetrics(cmd.Context(), input)
+		}, text.Metrics); err != nil {
+			return err
+		} else if nonInteractive {
+			return nil
+		}
+
+		InteractiveMetrics(cmd.Context(), deps, input, "Metrics")
+		return nil
+	}
+
+	return cmd
+}
+
+func InteractiveMetrics(ctx context.Context, deps *dependencies.Dependencies, input views.MetricsInput, breadcrumb string) tea.Cmd {
+	return command.AddToStackFunc(ctx, deps.MetricsCmd(), breadcrumb, &input,
+		views.NewMetricsView(ctx, deps.MetricsLoader().LoadMetrics, input))
+}
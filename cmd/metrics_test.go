@@ -0,0 +1,117 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	renderapi "github.com/render-oss/cli/internal/fakes/renderapi"
+	"github.com/render-oss/cli/internal/testids"
+	metricsclient "github.com/render-oss/cli/pkg/client/metrics"
+	"github.com/render-oss/cli/pkg/dependencies"
+	"github.com/render-oss/cli/pkg/metrics"
+)
+
+func executeMetrics(t *testing.T, server *renderapi.Server, args ...string) (CommandResult, error) {
+	t.Helper()
+	return executeInWorkspace(t, server, "", func(root *cobra.Command, deps *dependencies.Dependencies) {
+		deps.Commands.Metrics.MetricsCmd = newMetricsCmd(deps)
+		root.AddCommand(deps.Commands.Metrics.MetricsCmd)
+	}, append([]string{"metrics"}, args...)...)
+}
+
+func instanceSeries(instance, unit string, values ...float32) metricsclient.TimeSeries {
+	start := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	ts := metricsclient.TimeSeries{
+		Labels: []metricsclient.Label{{Field: "instance", Value: instance}},
+		Unit:   unit,
+	}
+	for i, v := range values {
+		ts.Values = append(ts.Values, metricsclient.TimeSeriesValue{
+			Timestamp: start.Add(time.Duration(i) * time.Minute),
+			Value:     v,
+		})
+	}
+	return ts
+}
+
+func TestMetrics_JSONOutputIncludesEachRequestedMetric(t *testing.T) {
+	server := renderapi.NewServer(t)
+	serviceID := testids.ServiceID("api")
+	server.Metrics.Set("cpu", serviceID, instanceSeries("api-abc", "cpu", 0.1, 0.2))
+	server.Metrics.Set("http-latency", serviceID, instanceSeries("api-abc", "ms", 40, 55))
+
+	result, err := executeMetrics(t, server, serviceID, "--metric", "cpu,http-latency", "--resolution", "60", "--output", "json")
+	require.NoError(t, err, "stderr: %s", result.Stderr)
+
+	var out metrics.Out
+	require.NoError(t, json.Unmarshal([]byte(result.Stdout), &out), result.Stdout)
+	require.Len(t, out.Data, 2)
+	assert.Equal(t, metrics.CPU, out.Data[0].Metric)
+	assert.Equal(t, metrics.HTTPLatency, out.Data[1].Metric)
+	require.Len(t, out.Data[1].Series, 1)
+	assert.Len(t, out.Data[1].Series[0].Values, 2)
+	assert.Equal(t, serviceID, out.Meta.ResourceID)
+	assert.Equal(t, 60, out.Meta.ResolutionSeconds)
+
+	var latencyRequest *renderapi.RecordedRequest
+	for i, r := range server.Requests {
+		if r.Method == "GET" && bytes.HasPrefix([]byte(r.URI), []byte("/metrics/http-latency")) {
+			latencyRequest = &server.Requests[i]
+		}
+	}
+	require.NotNil(t, latencyRequest)
+	assert.Contains(t, latencyRequest.URI, "quantile=0.95")
+	assert.Contains(t, latencyRequest.URI, "resolutionSeconds=60")
+}
+
+func TestMetrics_TextOutputSummarizesSeries(t *testing.T) {
+	server := renderapi.NewServer(t)
+	serviceID := testids.ServiceID("api")
+	server.Metrics.Set("cpu", serviceID,
+		instanceSeries("api-abc", "cpu", 0.1, 0.5, 0.3),
+		instanceSeries("api-def", "cpu", 0.2, 0.2, 0.4),
+	)
+
+	result, err := executeMetrics(t, server, serviceID, "--metric", "cpu", "--output", "text")
+	require.NoError(t, err, "stderr: %s", result.Stderr)
+
+	assert.Contains(t, result.Stdout, "CPU")
+	assert.Contains(t, result.Stdout, "api-abc")
+	assert.Contains(t, result.Stdout, "api-def")
+	assert.Contains(t, result.Stdout, "▁█")
+}
+
+func TestMetrics_TextOutputWithNoData(t *testing.T) {
+	server := renderapi.NewServer(t)
+	serviceID := testids.ServiceID("api")
+
+	result, err := executeMetrics(t, server, serviceID, "--metric", "memory", "--output", "text")
+	require.NoError(t, err, "stderr: %s", result.Stderr)
+
+	assert.Contains(t, result.Stdout, "No data in this time range")
+}
+
+func TestMetrics_RejectsMetricUnsupportedForResource(t *testing.T) {
+	server := renderapi.NewServer(t)
+
+	_, err := executeMetrics(t, server, testids.PostgresID("db"), "--metric", "http-requests", "--output", "json")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "http-requests")
+
+	for _, r := range server.Requests {
+		assert.NotContains(t, r.URI, "/metrics/", "no metrics should be queried")
+	}
+}
+
+func TestMetrics_RejectsUnknownMetric(t *testing.T) {
+	server := renderapi.NewServer(t)
+
+	_, err := executeMetrics(t, server, testids.ServiceID("api"), "--metric", "disk", "--output", "json")
+	require.Error(t, err)
+}
@@ -3,6 +3,7 @@ package cmd
 import (
 	"bytes"
 	"encoding/json"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -18,6 +19,13 @@ import (
 var pgActiveWorkspaceID = testids.WorkspaceID("active")
 
 func executePGCommand(t *testing.T, server *renderapi.Server, args ...string) (CommandResult, error) {
+	t.Helper()
+	return executePGCommandWithStdin(t, server, "", args...)
+}
+
+// executePGCommandWithStdin runs a pg command with stdin as the user's input
+// to any confirmation prompt.
+func executePGCommandWithStdin(t *testing.T, server *renderapi.Server, stdin string, args ...string) (CommandResult, error) {
 	t.Helper()
 	t.Setenv("RENDER_CLI_CONFIG_PATH", newTestConfigPath(t))
 	t.Setenv("RENDER_API_KEY", "test-api-key")
@@ -38,6 +46,7 @@ func executePGCommand(t *testing.T, server *renderapi.Server, args ...string) (C
 	setupRootCmdPersistentRun(root, deps)
 
 	var stdout, stderr bytes.Buffer
+	root.SetIn(strings.NewReader(stdin))
 	root.SetOut(&stdout)
 	root.SetErr(&stderr)
 	root.SetArgs(args)
@@ -46,6 +55,16 @@ func executePGCommand(t *testing.T, server *renderapi.Server, args ...string) (C
 	return CommandResult{Stdout: stdout.String(), Stderr: stderr.String()}, execErr
 }
 
+// executePGCommandInWorkspace runs a pg command with the seeded active
+// workspace selected and stdin as the user's input to any confirmation prompt.
+func executePGCommandInWorkspace(t *testing.T, server *renderapi.Server, stdin string, args ...string) (CommandResult, error) {
+	t.Helper()
+	server.Owners.Add(renderapi.NewOwner(client.Owner{Id: pgActiveWorkspaceID, Name: "Test Workspace"}))
+	t.Setenv("RENDER_WORKSPACE", pgActiveWorkspaceID)
+
+	return executePGCommandWithStdin(t, server, stdin, args...)
+}
+
 // seedPG adds a new Postgres database to the seeded active workspace.
 func seedPG(server *renderapi.Server, name string) *client.PostgresDetail {
 	return server.Postgres.Add(renderapi.NewPostgres(client.PostgresDetail{
@@ -0,0 +1,65 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/render-oss/cli/pkg/utils"
+)
+
+func newPgExportCmd(children ...*cobra.Command) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "export",
+		Aliases: []string{"exports"},
+		Short:   "Create, list, and download logical exports of a Render Postgres database",
+		Long: `Create, list, and download logical exports of a Render Postgres database.
+
+An export is a pg_dump of the database that Render stores for you to download.
+Exports run in the background: create one, wait for 'render pg export list' to
+show it as ready, then download it.
+
+Each subcommand takes the database's ID (dpg-...) or name. If the name matches
+more than one database, narrow the search with --project <id|name>,
+--environment <id|name>, or pass the Postgres ID directly.`,
+	}
+	cmd.AddCommand(children...)
+	return cmd
+}
+
+// downloadProgress reports how much of a download has been written, redrawing
+// a single line at most every progressInterval.
+type downloadProgress struct {
+	w       io.Writer
+	total   int64
+	written int64
+	last    time.Time
+}
+
+const progressInterval = 100 * time.Millisecond
+
+func (p *downloadProgress) Write(b []byte) (int, error) {
+	p.written += int64(len(b))
+	if time.Since(p.last) >= progressInterval {
+		p.print()
+	}
+	return len(b), nil
+}
+
+func (p *downloadProgress) print() {
+	p.last = time.Now()
+	if p.total > 0 {
+		fmt.Fprintf(p.w, "\r\033[KDownloading: %s of %s (%d%%)",
+			utils.FormatBytes(p.written), utils.FormatBytes(p.total), p.written*100/p.total)
+		return
+	}
+	fmt.Fprintf(p.w, "\r\033[KDownloading: %s", utils.FormatBytes(p.written))
+}
+
+// done prints the final count and ends the progress line.
+func (p *downloadProgress) done() {
+	p.print()
+	fmt.Fprintln(p.w)
+}
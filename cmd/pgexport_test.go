@@ -0,0 +1,122 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	renderapi "github.com/render-oss/cli/internal/fakes/renderapi"
+	"github.com/render-oss/cli/internal/testrequire"
+	pgclient "github.com/render-oss/cli/pkg/client/postgres"
+)
+
+func executePGExport(t *testing.T, server *renderapi.Server, args ...string) (CommandResult, error) {
+	t.Helper()
+	return executePGCommandInWorkspace(t, server, "", append([]string{"pg", "export"}, args...)...)
+}
+
+func TestPGExportCreate(t *testing.T) {
+	server := renderapi.NewServer(t)
+	pg := seedPG(server, "my-db")
+
+	result, err := executePGExport(t, server, "create", "my-db", "--output", "text")
+	require.NoError(t, err, "stderr: %s", result.Stderr)
+
+	assert.Contains(t, result.Stdout, "Started an export of Postgres database my-db")
+	assert.True(t, server.HasRequest("POST", "/postgres/"+pg.Id+"/export"))
+	assert.Len(t, server.Postgres.Exports[pg.Id], 1)
+}
+
+func TestPGExportList(t *testing.T) {
+	server := renderapi.NewServer(t)
+	pg := seedPG(server, "my-db")
+	older := server.Postgres.AddExport(pg.Id, pgclient.PostgresExport{
+		Id:        "exp-old",
+		CreatedAt: time.Now().Add(-48 * time.Hour),
+		Url:       server.ExportURL("exp-old"),
+	})
+	newer := server.Postgres.AddExport(pg.Id, pgclient.PostgresExport{Id: "exp-new"})
+
+	t.Run("text", func(t *testing.T) {
+		result, err := executePGExport(t, server, "list", "my-db", "--output", "text")
+		require.NoError(t, err, "stderr: %s", result.Stderr)
+
+		assert.Contains(t, result.Stdout, "in progress")
+		assert.Contains(t, result.Stdout, "ready")
+		assert.Less(t, strings.Index(result.Stdout, newer.Id), strings.Index(result.Stdout, older.Id), "expected the newest export first")
+	})
+
+	t.Run("json", func(t *testing.T) {
+		result, err := executePGExport(t, server, "list", pg.Id, "--output", "json")
+		require.NoError(t, err, "stderr: %s", result.Stderr)
+
+		body := unmarshalPGJSONOutput(t, result.Stdout)
+		exports, ok := body["data"].([]any)
+		require.True(t, ok, "expected data to be a list")
+		require.Len(t, exports, 2)
+		assert.Equal(t, "exp-old", exports[1].(map[string]any)["id"])
+		assert.Equal(t, *older.Url, exports[1].(map[string]any)["url"])
+		assert.Equal(t, pg.Id, testrequire.SubMap(t, body, "meta")["postgresId"])
+	})
+}
+
+func TestPGExportDownload(t *testing.T) {
+	t.Run("downloads the newest ready export", func(t *testing.T) {
+		server := renderapi.NewServer(t)
+		pg := seedPG(server, "my-db")
+		server.Postgres.AddExport(pg.Id, pgclient.PostgresExport{
+			Id:        "exp-1",
+			CreatedAt: time.Now().Add(-time.Hour),
+			Url:       server.ExportURL("exp-1"),
+		})
+		server.Postgres.AddExport(pg.Id, pgclient.PostgresExport{Id: "exp-2", Url: server.ExportURL("exp-2")})
+		server.Postgres.AddExport(pg.Id, pgclient.PostgresExport{Id: "exp-3", CreatedAt: time.Now().Add(time.Minute)})
+		t.Chdir(t.TempDir())
+
+		result, err := executePGExport(t, server, "download", "my-db", "--output", "text")
+		require.NoError(t, err, "stderr: %s", result.Stderr)
+
+		assert.Contains(t, result.Stdout, "Downloaded export exp-2 of my-db to export.dir.tar.gz")
+		contents, err := os.ReadFile("export.dir.tar.gz")
+		require.NoError(t, err)
+		assert.Equal(t, renderapi.ExportContents("exp-2"), string(contents))
+	})
+
+	t.Run("downloads a chosen export to a chosen file", func(t *testing.T) {
+		server := renderapi.NewServer(t)
+		pg := seedPG(server, "my-db")
+		server.Postgres.AddExport(pg.Id, pgclient.PostgresExport{Id: "exp-1", Url: server.ExportURL("exp-1")})
+		server.Postgres.AddExport(pg.Id, pgclient.PostgresExport{Id: "exp-2", Url: server.ExportURL("exp-2")})
+		path := filepath.Join(t.TempDir(), "backup.tar.gz")
+
+		result, err := executePGExport(t, server, "download", pg.Id, "--export", "exp-1", "--file", path, "--output", "json")
+		require.NoError(t, err, "stderr: %s", result.Stderr)
+
+		data := testrequire.SubMap(t, unmarshalPGJSONOutput(t, result.Stdout), "data")
+		assert.Equal(t, "exp-1", data["exportId"])
+		assert.Equal(t, path, data["localPath"])
+		assert.InDelta(t, len(renderapi.ExportContents("exp-1")), data["sizeBytes"], 0)
+		contents, err := os.ReadFile(path)
+		require.NoError(t, err)
+		assert.Equal(t, renderapi.ExportContents("exp-1"), string(contents))
+	})
+
+	t.Run("fails when no export is ready", func(t *testing.T) {
+		server := renderapi.NewServer(t)
+		pg := seedPG(server, "my-db")
+		server.Postgres.AddExport(pg.Id, pgclient.PostgresExport{Id: "exp-1"})
+		path := filepath.Join(t.TempDir(), "backup.tar.gz")
+
+		_, err := executePGExport(t, server, "download", "my-db", "--file", path, "--output", "json")
+		require.ErrorContains(t, err, "no exports are ready to download")
+		assert.NoFileExists(t, path)
+
+		_, err = executePGExport(t, server, "download", "my-db", "--export", "exp-1", "--file", path, "--output", "json")
+		require.ErrorContains(t, err, "export exp-1 is not ready to download yet")
+	})
+}
@@ -0,0 +1,55 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/render-oss/cli/pkg/command"
+	"github.com/render-oss/cli/pkg/dependencies"
+	"github.com/render-oss/cli/pkg/postgres"
+	"github.com/render-oss/cli/pkg/text"
+	pgtypes "github.com/render-oss/cli/pkg/types/postgres"
+)
+
+func newPgExportCreateCmd(deps *dependencies.Dependencies) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "create <postgresID|postgresName>",
+		Short:        "Start an export of a Render Postgres database",
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+		Long: `Start a logical export of a Render Postgres database. The export runs in the
+background; check 'render pg export list' to see when it is ready.`,
+		Example: `  # Start an export
+  render pg export create my-db`,
+	}
+	addPgLookupFlags(cmd, nil)
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		command.DefaultFormatNonInteractive(cmd)
+
+		var input pgtypes.ExportInput
+		if err := command.ParseCommand(cmd, args, &input); err != nil {
+			return err
+		}
+		input = pgtypes.NormalizeExportInput(input)
+
+		_, err := command.NonInteractive(cmd, func() (*postgres.ExportCreateOut, error) {
+			resolved, err := deps.PostgresService().Resolve(cmd.Context(), postgres.ResolveInput{
+				IDOrName:            input.IDOrName,
+				ProjectIDOrName:     input.ProjectIDOrName,
+				EnvironmentIDOrName: input.EnvironmentIDOrName,
+			})
+			if err != nil {
+				return nil, err
+			}
+			if err := deps.PostgresService().CreateExport(cmd.Context(), resolved.Postgres.Id); err != nil {
+				return nil, err
+			}
+			return &postgres.ExportCreateOut{
+				Meta: postgres.ExportCreateOutMeta{TargetMeta: postgres.NewTargetMeta(resolved), Started: true},
+			}, nil
+		}, text.PostgresExportCreate)
+		return err
+	}
+
+	return cmd
+}
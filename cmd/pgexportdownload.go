@@ -0,0 +1,124 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/render-oss/cli/pkg/command"
+	"github.com/render-oss/cli/pkg/dependencies"
+	"github.com/render-oss/cli/pkg/pointers"
+	"github.com/render-oss/cli/pkg/postgres"
+	"github.com/render-oss/cli/pkg/text"
+	pgtypes "github.com/render-oss/cli/pkg/types/postgres"
+)
+
+func newPgExportDownloadCmd(deps *dependencies.Dependencies) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "download <postgresID|postgresName>",
+		Short:        "Download an export of a Render Postgres database",
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+		Long: `Download an export of a Render Postgres database to a local file.
+
+By default, the newest ready export is downloaded. Pass --export to pick another
+one. The file is named after the export unless you pass --file. Progress is
+shown on stderr when it is a terminal.`,
+		Example: `  # Download the newest export into the current directory
+  render pg export download my-db
+
+  # Download a specific export to a chosen path
+  render pg export download my-db --export exp-abc123 --file ./backups/my-db.dir.tar.gz`,
+	}
+	cmd.Flags().String("export", "", "ID of the export to download (default: the newest ready export)")
+	cmd.Flags().StringP("file", "f", "", "Path to write the export to (default: the export's file name in the current directory)")
+	_ = cmd.MarkFlagFilename("file")
+	addPgLookupFlags(cmd, map[string]string{
+		"export": "EXPORT_ID",
+		"file":   "PATH",
+	})
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		command.DefaultFormatNonInteractive(cmd)
+
+		var input pgtypes.ExportDownloadInput
+		if err := command.ParseCommand(cmd, args, &input); err != nil {
+			return err
+		}
+		input = pgtypes.NormalizeExportDownloadInput(input)
+
+		_, err := command.NonInteractive(cmd, func() (*postgres.ExportDownloadOut, error) {
+			return downloadPgExport(cmd, deps, input)
+		}, text.PostgresExportDownload)
+		return err
+	}
+
+	return cmd
+}
+
+func downloadPgExport(cmd *cobra.Command, deps *dependencies.Dependencies, input pgtypes.ExportDownloadInput) (*postgres.ExportDownloadOut, error) {
+	ctx := cmd.Context()
+	svc := deps.PostgresService()
+
+	resolved, err := svc.Resolve(ctx, postgres.ResolveInput{
+		IDOrName:            input.IDOrName,
+		ProjectIDOrName:     input.ProjectIDOrName,
+		EnvironmentIDOrName: input.EnvironmentIDOrName,
+	})
+	if err != nil {
+		return nil, err
+	}
+	export, err := svc.FindExport(ctx, resolved.Postgres.Id, pointers.StringValue(input.ExportID))
+	if err != nil {
+		return nil, err
+	}
+
+	path := input.FilePath
+	if path == "" {
+		path = postgres.ExportFileName(export)
+	}
+	path, err = command.ExpandPath(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve file path: %w", err)
+	}
+
+	body, size, err := svc.OpenExport(ctx, export)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	file, err := os.Create(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create output file: %w", err)
+	}
+
+	var src io.Reader = body
+	var progress *downloadProgress
+	if signals, err := deps.DetectRuntimeSignals(); err == nil && signals.StderrTTY {
+		progress = &downloadProgress{w: cmd.ErrOrStderr(), total: size}
+		src = io.TeeReader(body, progress)
+	}
+
+	written, err := io.Copy(file, src)
+	if progress != nil {
+		progress.done()
+	}
+	if err == nil {
+		err = file.Close()
+	} else {
+		file.Close()
+	}
+	if err != nil {
+		// Clean up the partial file so it isn't mistaken for a complete export.
+		os.Remove(path)
+		return nil, fmt.Errorf("failed to write export: %w", err)
+	}
+
+	return &postgres.ExportDownloadOut{
+		Data: postgres.ExportDownload{ExportID: export.Id, LocalPath: path, SizeBytes: written},
+		Meta: postgres.NewTargetMeta(resolved),
+	}, nil
+}
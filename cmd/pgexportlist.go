@@ -0,0 +1,57 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/render-oss/cli/pkg/command"
+	"github.com/render-oss/cli/pkg/dependencies"
+	"github.com/render-oss/cli/pkg/postgres"
+	"github.com/render-oss/cli/pkg/text"
+	pgtypes "github.com/render-oss/cli/pkg/types/postgres"
+)
+
+func newPgExportListCmd(deps *dependencies.Dependencies) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "list <postgresID|postgresName>",
+		Short:        "List the exports of a Render Postgres database",
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+		Long: `List the exports of a Render Postgres database, newest first. An export is
+ready once it has a download URL, which JSON and YAML output include.`,
+		Example: `  # List exports
+  render pg export list my-db
+
+  # Get the newest export's download URL
+  render pg export list my-db --output json | jq -r '.data[0].url'`,
+	}
+	addPgLookupFlags(cmd, nil)
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		command.DefaultFormatNonInteractive(cmd)
+
+		var input pgtypes.ExportInput
+		if err := command.ParseCommand(cmd, args, &input); err != nil {
+			return err
+		}
+		input = pgtypes.NormalizeExportInput(input)
+
+		_, err := command.NonInteractive(cmd, func() (*postgres.ExportListOut, error) {
+			resolved, err := deps.PostgresService().Resolve(cmd.Context(), postgres.ResolveInput{
+				IDOrName:            input.IDOrName,
+				ProjectIDOrName:     input.ProjectIDOrName,
+				EnvironmentIDOrName: input.EnvironmentIDOrName,
+			})
+			if err != nil {
+				return nil, err
+			}
+			exports, err := deps.PostgresService().ListExports(cmd.Context(), resolved.Postgres.Id)
+			if err != nil {
+				return nil, err
+			}
+			return &postgres.ExportListOut{Data: exports, Meta: postgres.NewTargetMeta(resolved)}, nil
+		}, text.PostgresExportTable)
+		return err
+	}
+
+	return cmd
+}
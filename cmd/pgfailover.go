@@ -0,0 +1,92 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/render-oss/cli/pkg/client"
+	"github.com/render-oss/cli/pkg/command"
+	"github.com/render-oss/cli/pkg/dependencies"
+	"github.com/render-oss/cli/pkg/postgres"
+	"github.com/render-oss/cli/pkg/text"
+	pgtypes "github.com/render-oss/cli/pkg/types/postgres"
+)
+
+func newPgFailoverCmd(deps *dependencies.Dependencies) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "failover <postgresID|postgresName>",
+		Short:        "Fail over a highly available Render Postgres database to its standby",
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+		Long: `Fail over a highly available Render Postgres database: its standby is promoted
+to primary and takes over the database's connection URLs. Open connections
+are dropped while it takes over.
+
+Before failing over, the command checks that the database is a primary on a
+Pro or Accelerated plan, has high availability enabled, and is available. It
+also checks that the database reported replication lag for its standby in
+the last 5 minutes. The standby has no metrics of its own, so this only shows
+it was streaming changes recently, not that it is healthy now. Pass
+--skip-standby-check to skip that check, e.g. when metrics are unavailable.
+
+You are asked to type the database's name to confirm. Pass --confirm to skip
+the prompt in scripts.
+
+With --wait, the command re-checks the database until the failover is under
+way and the database is available again, or --timeout seconds pass. It exits non-zero on timeout.`,
+		Example: `  # Fail over, typing the database name to confirm
+  render pg failover my-db
+
+  # Fail over from a runbook and wait up to 10 minutes for it to finish
+  render pg failover dpg-abc123def456ghi789jkl0 --confirm --wait --timeout 600`,
+	}
+	addPgHAWaitFlags(cmd, "Wait for the database to be available after failing over, and exit non-zero if it isn't before the timeout")
+	addPgStandbyCheckFlag(cmd, "Fail over without checking that the standby has recently reported replication lag")
+	addPgLookupFlags(cmd, map[string]string{"timeout": "SECONDS"})
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		command.DefaultFormatNonInteractive(cmd)
+
+		var input pgtypes.HAInput
+		if err := command.ParseCommand(cmd, args, &input); err != nil {
+			return err
+		}
+		input = pgtypes.NormalizeHAInput(input)
+		target := &pgHATarget{
+			deps: deps,
+			input: postgres.ResolveInput{
+				IDOrName:            input.IDOrName,
+				ProjectIDOrName:     input.ProjectIDOrName,
+				EnvironmentIDOrName: input.EnvironmentIDOrName,
+			},
+			op:               postgres.HAFailover,
+			skipStandbyCheck: input.SkipStandbyCheck,
+		}
+
+		var result *postgres.HAOut
+		loadData := func() (*postgres.HAOut, error) {
+			resolved, err := target.resolve(cmd.Context())
+			if err != nil {
+				return nil, err
+			}
+			pg, err := deps.PostgresService().Failover(cmd.Context(), resolved.Postgres.Id)
+			if err != nil {
+				return nil, err
+			}
+			result, err = pgHAOut(cmd.Context(), deps, resolved, pg, postgres.HAFailover, input.Wait, pgtypes.HAWaitTimeout(input.Timeout))
+			return result, err
+		}
+
+		confirm := target.confirmation(cmd.Context(), func(pg *client.PostgresDetail) string {
+			return fmt.Sprintf("This will fail over Postgres database %s (%s) to its standby. Open connections are dropped while the standby takes over as primary.",
+				pg.Name, pg.Id)
+		})
+		if _, err := command.NonInteractiveWithTypedConfirm(cmd, loadData, text.PostgresHA, confirm); err != nil {
+			return err
+		}
+		return exitIfPgHAWaitTimedOut(cmd, result)
+	}
+
+	return cmd
+}
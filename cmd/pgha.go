@@ -0,0 +1,135 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/render-oss/cli/pkg/client"
+	"github.com/render-oss/cli/pkg/command"
+	"github.com/render-oss/cli/pkg/dependencies"
+	"github.com/render-oss/cli/pkg/metrics"
+	"github.com/render-oss/cli/pkg/pointers"
+	"github.com/render-oss/cli/pkg/postgres"
+	pgtypes "github.com/render-oss/cli/pkg/types/postgres"
+)
+
+// pgHAPollInterval is how often --wait re-checks a database's status after a
+// failover, promotion, or replication restart. Tests shorten it.
+var pgHAPollInterval = 10 * time.Second
+
+// addPgHAWaitFlags adds --wait and --timeout to a high-availability command.
+func addPgHAWaitFlags(cmd *cobra.Command, waitUsage string) {
+	cmd.Flags().Bool("wait", false, waitUsage)
+	cmd.Flags().Int("timeout", pgtypes.DefaultHAWaitTimeoutSeconds, "Seconds to wait with --wait")
+}
+
+// addPgStandbyCheckFlag adds --skip-standby-check to a command whose
+// operation hands over to a standby or read replica.
+func addPgStandbyCheckFlag(cmd *cobra.Command, usage string) {
+	cmd.Flags().Bool("skip-standby-check", false, usage)
+}
+
+// pgHATarget resolves a database and runs the operation's pre-flight checks
+// at most once, so the confirmation prompt and the operation it guards act
+// on the same database.
+type pgHATarget struct {
+	deps             *dependencies.Dependencies
+	input            postgres.ResolveInput
+	op               postgres.HAOperation
+	skipStandbyCheck bool
+	resolved         *postgres.ResolvedPostgres
+}
+
+func (t *pgHATarget) resolve(ctx context.Context) (*postgres.ResolvedPostgres, error) {
+	if t.resolved == nil {
+		resolved, err := t.deps.PostgresService().Resolve(ctx, t.input)
+		if err != nil {
+			return nil, err
+		}
+		if err := t.op.Check(resolved.Postgres); err != nil {
+			return nil, err
+		}
+		if !t.skipStandbyCheck {
+			if err := t.checkStandby(ctx, resolved.Postgres); err != nil {
+				return nil, err
+			}
+		}
+		t.resolved = resolved
+	}
+	return t.resolved, nil
+}
+
+// checkStandby checks from pg's recent replication lag that the database
+// taking over in the operation is streaming changes.
+func (t *pgHATarget) checkStandby(ctx context.Context, pg *client.PostgresDetail) error {
+	if t.op != postgres.HAFailover && t.op != postgres.HAPromote {
+		return nil
+	}
+	now := time.Now()
+	lag, err := t.deps.MetricsRepo().Query(ctx, metrics.ReplicationLag, metrics.QueryParams{
+		ResourceID: pg.Id,
+		StartTime:  pointers.From(now.Add(-postgres.StandbyStatusWindow)),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to check replication lag: %w; pass --skip-standby-check to skip this check", err)
+	}
+	return t.op.CheckStandby(pg, lag, now)
+}
+
+// confirmation returns a typed confirmation that asks the user to enter the
+// database's name before the operation runs. message describes what the
+// operation will do to the database.
+func (t *pgHATarget) confirmation(ctx context.Context, message func(*client.PostgresDetail) string) command.TypedConfirmFunc {
+	return func() (string, string, error) {
+		resolved, err := t.resolve(ctx)
+		if err != nil {
+			return "", "", err
+		}
+		return message(resolved.Postgres), resolved.Postgres.Name, nil
+	}
+}
+
+// pgHAOut describes an operation that started on the resolved database,
+// whose state is now pg. With wait, it first waits for the database to
+// become healthy.
+func pgHAOut(ctx context.Context, deps *dependencies.Dependencies, resolved *postgres.ResolvedPostgres, pg *client.PostgresDetail, op postgres.HAOperation, wait bool, timeout time.Duration) (*postgres.HAOut, error) {
+	if !wait {
+		out := postgres.NewHAOut(resolved, pg, op)
+		return &out, nil
+	}
+
+	latest, err := deps.PostgresService().WaitForHealthy(ctx, pg, op, timeout, pgHAPollInterval)
+	timedOut := errors.Is(err, postgres.ErrHAWaitTimeout)
+	if err != nil && !timedOut {
+		return nil, err
+	}
+	if latest == nil {
+		latest = pg
+	}
+
+	out := postgres.NewHAOut(resolved, latest, op)
+	out.Meta.Waited = true
+	out.Meta.Healthy = !timedOut
+	if timedOut {
+		out.Meta.TimedOut = true
+		out.Meta.Message = fmt.Sprintf(
+			"Timed out after %s waiting for Postgres database %s to become healthy; it is %s. Run 'render pg get %s' to check its status.",
+			timeout, latest.Name, latest.Status, latest.Id,
+		)
+	}
+	return &out, nil
+}
+
+// exitIfPgHAWaitTimedOut makes the command exit non-zero after its output has
+// been printed when --wait gave up before the database became healthy.
+func exitIfPgHAWaitTimedOut(cmd *cobra.Command, out *postgres.HAOut) error {
+	if out == nil || !out.Meta.TimedOut {
+		return nil
+	}
+	cmd.Root().SilenceErrors = true
+	return command.NewExitError(1, nil)
+}
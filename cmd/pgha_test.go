@@ -0,0 +1,294 @@
+package cmd
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	renderapi "github.com/render-oss/cli/internal/fakes/renderapi"
+	"github.com/render-oss/cli/internal/testrequire"
+	"github.com/render-oss/cli/pkg/client"
+	metricsclient "github.com/render-oss/cli/pkg/client/metrics"
+	pgclient "github.com/render-oss/cli/pkg/client/postgres"
+	"github.com/render-oss/cli/pkg/command"
+	"github.com/render-oss/cli/pkg/pointers"
+)
+
+func executePGHA(t *testing.T, server *renderapi.Server, stdin string, args ...string) (CommandResult, error) {
+	t.Helper()
+
+	interval := pgHAPollInterval
+	pgHAPollInterval = 10 * time.Millisecond
+	t.Cleanup(func() { pgHAPollInterval = interval })
+
+	return executePGCommandInWorkspace(t, server, stdin, append([]string{"pg"}, args...)...)
+}
+
+// seedHAPG adds a primary with high availability whose standby has
+// recently reported replication lag.
+func seedHAPG(server *renderapi.Server, name string) *client.PostgresDetail {
+	pg := server.Postgres.Add(renderapi.NewPostgres(client.PostgresDetail{
+		Name:                    name,
+		Owner:                   client.Owner{Id: pgActiveWorkspaceID},
+		Plan:                    pgclient.Pro4gb,
+		Role:                    client.Primary,
+		HighAvailabilityEnabled: true,
+	}))
+	seedReplicationLag(server, pg)
+	return pg
+}
+
+// seedReplicaPG adds a read replica of primary that has recently reported
+// replication lag.
+func seedReplicaPG(server *renderapi.Server, name string, primary *client.PostgresDetail) *client.PostgresDetail {
+	pg := server.Postgres.Add(renderapi.NewPostgres(client.PostgresDetail{
+		Name:              name,
+		Owner:             client.Owner{Id: pgActiveWorkspaceID},
+		Plan:              pgclient.Pro4gb,
+		Role:              client.Replica,
+		PrimaryPostgresID: pointers.From(primary.Id),
+	}))
+	seedReplicationLag(server, pg)
+	return pg
+}
+
+func seedReplicationLag(server *renderapi.Server, pg *client.PostgresDetail) {
+	server.Metrics.Set("replication-lag", pg.Id, metricsclient.TimeSeries{
+		Unit:   "seconds",
+		Values: []metricsclient.TimeSeriesValue{{Timestamp: time.Now(), Value: 0.1}},
+	})
+}
+
+func TestPGFailover(t *testing.T) {
+	t.Run("fails over when the typed name matches", func(t *testing.T) {
+		server := renderapi.NewServer(t)
+		pg := seedHAPG(server, "my-db")
+
+		result, err := executePGHA(t, server, "my-db\n", "failover", "my-db", "--output", "text")
+		require.NoError(t, err, "stderr: %s", result.Stderr)
+
+		assert.Contains(t, result.Stderr, "This will fail over Postgres database my-db")
+		assert.Contains(t, result.Stderr, "Type my-db to confirm")
+		assert.Contains(t, result.Stdout, "Started failover of Postgres database my-db")
+		assert.Equal(t, []renderapi.PostgresHAOperation{{PostgresID: pg.Id, Operation: "failover"}}, server.Postgres.HAOperations)
+	})
+
+	t.Run("aborts when the typed name does not match", func(t *testing.T) {
+		server := renderapi.NewServer(t)
+		seedHAPG(server, "my-db")
+
+		result, err := executePGHA(t, server, "y\n", "failover", "my-db", "--output", "text")
+		require.NoError(t, err, "stderr: %s", result.Stderr)
+
+		assert.Contains(t, result.Stderr, "Aborted")
+		assert.False(t, server.HasRequest("POST", "/failover"))
+	})
+
+	t.Run("pre-flight checks run before the prompt", func(t *testing.T) {
+		server := renderapi.NewServer(t)
+		seedPG(server, "my-db")
+
+		result, err := executePGHA(t, server, "my-db\n", "failover", "my-db", "--output", "text")
+		require.Error(t, err)
+
+		assert.Contains(t, err.Error(), "my-db is on the Free plan, which doesn't support high availability")
+		assert.NotContains(t, result.Stdout, "Type my-db to confirm")
+		assert.False(t, server.HasRequest("POST", "/failover"))
+	})
+
+	t.Run("pre-flight checks run with --confirm", func(t *testing.T) {
+		server := renderapi.NewServer(t)
+		pg := seedHAPG(server, "my-db")
+		pg.HighAvailabilityEnabled = false
+
+		_, err := executePGHA(t, server, "", "failover", "my-db", "--confirm", "--output", "text")
+		require.Error(t, err)
+
+		assert.Contains(t, err.Error(), "my-db doesn't have high availability enabled")
+		assert.False(t, server.HasRequest("POST", "/failover"))
+	})
+
+	t.Run("refuses when the standby hasn't reported replication lag", func(t *testing.T) {
+		server := renderapi.NewServer(t)
+		pg := seedHAPG(server, "my-db")
+		server.Metrics.Set("replication-lag", pg.Id)
+
+		_, err := executePGHA(t, server, "", "failover", "my-db", "--confirm", "--output", "text")
+		require.Error(t, err)
+
+		assert.Contains(t, err.Error(), "my-db hasn't reported replication lag for its standby in the last 5 minutes")
+		assert.False(t, server.HasRequest("POST", "/failover"))
+	})
+
+	t.Run("--skip-standby-check fails over without replication lag", func(t *testing.T) {
+		server := renderapi.NewServer(t)
+		pg := seedHAPG(server, "my-db")
+		server.Metrics.Set("replication-lag", pg.Id)
+
+		result, err := executePGHA(t, server, "", "failover", "my-db", "--confirm", "--skip-standby-check", "--output", "text")
+		require.NoError(t, err, "stderr: %s", result.Stderr)
+
+		assert.Equal(t, []renderapi.PostgresHAOperation{{PostgresID: pg.Id, Operation: "failover"}}, server.Postgres.HAOperations)
+	})
+
+	t.Run("a failed replication lag query points to --skip-standby-check", func(t *testing.T) {
+		server := renderapi.NewServer(t)
+		seedHAPG(server, "my-db")
+		server.Metrics.RespondWith(http.StatusInternalServerError)
+
+		_, err := executePGHA(t, server, "", "failover", "my-db", "--confirm", "--output", "text")
+		require.Error(t, err)
+
+		assert.Contains(t, err.Error(), "failed to check replication lag")
+		assert.Contains(t, err.Error(), "pass --skip-standby-check to skip this check")
+		assert.False(t, server.HasRequest("POST", "/failover"))
+	})
+
+	t.Run("waits until available", func(t *testing.T) {
+		server := renderapi.NewServer(t)
+		seedHAPG(server, "my-db")
+
+		result, err := executePGHA(t, server, "", "failover", "my-db", "--confirm", "--wait", "--output", "json")
+		require.NoError(t, err, "stderr: %s", result.Stderr)
+
+		meta := testrequire.SubMap(t, unmarshalPGJSONOutput(t, result.Stdout), "meta")
+		assert.Equal(t, "failover", meta["operation"])
+		assert.Equal(t, true, meta["waited"])
+		assert.Equal(t, true, meta["healthy"])
+	})
+
+	t.Run("--wait doesn't succeed before the failover is under way", func(t *testing.T) {
+		server := renderapi.NewServer(t)
+		seedHAPG(server, "my-db")
+		server.Postgres.StatusDuringHAOperation = client.DatabaseStatusAvailable
+
+		result, err := executePGHA(t, server, "", "failover", "my-db", "--confirm", "--wait", "--timeout", "1", "--output", "json")
+
+		var exitErr command.ExitCoder
+		require.ErrorAs(t, err, &exitErr)
+		meta := testrequire.SubMap(t, unmarshalPGJSONOutput(t, result.Stdout), "meta")
+		assert.Equal(t, true, meta["timedOut"])
+		assert.Equal(t, false, meta["healthy"])
+	})
+
+	t.Run("--wait times out with a non-zero exit", func(t *testing.T) {
+		server := renderapi.NewServer(t)
+		seedHAPG(server, "my-db")
+		server.Postgres.StatusAfterHAOperation = client.DatabaseStatusUpdatingInstance
+
+		result, err := executePGHA(t, server, "", "failover", "my-db", "--confirm", "--wait", "--timeout", "1", "--output", "json")
+
+		var exitErr command.ExitCoder
+		require.ErrorAs(t, err, &exitErr)
+		assert.Equal(t, 1, exitErr.ExitCode())
+
+		body := unmarshalPGJSONOutput(t, result.Stdout)
+		meta := testrequire.SubMap(t, body, "meta")
+		assert.Equal(t, true, meta["timedOut"])
+		assert.Equal(t, false, meta["healthy"])
+		assert.Contains(t, meta["message"], "Timed out after 1s waiting for Postgres database my-db to become healthy; it is updating_instance")
+		assert.Equal(t, "updating_instance", testrequire.SubMap(t, body, "data")["status"])
+	})
+}
+
+func TestPGPromote(t *testing.T) {
+	t.Run("promotes a replica and waits for the new primary", func(t *testing.T) {
+		server := renderapi.NewServer(t)
+		primary := seedHAPG(server, "my-db")
+		replica := seedReplicaPG(server, "my-replica", primary)
+
+		result, err := executePGHA(t, server, "my-replica\n", "promote", "my-replica", "--wait", "--output", "text")
+		require.NoError(t, err, "stderr: %s", result.Stderr)
+
+		assert.Contains(t, result.Stderr, "It stops replicating from "+primary.Id)
+		assert.Contains(t, result.Stdout, "Promoted my-replica ("+replica.Id+") to a standalone primary; it is available.")
+		assert.Equal(t, client.Primary, replica.Role)
+	})
+
+	t.Run("refuses a primary", func(t *testing.T) {
+		server := renderapi.NewServer(t)
+		seedHAPG(server, "my-db")
+
+		_, err := executePGHA(t, server, "", "promote", "my-db", "--confirm", "--output", "text")
+		require.Error(t, err)
+
+		assert.Contains(t, err.Error(), "my-db is not a read replica; only read replicas can be promoted")
+		assert.False(t, server.HasRequest("POST", "/promote"))
+	})
+
+	t.Run("refuses a replica that hasn't reported replication lag", func(t *testing.T) {
+		server := renderapi.NewServer(t)
+		primary := seedHAPG(server, "my-db")
+		replica := seedReplicaPG(server, "my-replica", primary)
+		server.Metrics.Set("replication-lag", replica.Id)
+
+		_, err := executePGHA(t, server, "", "promote", "my-replica", "--confirm", "--output", "text")
+		require.ErrorContains(t, err, "my-replica hasn't reported replication lag in the last 5 minutes")
+		assert.False(t, server.HasRequest("POST", "/promote"))
+
+		_, err = executePGHA(t, server, "", "promote", "my-replica", "--confirm", "--skip-standby-check", "--output", "text")
+		require.NoError(t, err)
+		assert.Equal(t, client.Primary, replica.Role)
+	})
+}
+
+func TestPGReplicate(t *testing.T) {
+	t.Run("defaults to the public schema of the default database", func(t *testing.T) {
+		server := renderapi.NewServer(t)
+		pg := seedPG(server, "my-db")
+
+		result, err := executePGHA(t, server, "my-db\n", "replicate", "my-db", "--output", "text")
+		require.NoError(t, err, "stderr: %s", result.Stderr)
+
+		assert.Contains(t, result.Stderr, "This will set up logical replication of schema public of database my-db_db on Postgres database my-db")
+		assert.Contains(t, result.Stdout, "The change takes effect the next time the database restarts.")
+		assert.Contains(t, result.Stdout, "Run 'render restart "+pg.Id+"' to restart it now.")
+		assert.Equal(t, []renderapi.PostgresHAOperation{{
+			PostgresID:  pg.Id,
+			Operation:   "replicate",
+			Replication: &pgclient.PostgresReplicationSetupInput{DatabaseName: "my-db_db", SchemaName: "public"},
+		}}, server.Postgres.HAOperations)
+	})
+
+	t.Run("sends names and restarts", func(t *testing.T) {
+		server := renderapi.NewServer(t)
+		seedPG(server, "my-db")
+
+		result, err := executePGHA(t, server, "", "replicate", "my-db",
+			"--database", "app", "--schema", "sales", "--publication", "sales_pub", "--slot", "sales_slot",
+			"--restart", "--wait", "--confirm", "--output", "json")
+		require.NoError(t, err, "stderr: %s", result.Stderr)
+
+		assert.Equal(t, &pgclient.PostgresReplicationSetupInput{
+			DatabaseName:             "app",
+			SchemaName:               "sales",
+			AllTablesPublicationName: pointers.From("sales_pub"),
+			ReplicationSlotName:      pointers.From("sales_slot"),
+			RestartNow:               pointers.From(true),
+		}, server.Postgres.HAOperations[0].Replication)
+		meta := testrequire.SubMap(t, unmarshalPGJSONOutput(t, result.Stdout), "meta")
+		assert.Equal(t, true, meta["healthy"])
+		assert.Equal(t, "sales", testrequire.SubMap(t, meta, "replication")["schemaName"])
+	})
+
+	t.Run("--wait requires --restart", func(t *testing.T) {
+		server := renderapi.NewServer(t)
+		seedPG(server, "my-db")
+
+		_, err := executePGHA(t, server, "", "replicate", "my-db", "--wait", "--confirm", "--output", "text")
+		require.ErrorContains(t, err, "--wait requires --restart")
+		assert.False(t, server.HasRequest("POST", "/replication"))
+	})
+
+	t.Run("refuses a replica", func(t *testing.T) {
+		server := renderapi.NewServer(t)
+		primary := seedHAPG(server, "my-db")
+		seedReplicaPG(server, "my-replica", primary)
+
+		_, err := executePGHA(t, server, "", "replicate", "my-replica", "--confirm", "--output", "text")
+		require.ErrorContains(t, err, "my-replica is a read replica; set up replication on its primary "+primary.Id+" instead")
+	})
+}
@@ -0,0 +1,83 @@
+package cmd
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	"github.com/render-oss/cli/pkg/command"
+	"github.com/render-oss/cli/pkg/dependencies"
+	"github.com/render-oss/cli/pkg/postgres"
+	"github.com/render-oss/cli/pkg/text"
+	"github.com/render-oss/cli/pkg/tui/views"
+	pgtypes "github.com/render-oss/cli/pkg/types/postgres"
+)
+
+func newPgInsightsCmd(deps *dependencies.Dependencies) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "insights <postgresID|postgresName>",
+		Short:        "Show query performance insights for a Render Postgres database",
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+		Long: `Show the statistics Render collects for diagnosing slow queries:
+
+  • Top queries: the queries with the most total execution time
+  • Table scans: the tables read most often with sequential scans, which
+    usually point to a missing index
+  • Processes: the database's active connections and what they are running
+  • Sizes: the size of each table and index
+
+In interactive mode, each is shown in its own tab. Use shift+left/right to
+switch tabs, / to search, and r to reload. In text mode, each is printed as a
+section.`,
+		Example: `  # Browse insights interactively
+  render pg insights my-db
+
+  # Print the ten slowest queries by mean time
+  render pg insights my-db --output json | jq '.data.topQueries | sort_by(-.meanTimeMs) | .[:10]'
+
+  # List active processes
+  render pg insights my-db --output json | jq '.data.processes'`,
+	}
+	addPgLookupFlags(cmd, nil)
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		var input pgtypes.InsightsInput
+		if err := command.ParseCommand(cmd, args, &input); err != nil {
+			return err
+		}
+		input = pgtypes.NormalizeInsightsInput(input)
+
+		if nonInteractive, err := command.NonInteractive(cmd, func() (*postgres.InsightsOut, error) {
+			return loadPgInsights(deps)(cmd.Context(), input)
+		}, text.PostgresInsights); err != nil {
+			return err
+		} else if nonInteractive {
+			return nil
+		}
+
+		command.AddToStackFunc(cmd.Context(), cmd, "Insights", &input,
+			views.NewPostgresInsightsView(cmd.Context(), loadPgInsights(deps), input))
+		return nil
+	}
+
+	return cmd
+}
+
+func loadPgInsights(deps *dependencies.Dependencies) func(context.Context, pgtypes.InsightsInput) (*postgres.InsightsOut, error) {
+	return func(ctx context.Context, input pgtypes.InsightsInput) (*postgres.InsightsOut, error) {
+		resolved, err := deps.PostgresService().Resolve(ctx, postgres.ResolveInput{
+			IDOrName:            input.IDOrName,
+			ProjectIDOrName:     input.ProjectIDOrName,
+			EnvironmentIDOrName: input.EnvironmentIDOrName,
+		})
+		if err != nil {
+			return nil, err
+		}
+		insights, err := deps.PostgresService().Insights(ctx, resolved.Postgres.Id)
+		if err != nil {
+			return nil, err
+		}
+		return &postgres.InsightsOut{Data: *insights, Meta: postgres.NewTargetMeta(resolved)}, nil
+	}
+}
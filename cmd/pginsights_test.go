@@ -0,0 +1,81 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	renderapi "github.com/render-oss/cli/internal/fakes/renderapi"
+	"github.com/render-oss/cli/internal/testrequire"
+	pgclient "github.com/render-oss/cli/pkg/client/postgres"
+	"github.com/render-oss/cli/pkg/pointers"
+)
+
+func executePGInsights(t *testing.T, server *renderapi.Server, args ...string) (CommandResult, error) {
+	t.Helper()
+	return executePGCommandInWorkspace(t, server, "", append([]string{"pg", "insights"}, args...)...)
+}
+
+func TestPGInsights(t *testing.T) {
+	server := renderapi.NewServer(t)
+	pg := seedPG(server, "my-db")
+	server.Postgres.SetInsights(pg.Id, renderapi.PostgresInsights{
+		TopQueries: []pgclient.PostgresQueryStatistic{
+			{Query: pointers.From("SELECT 1"), Calls: pointers.From(10), TotalTimeMs: pointers.From(float32(5))},
+			{Query: pointers.From("SELECT *\n  FROM orders\n  WHERE customer_id = $1"), Calls: pointers.From(3), TotalTimeMs: pointers.From(float32(2500))},
+		},
+		TableScans: []pgclient.PostgresTableScan{
+			{Database: pointers.From("app"), Schema: pointers.From("public"), Table: pointers.From("orders"), Scans: pointers.From(42)},
+		},
+		Processes: []pgclient.PostgresProcess{
+			{Pid: pointers.From(1234), Username: pointers.From("app_user"), State: pointers.From("active"), Duration: pointers.From(float32(1.5)), Query: pointers.From("VACUUM orders")},
+		},
+		Sizes: []pgclient.PostgresSize{
+			{Database: pointers.From("app"), Schema: pointers.From("public"), Table: pointers.From("orders"), Bytes: pointers.From(2 * 1024 * 1024)},
+		},
+	})
+
+	t.Run("text", func(t *testing.T) {
+		result, err := executePGInsights(t, server, "my-db", "--output", "text")
+		require.NoError(t, err, "stderr: %s", result.Stderr)
+
+		for _, want := range []string{
+			"Top queries", "SELECT * FROM orders WHERE customer_id = $1", "2.50 s",
+			"Sequential table scans", "public.orders", "42",
+			"Active processes", "1234", "app_user", "1.5s", "VACUUM orders",
+			"Table sizes", "2.0 MB",
+		} {
+			assert.Contains(t, result.Stdout, want)
+		}
+		assert.Less(t, strings.Index(result.Stdout, "FROM orders"), strings.Index(result.Stdout, "SELECT 1"), "expected the query with the most total time first")
+	})
+
+	t.Run("json", func(t *testing.T) {
+		result, err := executePGInsights(t, server, pg.Id, "--output", "json")
+		require.NoError(t, err, "stderr: %s", result.Stderr)
+
+		body := unmarshalPGJSONOutput(t, result.Stdout)
+		data := testrequire.SubMap(t, body, "data")
+		for _, key := range []string{"topQueries", "tableScans", "processes", "sizes"} {
+			assert.NotEmpty(t, data[key], key)
+		}
+		topQueries := data["topQueries"].([]any)
+		assert.Equal(t, float64(2500), topQueries[0].(map[string]any)["totalTimeMs"])
+		assert.Equal(t, pg.Id, testrequire.SubMap(t, body, "meta")["postgresId"])
+	})
+}
+
+func TestPGInsightsEmpty(t *testing.T) {
+	server := renderapi.NewServer(t)
+	seedPG(server, "my-db")
+
+	result, err := executePGInsights(t, server, "my-db", "--output", "json")
+	require.NoError(t, err, "stderr: %s", result.Stderr)
+
+	data := testrequire.SubMap(t, unmarshalPGJSONOutput(t, result.Stdout), "data")
+	for _, key := range []string{"topQueries", "tableScans", "processes", "sizes"} {
+		assert.Equal(t, []any{}, data[key], key)
+	}
+}
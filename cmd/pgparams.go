@@ -0,0 +1,75 @@
+package cmd
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	"github.com/render-oss/cli/pkg/dependencies"
+	"github.com/render-oss/cli/pkg/postgres"
+)
+
+func newPgParamsCmd(children ...*cobra.Command) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "params",
+		Aliases: []string{"parameters"},
+		Short:   "Manage PostgreSQL parameter overrides of a Render Postgres database",
+		Long: `List, set, and unset the PostgreSQL parameters a Render Postgres database
+overrides, such as work_mem or max_connections. Parameters without an override
+use Render's defaults. Use 'render pg params available' to see which
+parameters can be overridden and the values they accept.
+
+Changes apply to the database and its read replicas. Without --confirm, set and
+unset validate the change and preview it as a before/after diff, making no
+changes. Some parameters only take effect after the database restarts; the
+output says when a restart is needed.
+
+Each subcommand takes the database's ID (dpg-...) or name. If the name matches
+more than one database, narrow the search with --project <id|name>,
+--environment <id|name>, or pass the Postgres ID directly.`,
+	}
+	cmd.AddCommand(children...)
+	return cmd
+}
+
+// changePgParams validates change against the database's current overrides
+// and, when confirm is set, applies it.
+func changePgParams(ctx context.Context, deps *dependencies.Dependencies, input postgres.ResolveInput, change postgres.ParameterChange, confirm bool) (*postgres.ParametersUpdateOut, error) {
+	svc := deps.PostgresService()
+	resolved, err := svc.Resolve(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	plan, err := svc.PlanParameterChange(ctx, resolved.Postgres.Id, change)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &postgres.ParametersUpdateOut{
+		Data: plan.After,
+		Diff: plan.Diff,
+		Meta: postgres.ParametersUpdateOutMeta{
+			TargetMeta:      postgres.NewTargetMeta(resolved),
+			RequiresRestart: plan.RequiresRestart,
+		},
+	}
+	if len(plan.Diff) == 0 {
+		return out, nil
+	}
+	if !confirm {
+		out.Meta.Message = "re-run with --confirm to apply"
+		return out, nil
+	}
+
+	result, err := svc.UpdateParameterOverrides(ctx, resolved.Postgres.Id, plan.After)
+	if err != nil {
+		return nil, err
+	}
+	out.Meta.Applied = true
+	if result != nil {
+		out.Data = result.AppliedOverrides
+		out.Meta.RequiresRestart = result.RequiresRestart
+		out.Meta.AffectedDatabases = result.AffectedDatabases
+	}
+	return out, nil
+}
@@ -0,0 +1,176 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	renderapi "github.com/render-oss/cli/internal/fakes/renderapi"
+	"github.com/render-oss/cli/internal/testrequire"
+	"github.com/render-oss/cli/pkg/client"
+)
+
+func executePGParams(t *testing.T, server *renderapi.Server, args ...string) (CommandResult, error) {
+	t.Helper()
+	return executePGCommandInWorkspace(t, server, "", append([]string{"pg", "params"}, args...)...)
+}
+
+func TestPGParamsList(t *testing.T) {
+	server := renderapi.NewServer(t)
+	pg := seedPG(server, "my-db")
+	server.Postgres.SetParameterOverrides(pg.Id, client.PostgresParameterOverrides{"work_mem": "64MB"})
+
+	t.Run("text", func(t *testing.T) {
+		result, err := executePGParams(t, server, "list", "my-db", "--output", "text")
+		require.NoError(t, err, "stderr: %s", result.Stderr)
+
+		assert.Contains(t, result.Stdout, "work_mem")
+		assert.Contains(t, result.Stdout, "64MB")
+	})
+
+	t.Run("json", func(t *testing.T) {
+		result, err := executePGParams(t, server, "list", pg.Id, "--output", "json")
+		require.NoError(t, err, "stderr: %s", result.Stderr)
+
+		body := unmarshalPGJSONOutput(t, result.Stdout)
+		assert.Equal(t, map[string]any{"work_mem": "64MB"}, body["data"])
+		assert.Equal(t, pg.Id, testrequire.SubMap(t, body, "meta")["postgresId"])
+	})
+}
+
+func TestPGParamsAvailable(t *testing.T) {
+	server := renderapi.NewServer(t)
+	seedPG(server, "my-db")
+
+	result, err := executePGParams(t, server, "available", "my-db", "--output", "text")
+	require.NoError(t, err, "stderr: %s", result.Stderr)
+
+	assert.Contains(t, result.Stdout, "max_connections")
+	assert.Contains(t, result.Stdout, "between 1 and 1000")
+	assert.Contains(t, result.Stdout, "required")
+}
+
+func TestPGParamsSet(t *testing.T) {
+	t.Run("previews without --confirm", func(t *testing.T) {
+		server := renderapi.NewServer(t)
+		pg := seedPG(server, "my-db")
+		server.Postgres.SetParameterOverrides(pg.Id, client.PostgresParameterOverrides{"work_mem": "4MB"})
+
+		result, err := executePGParams(t, server, "set", "my-db", "work_mem=64MB", "max_connections=200", "--output", "text")
+		require.NoError(t, err, "stderr: %s", result.Stderr)
+
+		assert.Contains(t, result.Stdout, "This command would update parameter overrides on Postgres database my-db")
+		assert.Contains(t, result.Stdout, "work_mem:        4MB → 64MB")
+		assert.Contains(t, result.Stdout, "max_connections: (default) → 200")
+		assert.Contains(t, result.Stdout, "take effect only after the database restarts")
+		assert.Contains(t, result.Stdout, "Re-run with --confirm")
+		assert.False(t, server.HasRequest("PUT", "/parameter-overrides"))
+	})
+
+	t.Run("applies with --confirm", func(t *testing.T) {
+		server := renderapi.NewServer(t)
+		pg := seedPG(server, "my-db")
+		server.Postgres.SetParameterOverrides(pg.Id, client.PostgresParameterOverrides{"log_min_duration_statement": "-1"})
+
+		result, err := executePGParams(t, server, "set", "my-db", "work_mem=64MB", "--confirm", "--output", "json")
+		require.NoError(t, err, "stderr: %s", result.Stderr)
+
+		body := unmarshalPGJSONOutput(t, result.Stdout)
+		assert.Equal(t, map[string]any{"work_mem": "64MB", "log_min_duration_statement": "-1"}, body["data"])
+		assert.Equal(t, map[string]any{"before": nil, "after": "64MB"}, testrequire.SubMap(t, body, "diff")["work_mem"])
+		meta := testrequire.SubMap(t, body, "meta")
+		assert.Equal(t, true, meta["applied"])
+		assert.Equal(t, false, meta["requiresRestart"])
+		assert.Equal(t, client.PostgresParameterOverrides{"work_mem": "64MB", "log_min_duration_statement": "-1"}, server.Postgres.ParameterOverrides[pg.Id])
+	})
+
+	t.Run("applies a file declaratively", func(t *testing.T) {
+		server := renderapi.NewServer(t)
+		pg := seedPG(server, "my-db")
+		server.Postgres.SetParameterOverrides(pg.Id, client.PostgresParameterOverrides{"work_mem": "4MB", "log_min_duration_statement": "-1"})
+		file := filepath.Join(t.TempDir(), "params.yaml")
+		require.NoError(t, os.WriteFile(file, []byte("work_mem: 64MB\nmax_connections: 200\n"), 0o644))
+
+		result, err := executePGParams(t, server, "set", "my-db", "--file", file, "--confirm", "--output", "text")
+		require.NoError(t, err, "stderr: %s", result.Stderr)
+
+		assert.Contains(t, result.Stdout, "Updated parameter overrides on Postgres database my-db")
+		assert.Contains(t, result.Stdout, "log_min_duration_statement: -1 → (default)")
+		assert.Contains(t, result.Stdout, "render restart "+pg.Id)
+		assert.Equal(t, client.PostgresParameterOverrides{"work_mem": "64MB", "max_connections": "200"}, server.Postgres.ParameterOverrides[pg.Id])
+	})
+
+	t.Run("no changes", func(t *testing.T) {
+		server := renderapi.NewServer(t)
+		pg := seedPG(server, "my-db")
+		server.Postgres.SetParameterOverrides(pg.Id, client.PostgresParameterOverrides{"work_mem": "64MB"})
+
+		result, err := executePGParams(t, server, "set", "my-db", "work_mem=64MB", "--confirm", "--output", "text")
+		require.NoError(t, err, "stderr: %s", result.Stderr)
+
+		assert.Contains(t, result.Stdout, "No changes to parameter overrides on Postgres database my-db")
+		assert.False(t, server.HasRequest("PUT", "/parameter-overrides"))
+	})
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{name: "unknown parameter", args: []string{"shared_buffers=1GB"}, wantErr: "parameter shared_buffers can't be overridden"},
+		{name: "out of range", args: []string{"max_connections=5000"}, wantErr: "parameter max_connections must be between 1 and 1000, got 5000"},
+		{name: "not a number", args: []string{"max_connections=many"}, wantErr: `parameter max_connections takes a number, got "many"`},
+		{name: "malformed pair", args: []string{"work_mem"}, wantErr: `invalid parameter "work_mem": expected NAME=VALUE`},
+		{name: "nothing to set", args: nil, wantErr: "at least one NAME=VALUE pair or --file is required"},
+		{name: "file and pairs", args: []string{"work_mem=64MB", "--file", "params.yaml"}, wantErr: "--file and NAME=VALUE pairs are mutually exclusive"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := renderapi.NewServer(t)
+			seedPG(server, "my-db")
+
+			_, err := executePGParams(t, server, append([]string{"set", "my-db", "--confirm", "--output", "json"}, tt.args...)...)
+			require.ErrorContains(t, err, tt.wantErr)
+			assert.False(t, server.HasRequest("PUT", "/parameter-overrides"))
+		})
+	}
+}
+
+func TestPGParamsUnset(t *testing.T) {
+	t.Run("previews without --confirm", func(t *testing.T) {
+		server := renderapi.NewServer(t)
+		pg := seedPG(server, "my-db")
+		server.Postgres.SetParameterOverrides(pg.Id, client.PostgresParameterOverrides{"work_mem": "64MB"})
+
+		result, err := executePGParams(t, server, "unset", "my-db", "work_mem", "--output", "text")
+		require.NoError(t, err, "stderr: %s", result.Stderr)
+
+		assert.Contains(t, result.Stdout, "work_mem: 64MB → (default)")
+		assert.Equal(t, client.PostgresParameterOverrides{"work_mem": "64MB"}, server.Postgres.ParameterOverrides[pg.Id])
+	})
+
+	t.Run("applies with --confirm", func(t *testing.T) {
+		server := renderapi.NewServer(t)
+		pg := seedPG(server, "my-db")
+		server.Postgres.SetParameterOverrides(pg.Id, client.PostgresParameterOverrides{"work_mem": "64MB", "max_connections": "200"})
+
+		result, err := executePGParams(t, server, "unset", "my-db", "max_connections", "--confirm", "--output", "json")
+		require.NoError(t, err, "stderr: %s", result.Stderr)
+
+		meta := testrequire.SubMap(t, unmarshalPGJSONOutput(t, result.Stdout), "meta")
+		assert.Equal(t, true, meta["applied"])
+		assert.Equal(t, true, meta["requiresRestart"])
+		assert.Equal(t, client.PostgresParameterOverrides{"work_mem": "64MB"}, server.Postgres.ParameterOverrides[pg.Id])
+	})
+
+	t.Run("parameter without override", func(t *testing.T) {
+		server := renderapi.NewServer(t)
+		seedPG(server, "my-db")
+
+		_, err := executePGParams(t, server, "unset", "my-db", "work_mem", "--confirm")
+		require.EqualError(t, err, "parameter work_mem has no override to unset")
+	})
+}
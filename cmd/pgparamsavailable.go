@@ -0,0 +1,58 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/render-oss/cli/pkg/command"
+	"github.com/render-oss/cli/pkg/dependencies"
+	"github.com/render-oss/cli/pkg/postgres"
+	"github.com/render-oss/cli/pkg/text"
+	pgtypes "github.com/render-oss/cli/pkg/types/postgres"
+)
+
+func newPgParamsAvailableCmd(deps *dependencies.Dependencies) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "available <postgresID|postgresName>",
+		Short:        "List the parameters that can be overridden on a Render Postgres database",
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+		Long: `List the PostgreSQL parameters that can be overridden on a Render Postgres
+database, with the range of values numeric parameters accept and whether a
+change takes effect only after a restart.`,
+		Example: `  # List available parameters
+  render pg params available my-db
+
+  # Show the examples for one parameter
+  render pg params available my-db --output json | jq '.data[] | select(.name == "work_mem")'`,
+	}
+	addPgLookupFlags(cmd, nil)
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		command.DefaultFormatNonInteractive(cmd)
+
+		var input pgtypes.ParamsInput
+		if err := command.ParseCommand(cmd, args, &input); err != nil {
+			return err
+		}
+		input = pgtypes.NormalizeParamsInput(input)
+
+		_, err := command.NonInteractive(cmd, func() (*postgres.AvailableParametersOut, error) {
+			resolved, err := deps.PostgresService().Resolve(cmd.Context(), postgres.ResolveInput{
+				IDOrName:            input.IDOrName,
+				ProjectIDOrName:     input.ProjectIDOrName,
+				EnvironmentIDOrName: input.EnvironmentIDOrName,
+			})
+			if err != nil {
+				return nil, err
+			}
+			available, err := deps.PostgresService().ListAvailableParameterOverrides(cmd.Context(), resolved.Postgres.Id)
+			if err != nil {
+				return nil, err
+			}
+			return &postgres.AvailableParametersOut{Data: available, Meta: postgres.NewTargetMeta(resolved)}, nil
+		}, text.PostgresAvailableParamsTable)
+		return err
+	}
+
+	return cmd
+}
@@ -0,0 +1,58 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/render-oss/cli/pkg/command"
+	"github.com/render-oss/cli/pkg/dependencies"
+	"github.com/render-oss/cli/pkg/postgres"
+	"github.com/render-oss/cli/pkg/text"
+	pgtypes "github.com/render-oss/cli/pkg/types/postgres"
+)
+
+func newPgParamsListCmd(deps *dependencies.Dependencies) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "list <postgresID|postgresName>",
+		Short:        "List the parameter overrides of a Render Postgres database",
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+		Long: `List the PostgreSQL parameters a Render Postgres database overrides, with their
+values. JSON and YAML output can be saved and later applied with
+'render pg params set --file'.`,
+		Example: `  # List overrides
+  render pg params list my-db
+
+  # Save overrides to a file
+  render pg params list my-db --output yaml | yq '.data' > params.yaml`,
+	}
+	addPgLookupFlags(cmd, nil)
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		command.DefaultFormatNonInteractive(cmd)
+
+		var input pgtypes.ParamsInput
+		if err := command.ParseCommand(cmd, args, &input); err != nil {
+			return err
+		}
+		input = pgtypes.NormalizeParamsInput(input)
+
+		_, err := command.NonInteractive(cmd, func() (*postgres.ParametersOut, error) {
+			resolved, err := deps.PostgresService().Resolve(cmd.Context(), postgres.ResolveInput{
+				IDOrName:            input.IDOrName,
+				ProjectIDOrName:     input.ProjectIDOrName,
+				EnvironmentIDOrName: input.EnvironmentIDOrName,
+			})
+			if err != nil {
+				return nil, err
+			}
+			overrides, err := deps.PostgresService().ListParameterOverrides(cmd.Context(), resolved.Postgres.Id)
+			if err != nil {
+				return nil, err
+			}
+			return &postgres.ParametersOut{Data: overrides, Meta: postgres.NewTargetMeta(resolved)}, nil
+		}, text.PostgresParamsTable)
+		return err
+	}
+
+	return cmd
+}
@@ -0,0 +1,98 @@
+package cmd
+
+import (
+	"errors"
+
+	"github.com/spf13/cobra"
+
+	"github.com/render-oss/cli/pkg/command"
+	"github.com/render-oss/cli/pkg/dependencies"
+	"github.com/render-oss/cli/pkg/postgres"
+	"github.com/render-oss/cli/pkg/text"
+	pgtypes "github.com/render-oss/cli/pkg/types/postgres"
+)
+
+func newPgParamsSetCmd(deps *dependencies.Dependencies) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "set <postgresID|postgresName> [NAME=VALUE]...",
+		Short:        "Set parameter overrides on a Render Postgres database",
+		Args:         cobra.MinimumNArgs(1),
+		SilenceUsage: true,
+		Long: `Set PostgreSQL parameter overrides on a Render Postgres database.
+
+With NAME=VALUE pairs, the named parameters are set and other overrides are
+left unchanged. Values are taken verbatim after the first '='. Memory and time
+parameters accept Postgres units, such as 64MB or 30s. A value with a unit is
+only checked to have a sign the parameter's range allows; Postgres checks the
+converted value when the override is applied.
+
+With --file, the overrides are applied declaratively from a YAML file mapping
+parameter names to values:
+
+  work_mem: 64MB
+  max_connections: 200
+
+The file becomes the database's complete list of overrides: parameters it
+doesn't name are reset to their defaults. --file and NAME=VALUE pairs are
+mutually exclusive.
+
+Names and values are checked against 'render pg params available' before
+anything changes. Without --confirm, this command previews the change as a
+before/after diff, making no changes.`,
+		Example: `  # Preview setting work_mem
+  render pg params set my-db work_mem=64MB
+
+  # Set several parameters
+  render pg params set my-db work_mem=64MB max_connections=200 --confirm
+
+  # Preview, then apply, the overrides in a file
+  render pg params set my-db --file params.yaml
+  render pg params set my-db --file params.yaml --confirm`,
+	}
+	cmd.Flags().StringP("file", "f", "", "YAML file of parameter overrides to apply in place of the current ones")
+	addPgLookupFlags(cmd, map[string]string{
+		"file": "PATH",
+	})
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		command.DefaultFormatNonInteractive(cmd)
+
+		var input pgtypes.ParamsSetInput
+		if err := command.ParseCommand(cmd, args, &input); err != nil {
+			return err
+		}
+		input = pgtypes.NormalizeParamsSetInput(input)
+
+		var change postgres.ParameterChange
+		switch {
+		case input.File != nil && len(args) > 1:
+			return errors.New("--file and NAME=VALUE pairs are mutually exclusive")
+		case input.File != nil:
+			overrides, err := postgres.ReadParameterOverridesFile(*input.File)
+			if err != nil {
+				return err
+			}
+			change = postgres.ParameterChange{Set: overrides, Replace: true}
+		case len(args) > 1:
+			params, err := pgtypes.ParseParamAssignments(args[1:])
+			if err != nil {
+				return err
+			}
+			change = postgres.ParameterChange{Set: params}
+		default:
+			return errors.New("at least one NAME=VALUE pair or --file is required")
+		}
+		confirm := command.GetConfirmFromContext(cmd.Context())
+
+		_, err := command.NonInteractive(cmd, func() (*postgres.ParametersUpdateOut, error) {
+			return changePgParams(cmd.Context(), deps, postgres.ResolveInput{
+				IDOrName:            input.IDOrName,
+				ProjectIDOrName:     input.ProjectIDOrName,
+				EnvironmentIDOrName: input.EnvironmentIDOrName,
+			}, change, confirm)
+		}, text.PostgresParamsUpdate)
+		return err
+	}
+
+	return cmd
+}
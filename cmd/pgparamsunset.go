@@ -0,0 +1,57 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/render-oss/cli/pkg/command"
+	"github.com/render-oss/cli/pkg/dependencies"
+	"github.com/render-oss/cli/pkg/postgres"
+	"github.com/render-oss/cli/pkg/text"
+	pgtypes "github.com/render-oss/cli/pkg/types/postgres"
+)
+
+func newPgParamsUnsetCmd(deps *dependencies.Dependencies) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "unset <postgresID|postgresName> NAME...",
+		Short:        "Remove parameter overrides from a Render Postgres database",
+		Args:         cobra.MinimumNArgs(2),
+		SilenceUsage: true,
+		Long: `Remove PostgreSQL parameter overrides from a Render Postgres database, resetting
+the parameters to their defaults. Each parameter must currently be overridden.
+
+Without --confirm, this command previews the change as a before/after diff,
+making no changes.`,
+		Example: `  # Preview resetting work_mem
+  render pg params unset my-db work_mem
+
+  # Reset several parameters
+  render pg params unset my-db work_mem max_connections --confirm`,
+	}
+	addPgLookupFlags(cmd, nil)
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		command.DefaultFormatNonInteractive(cmd)
+
+		var input pgtypes.ParamsInput
+		if err := command.ParseCommand(cmd, args, &input); err != nil {
+			return err
+		}
+		input = pgtypes.NormalizeParamsInput(input)
+		names, err := pgtypes.ParseParamNames(args[1:])
+		if err != nil {
+			return err
+		}
+		confirm := command.GetConfirmFromContext(cmd.Context())
+
+		_, err = command.NonInteractive(cmd, func() (*postgres.ParametersUpdateOut, error) {
+			return changePgParams(cmd.Context(), deps, postgres.ResolveInput{
+				IDOrName:            input.IDOrName,
+				ProjectIDOrName:     input.ProjectIDOrName,
+				EnvironmentIDOrName: input.EnvironmentIDOrName,
+			}, postgres.ParameterChange{Unset: names}, confirm)
+		}, text.PostgresParamsUpdate)
+		return err
+	}
+
+	return cmd
+}
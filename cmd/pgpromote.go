@@ -0,0 +1,91 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/render-oss/cli/pkg/client"
+	"github.com/render-oss/cli/pkg/command"
+	"github.com/render-oss/cli/pkg/dependencies"
+	"github.com/render-oss/cli/pkg/pointers"
+	"github.com/render-oss/cli/pkg/postgres"
+	"github.com/render-oss/cli/pkg/text"
+	pgtypes "github.com/render-oss/cli/pkg/types/postgres"
+)
+
+func newPgPromoteCmd(deps *dependencies.Dependencies) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "promote <postgresID|postgresName>",
+		Short:        "Promote a Render Postgres read replica to a standalone primary",
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+		Long: `Promote a read replica to a standalone primary database. The replica stops
+replicating from its primary and starts accepting writes. It can't be made a
+replica again.
+
+Before promoting, the command checks that the database is a read replica and
+is available. It also checks that the replica reported replication lag in the
+last 5 minutes, which shows it is streaming changes from its primary. Pass
+--skip-standby-check to skip that check, e.g. when the primary is down.
+
+You are asked to type the replica's name to confirm. Pass --confirm to skip
+the prompt in scripts.
+
+With --wait, the command re-checks the database until it reports as an
+available primary or --timeout seconds pass. It exits non-zero on timeout.`,
+		Example: `  # Promote, typing the replica name to confirm
+  render pg promote my-db-replica
+
+  # Promote from a runbook and wait for the new primary
+  render pg promote dpg-abc123def456ghi789jkl0 --confirm --wait`,
+	}
+	addPgHAWaitFlags(cmd, "Wait for the database to be an available primary, and exit non-zero if it isn't before the timeout")
+	addPgStandbyCheckFlag(cmd, "Promote without checking that the replica has recently reported replication lag")
+	addPgLookupFlags(cmd, map[string]string{"timeout": "SECONDS"})
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		command.DefaultFormatNonInteractive(cmd)
+
+		var input pgtypes.HAInput
+		if err := command.ParseCommand(cmd, args, &input); err != nil {
+			return err
+		}
+		input = pgtypes.NormalizeHAInput(input)
+		target := &pgHATarget{
+			deps: deps,
+			input: postgres.ResolveInput{
+				IDOrName:            input.IDOrName,
+				ProjectIDOrName:     input.ProjectIDOrName,
+				EnvironmentIDOrName: input.EnvironmentIDOrName,
+			},
+			op:               postgres.HAPromote,
+			skipStandbyCheck: input.SkipStandbyCheck,
+		}
+
+		var result *postgres.HAOut
+		loadData := func() (*postgres.HAOut, error) {
+			resolved, err := target.resolve(cmd.Context())
+			if err != nil {
+				return nil, err
+			}
+			pg, err := deps.PostgresService().Promote(cmd.Context(), resolved.Postgres.Id)
+			if err != nil {
+				return nil, err
+			}
+			result, err = pgHAOut(cmd.Context(), deps, resolved, pg, postgres.HAPromote, input.Wait, pgtypes.HAWaitTimeout(input.Timeout))
+			return result, err
+		}
+
+		confirm := target.confirmation(cmd.Context(), func(pg *client.PostgresDetail) string {
+			return fmt.Sprintf("This will promote read replica %s (%s) to a standalone primary. It stops replicating from %s and can't be made a replica again.",
+				pg.Name, pg.Id, pointers.StringValue(pg.PrimaryPostgresID))
+		})
+		if _, err := command.NonInteractiveWithTypedConfirm(cmd, loadData, text.PostgresHA, confirm); err != nil {
+			return err
+		}
+		return exitIfPgHAWaitTimedOut(cmd, result)
+	}
+
+	return cmd
+}
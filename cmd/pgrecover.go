@@ -0,0 +1,94 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/render-oss/cli/pkg/command"
+	"github.com/render-oss/cli/pkg/dependencies"
+	"github.com/render-oss/cli/pkg/postgres"
+	"github.com/render-oss/cli/pkg/text"
+	pgtypes "github.com/render-oss/cli/pkg/types/postgres"
+)
+
+func newPgRecoverCmd(deps *dependencies.Dependencies) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "recover <postgresID|postgresName> --to <time> --name <new-db>",
+		Short:        "Restore a Render Postgres database to a point in time as a new database",
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+		Long: `Restore a Render Postgres database to a point in time. Recovery creates a new
+database in the same environment; the original database is not changed.
+
+--to is either a relative time (30m, 12h, 7d), meaning that long ago, or an
+RFC3339 timestamp. It must fall in the database's recovery window, which is
+checked before anything is created.
+
+Without --confirm, this command checks the recovery time and previews the
+recovery, making no changes. Pass --confirm to start the recovery.`,
+		Example: `  # Check that a time can be recovered to (no changes made)
+  render pg recover my-db --to 2026-05-01T12:00:00Z --name my-db-restored
+
+  # Recover the database as it was two hours ago
+  render pg recover my-db --to 2h --name my-db-restored --confirm
+
+  # Recover onto a larger plan
+  render pg recover my-db --to 30m --name my-db-restored --plan pro_8gb --confirm --output json`,
+	}
+	cmd.Flags().Var(command.NewTimeInput(), "to", "Point in time to restore to (relative or RFC3339)")
+	cmd.Flags().String("name", "", "Name of the new database")
+	cmd.Flags().String("plan", "", "Plan of the new database (default: the original database's plan)")
+	addPgLookupFlags(cmd, map[string]string{
+		"to":   "TIME",
+		"name": "NAME",
+		"plan": "PLAN",
+	})
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		command.DefaultFormatNonInteractive(cmd)
+
+		var input pgtypes.RecoverPostgresInput
+		if err := command.ParseCommand(cmd, args, &input); err != nil {
+			return err
+		}
+		input = pgtypes.NormalizeRecoverInput(input)
+		confirm := command.GetConfirmFromContext(cmd.Context())
+
+		loadData := func() (*postgres.RecoverOut, error) {
+			svc := deps.PostgresService()
+			source, err := svc.Resolve(cmd.Context(), postgres.ResolveInput{
+				IDOrName:            input.IDOrName,
+				ProjectIDOrName:     input.ProjectIDOrName,
+				EnvironmentIDOrName: input.EnvironmentIDOrName,
+			})
+			if err != nil {
+				return nil, err
+			}
+			recoverInput := postgres.RecoverInput{To: *input.To.T, Name: input.Name, Plan: input.Plan}
+			out := postgres.NewPostgresRecoverOut(source, recoverInput)
+
+			if !confirm {
+				if err := svc.CheckRecovery(cmd.Context(), source.Postgres.Id, recoverInput.To); err != nil {
+					return nil, err
+				}
+				out.Meta.Message = "re-run with --confirm to recover"
+				return &out, nil
+			}
+
+			created, err := svc.Recover(cmd.Context(), source.Postgres.Id, recoverInput)
+			if err != nil {
+				return nil, err
+			}
+			recovered, err := svc.Resolve(cmd.Context(), postgres.ResolveInput{IDOrName: created.Id})
+			if err != nil {
+				return nil, err
+			}
+			out.SetRecovered(recovered)
+			return &out, nil
+		}
+
+		_, err := command.NonInteractive(cmd, loadData, text.PostgresRecover)
+		return err
+	}
+
+	return cmd
+}
@@ -0,0 +1,108 @@
+package cmd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	renderapi "github.com/render-oss/cli/internal/fakes/renderapi"
+	"github.com/render-oss/cli/internal/testrequire"
+	pgclient "github.com/render-oss/cli/pkg/client/postgres"
+	"github.com/render-oss/cli/pkg/pointers"
+)
+
+func executePGRecover(t *testing.T, server *renderapi.Server, args ...string) (CommandResult, error) {
+	t.Helper()
+	return executePGCommandInWorkspace(t, server, "", append([]string{"pg", "recover"}, args...)...)
+}
+
+func TestPGRecover_Preview_DoesNotRecover(t *testing.T) {
+	server := renderapi.NewServer(t)
+	pg := seedPG(server, "my-db")
+
+	result, err := executePGRecover(t, server, "my-db", "--to", "2h", "--name", "my-db-restored", "--output", "text")
+	require.NoError(t, err, "stderr: %s", result.Stderr)
+
+	assert.Contains(t, result.Stdout, "would recover Postgres database my-db ("+pg.Id+")")
+	assert.Contains(t, result.Stdout, "my-db-restored")
+	assert.Contains(t, result.Stdout, "--confirm")
+	assert.True(t, server.HasRequest("GET", "/postgres/"+pg.Id+"/recovery"))
+	assert.Empty(t, server.Postgres.Recoveries)
+}
+
+func TestPGRecover_Confirm_CreatesDatabase(t *testing.T) {
+	server := renderapi.NewServer(t)
+	pg := seedPG(server, "my-db")
+	to := time.Now().Add(-time.Hour).UTC().Truncate(time.Second)
+
+	result, err := executePGRecover(t, server, pg.Id, "--to", to.Format(time.RFC3339), "--name", "my-db-restored", "--plan", "pro_8gb", "--confirm", "--output", "json")
+	require.NoError(t, err, "stderr: %s", result.Stderr)
+
+	require.Len(t, server.Postgres.Recoveries, 1)
+	recovery := server.Postgres.Recoveries[0]
+	assert.Equal(t, pg.Id, recovery.PostgresID)
+	assert.True(t, to.Equal(recovery.Body.RestoreTime))
+	assert.Equal(t, "my-db-restored", pointers.StringValue(recovery.Body.RestoreName))
+	assert.Equal(t, "pro_8gb", pointers.StringValue(recovery.Body.Plan))
+
+	body := unmarshalPGJSONOutput(t, result.Stdout)
+	data := testrequire.SubMap(t, body, "data")
+	assert.Equal(t, "my-db-restored", data["name"])
+	assert.NotEqual(t, pg.Id, data["id"])
+	meta := testrequire.SubMap(t, body, "meta")
+	assert.Equal(t, true, meta["recovered"])
+	assert.Equal(t, pg.Id, testrequire.SubMap(t, meta, "source")["postgresId"])
+}
+
+func TestPGRecover_ValidatesRecoveryWindow(t *testing.T) {
+	tests := []struct {
+		name    string
+		info    *pgclient.RecoveryInfo
+		to      string
+		wantErr string
+	}{
+		{
+			name:    "before the window",
+			info:    &pgclient.RecoveryInfo{RecoveryStatus: pgclient.AVAILABLE, StartsAt: pointers.From(time.Now().Add(-24 * time.Hour))},
+			to:      "2d",
+			wantErr: "before the start of the recovery window",
+		},
+		{
+			name:    "in the future",
+			to:      time.Now().Add(time.Hour).Format(time.RFC3339),
+			wantErr: "is in the future",
+		},
+		{
+			name:    "backup not ready",
+			info:    &pgclient.RecoveryInfo{RecoveryStatus: pgclient.BACKUPNOTREADY},
+			to:      "1h",
+			wantErr: "first backup is not ready",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := renderapi.NewServer(t)
+			pg := seedPG(server, "my-db")
+			if tt.info != nil {
+				server.Postgres.SetRecoveryInfo(pg.Id, *tt.info)
+			}
+
+			_, err := executePGRecover(t, server, "my-db", "--to", tt.to, "--name", "restored", "--confirm", "--output", "json")
+			require.ErrorContains(t, err, tt.wantErr)
+			assert.Empty(t, server.Postgres.Recoveries)
+		})
+	}
+}
+
+func TestPGRecover_RequiresFlags(t *testing.T) {
+	server := renderapi.NewServer(t)
+	seedPG(server, "my-db")
+
+	_, err := executePGRecover(t, server, "my-db", "--name", "restored")
+	require.ErrorContains(t, err, "--to is required")
+
+	_, err = executePGRecover(t, server, "my-db", "--to", "1h")
+	require.ErrorContains(t, err, "--name is required")
+}
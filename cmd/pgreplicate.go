@@ -0,0 +1,124 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/render-oss/cli/pkg/client"
+	pgclient "github.com/render-oss/cli/pkg/client/postgres"
+	"github.com/render-oss/cli/pkg/command"
+	"github.com/render-oss/cli/pkg/dependencies"
+	"github.com/render-oss/cli/pkg/pointers"
+	"github.com/render-oss/cli/pkg/postgres"
+	"github.com/render-oss/cli/pkg/text"
+	pgtypes "github.com/render-oss/cli/pkg/types/postgres"
+)
+
+func newPgReplicateCmd(deps *dependencies.Dependencies) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "replicate <postgresID|postgresName>",
+		Short:        "Set up logical replication from a Render Postgres database",
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+		Long: `Set up logical replication from a Render Postgres database, so an external
+subscriber can stream changes from it. This creates a publication for every
+table in a schema and, with --slot, a replication slot for the subscriber.
+
+The change takes effect the next time the database restarts. Pass --restart
+to restart it right away, which drops open connections.
+
+Before setting up replication, the command checks that the database is a
+primary and is available.
+
+You are asked to type the database's name to confirm. Pass --confirm to skip
+the prompt in scripts.
+
+With --restart --wait, the command re-checks the database until it is
+available again or --timeout seconds pass. It exits non-zero on timeout.`,
+		Example: `  # Replicate the public schema of the default database
+  render pg replicate my-db
+
+  # Name the publication and slot, restart now, and wait for the restart
+  render pg replicate my-db --schema app --publication app_pub --slot app_slot --restart --wait --confirm`,
+	}
+	cmd.Flags().String("database", "", "Database to replicate (default: the database's default database)")
+	cmd.Flags().String("schema", pgtypes.DefaultReplicationSchema, "Schema to replicate")
+	cmd.Flags().String("publication", "", "Name of the publication to create for the schema's tables")
+	cmd.Flags().String("slot", "", "Name of the replication slot to create for the subscriber")
+	cmd.Flags().Bool("restart", false, "Restart the database now to apply the change")
+	addPgHAWaitFlags(cmd, "With --restart, wait for the database to be available again, and exit non-zero if it isn't before the timeout")
+	addPgLookupFlags(cmd, map[string]string{
+		"database":    "NAME",
+		"schema":      "NAME",
+		"publication": "NAME",
+		"slot":        "NAME",
+		"timeout":     "SECONDS",
+	})
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		command.DefaultFormatNonInteractive(cmd)
+
+		var input pgtypes.ReplicateInput
+		if err := command.ParseCommand(cmd, args, &input); err != nil {
+			return err
+		}
+		input = pgtypes.NormalizeReplicateInput(input)
+		target := &pgHATarget{
+			deps: deps,
+			input: postgres.ResolveInput{
+				IDOrName:            input.IDOrName,
+				ProjectIDOrName:     input.ProjectIDOrName,
+				EnvironmentIDOrName: input.EnvironmentIDOrName,
+			},
+			op: postgres.HAReplicate,
+		}
+
+		var result *postgres.HAOut
+		loadData := func() (*postgres.HAOut, error) {
+			resolved, err := target.resolve(cmd.Context())
+			if err != nil {
+				return nil, err
+			}
+			body := replicationSetupBody(input, resolved.Postgres)
+			pg, err := deps.PostgresService().SetupReplication(cmd.Context(), resolved.Postgres.Id, body)
+			if err != nil {
+				return nil, err
+			}
+			result, err = pgHAOut(cmd.Context(), deps, resolved, pg, postgres.HAReplicate, input.Wait, pgtypes.HAWaitTimeout(input.Timeout))
+			if result != nil {
+				result.Meta.Replication = &body
+			}
+			return result, err
+		}
+
+		confirm := target.confirmation(cmd.Context(), func(pg *client.PostgresDetail) string {
+			body := replicationSetupBody(input, pg)
+			msg := fmt.Sprintf("This will set up logical replication of schema %s of database %s on Postgres database %s (%s).",
+				body.SchemaName, body.DatabaseName, pg.Name, pg.Id)
+			if input.Restart {
+				return msg + " The database then restarts, dropping open connections."
+			}
+			return msg + " The change takes effect the next time the database restarts."
+		})
+		if _, err := command.NonInteractiveWithTypedConfirm(cmd, loadData, text.PostgresHA, confirm); err != nil {
+			return err
+		}
+		return exitIfPgHAWaitTimedOut(cmd, result)
+	}
+
+	return cmd
+}
+
+func replicationSetupBody(input pgtypes.ReplicateInput, pg *client.PostgresDetail) pgclient.PostgresReplicationSetupInput {
+	body := pgclient.PostgresReplicationSetupInput{
+		DatabaseName:             pointers.ValueOrDefault(input.Database, pg.DatabaseName),
+		SchemaName:               input.Schema,
+		AllTablesPublicationName: input.Publication,
+		ReplicationSlotName:      input.Slot,
+	}
+	if input.Restart {
+		body.RestartNow = pointers.From(true)
+	}
+	return body
+}
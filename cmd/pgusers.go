@@ -0,0 +1,89 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	"github.com/spf13/cobra"
+
+	"github.com/render-oss/cli/pkg/command"
+	"github.com/render-oss/cli/pkg/dependencies"
+	"github.com/render-oss/cli/pkg/postgres"
+)
+
+func newPgUsersCmd(children ...*cobra.Command) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "users",
+		Aliases: []string{"user"},
+		Short:   "Manage the PostgreSQL users of a Render Postgres database",
+		Long: `List, create, and delete the PostgreSQL users of a Render Postgres database.
+
+Creating a user makes it the database's default user, the one shown in its
+connection info. The new user's password is printed once, when it is created.
+
+Each subcommand takes the database's ID (dpg-...) or name. If the name matches
+more than one database, narrow the search with --project <id|name>,
+--environment <id|name>, or pass the Postgres ID directly.
+
+Deleting a user asks you to type its username to confirm. Pass --confirm to
+skip the prompt in scripts.`,
+	}
+	cmd.AddCommand(children...)
+	return cmd
+}
+
+// addPgLookupFlags adds the flags that narrow a Postgres name lookup, then
+// sets flag placeholders. placeholders covers the command's other value
+// flags, so call it after defining them.
+func addPgLookupFlags(cmd *cobra.Command, placeholders map[string]string) {
+	cmd.Flags().String("project", "",
+		"Narrow lookup to a project (ID or name, optional) when the same Postgres database name exists in multiple projects.")
+	cmd.Flags().String("environment", "",
+		"Narrow lookup to an environment (ID or name, optional) when the same Postgres database name exists in multiple environments.")
+	all := map[string]string{
+		"project":     "PROJECT",
+		"environment": "ENVIRONMENT",
+	}
+	maps.Copy(all, placeholders)
+	setAllFlagPlaceholders(cmd, all)
+}
+
+// pgUserTarget resolves a database and one of its users at most once, so the
+// confirmation prompt and the action it guards act on the same user.
+type pgUserTarget struct {
+	deps     *dependencies.Dependencies
+	input    postgres.ResolveInput
+	username string
+	resolved *postgres.ResolvedPostgres
+	user     *postgres.User
+}
+
+func (t *pgUserTarget) resolve(ctx context.Context) (*postgres.ResolvedPostgres, *postgres.User, error) {
+	if t.user == nil {
+		resolved, err := t.deps.PostgresService().Resolve(ctx, t.input)
+		if err != nil {
+			return nil, nil, err
+		}
+		user, err := t.deps.PostgresService().GetUser(ctx, resolved.Postgres.Id, t.username)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w on Postgres database %s", err, resolved.Postgres.Name)
+		}
+		t.resolved, t.user = resolved, user
+	}
+	return t.resolved, t.user, nil
+}
+
+// confirmation returns a typed confirmation that asks the user to enter the
+// username before it is deleted.
+func (t *pgUserTarget) confirmation(ctx context.Context) command.TypedConfirmFunc {
+	return func() (string, string, error) {
+		resolved, user, err := t.resolve(ctx)
+		if err != nil {
+			return "", "", err
+		}
+		msg := fmt.Sprintf("This will delete user %s from Postgres database %s (%s). Clients using its credentials can no longer connect.",
+			user.Username, resolved.Postgres.Name, resolved.Postgres.Id)
+		return msg, user.Username, nil
+	}
+}
@@ -0,0 +1,61 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/render-oss/cli/pkg/command"
+	"github.com/render-oss/cli/pkg/dependencies"
+	"github.com/render-oss/cli/pkg/postgres"
+	"github.com/render-oss/cli/pkg/text"
+	pgtypes "github.com/render-oss/cli/pkg/types/postgres"
+)
+
+func newPgUsersCreateCmd(deps *dependencies.Dependencies) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "create <postgresID|postgresName> <username>",
+		Short:        "Create a user on a Render Postgres database",
+		Args:         cobra.ExactArgs(2),
+		SilenceUsage: true,
+		Long: `Create a PostgreSQL user on a Render Postgres database and print its generated
+password and connection strings.
+
+The new user becomes the database's default user. Its credentials are printed
+once; save them before you create another user. Use --output json to pass them
+straight to a secret manager.`,
+		Example: `  # Create a user
+  render pg users create my-db reporting
+
+  # Store the new password in a secret manager
+  render pg users create my-db reporting --output json | jq -r .data.password`,
+	}
+	addPgLookupFlags(cmd, nil)
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		command.DefaultFormatNonInteractive(cmd)
+
+		var input pgtypes.UserInput
+		if err := command.ParseCommand(cmd, args, &input); err != nil {
+			return err
+		}
+		input = pgtypes.NormalizeUserInput(input)
+
+		_, err := command.NonInteractive(cmd, func() (*postgres.UserCreateOut, error) {
+			resolved, err := deps.PostgresService().Resolve(cmd.Context(), postgres.ResolveInput{
+				IDOrName:            input.IDOrName,
+				ProjectIDOrName:     input.ProjectIDOrName,
+				EnvironmentIDOrName: input.EnvironmentIDOrName,
+			})
+			if err != nil {
+				return nil, err
+			}
+			creds, err := deps.PostgresService().CreateUser(cmd.Context(), resolved.Postgres.Id, input.Username)
+			if err != nil {
+				return nil, err
+			}
+			return &postgres.UserCreateOut{Data: *creds, Meta: postgres.NewTargetMeta(resolved)}, nil
+		}, text.PostgresUserCreate)
+		return err
+	}
+
+	return cmd
+}
@@ -0,0 +1,69 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/render-oss/cli/pkg/command"
+	"github.com/render-oss/cli/pkg/dependencies"
+	"github.com/render-oss/cli/pkg/postgres"
+	"github.com/render-oss/cli/pkg/text"
+	pgtypes "github.com/render-oss/cli/pkg/types/postgres"
+)
+
+func newPgUsersDeleteCmd(deps *dependencies.Dependencies) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "delete <postgresID|postgresName> <username>",
+		Short:        "Delete a user from a Render Postgres database",
+		Args:         cobra.ExactArgs(2),
+		SilenceUsage: true,
+		Long: `Delete a PostgreSQL user from a Render Postgres database. Clients that
+connect with the user's credentials stop working.
+
+You are asked to type the username to confirm. Pass --confirm to skip the
+prompt in scripts.`,
+		Example: `  # Delete, typing the username to confirm
+  render pg users delete my-db reporting
+
+  # Delete without a prompt
+  render pg users delete dpg-abc123def456ghi789jkl0 reporting --confirm`,
+	}
+	addPgLookupFlags(cmd, nil)
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		command.DefaultFormatNonInteractive(cmd)
+
+		var input pgtypes.UserInput
+		if err := command.ParseCommand(cmd, args, &input); err != nil {
+			return err
+		}
+		input = pgtypes.NormalizeUserInput(input)
+		target := &pgUserTarget{
+			deps: deps,
+			input: postgres.ResolveInput{
+				IDOrName:            input.IDOrName,
+				ProjectIDOrName:     input.ProjectIDOrName,
+				EnvironmentIDOrName: input.EnvironmentIDOrName,
+			},
+			username: input.Username,
+		}
+
+		loadData := func() (*postgres.UserDeleteOut, error) {
+			resolved, user, err := target.resolve(cmd.Context())
+			if err != nil {
+				return nil, err
+			}
+			if err := deps.PostgresService().DeleteUser(cmd.Context(), resolved.Postgres.Id, user.Username); err != nil {
+				return nil, err
+			}
+			return &postgres.UserDeleteOut{
+				Data: *user,
+				Meta: postgres.UserDeleteOutMeta{TargetMeta: postgres.NewTargetMeta(resolved), Deleted: true},
+			}, nil
+		}
+
+		_, err := command.NonInteractiveWithTypedConfirm(cmd, loadData, text.PostgresUserDelete, target.confirmation(cmd.Context()))
+		return err
+	}
+
+	return cmd
+}
@@ -0,0 +1,57 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/render-oss/cli/pkg/command"
+	"github.com/render-oss/cli/pkg/dependencies"
+	"github.com/render-oss/cli/pkg/postgres"
+	"github.com/render-oss/cli/pkg/text"
+	pgtypes "github.com/render-oss/cli/pkg/types/postgres"
+)
+
+func newPgUsersListCmd(deps *dependencies.Dependencies) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "list <postgresID|postgresName>",
+		Short:        "List the users of a Render Postgres database",
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+		Long: `List the PostgreSQL users of a Render Postgres database, with the number of
+open connections each one has. Passwords are not included.`,
+		Example: `  # List users by database name
+  render pg users list my-db
+
+  # JSON output
+  render pg users list dpg-abc123def456ghi789jkl0 --output json`,
+	}
+	addPgLookupFlags(cmd, nil)
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		command.DefaultFormatNonInteractive(cmd)
+
+		var input pgtypes.ListUsersInput
+		if err := command.ParseCommand(cmd, args, &input); err != nil {
+			return err
+		}
+		input = pgtypes.NormalizeListUsersInput(input)
+
+		_, err := command.NonInteractive(cmd, func() (*postgres.UsersListOut, error) {
+			resolved, err := deps.PostgresService().Resolve(cmd.Context(), postgres.ResolveInput{
+				IDOrName:            input.IDOrName,
+				ProjectIDOrName:     input.ProjectIDOrName,
+				EnvironmentIDOrName: input.EnvironmentIDOrName,
+			})
+			if err != nil {
+				return nil, err
+			}
+			users, err := deps.PostgresService().ListUsers(cmd.Context(), resolved.Postgres.Id)
+			if err != nil {
+				return nil, err
+			}
+			return &postgres.UsersListOut{Data: users, Meta: postgres.NewTargetMeta(resolved)}, nil
+		}, text.PostgresUsersTable)
+		return err
+	}
+
+	return cmd
+}
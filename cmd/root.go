@@ -120,6 +120,8 @@ func setupWorkflowCommands(deps *dependencies.Dependencies) {
 	deps.Commands.Workflow.RunListCmd = NewRunListCmd(deps)
 	deps.Commands.Workflow.RunDetailsCmd = NewRunDetailsCmd(deps)
 	deps.Commands.Workflow.RunCancelCmd = NewRunCancelCmd(deps)
+	deps.Commands.Workflow.RunRerunCmd = NewRunRerunCmd(deps)
+	deps.Commands.Workflow.RunTreeCmd = NewRunTreeCmd(deps)
 	deps.Commands.Workflow.VersionListCmd = NewVersionListCmd(deps)
 	deps.Commands.Workflow.VersionReleaseCmd = NewVersionReleaseCmd(deps)
 	deps.Commands.Workflow.WorkflowListCmd = NewWorkflowListCmd(deps)
@@ -133,6 +135,8 @@ func setupWorkflowCommands(deps *dependencies.Dependencies) {
 	tasksRunsCmd.AddCommand(deps.Commands.Workflow.RunListCmd)
 	tasksRunsCmd.AddCommand(deps.Commands.Workflow.RunDetailsCmd)
 	tasksRunsCmd.AddCommand(deps.Commands.Workflow.RunCancelCmd)
+	tasksRunsCmd.AddCommand(deps.Commands.Workflow.RunRerunCmd)
+	tasksRunsCmd.AddCommand(deps.Commands.Workflow.RunTreeCmd)
 
 	WorkflowsCmd.AddCommand(workflowStartShortcut(deps))
 	WorkflowsCmd.AddCommand(workflowCancelShortcut(deps))
@@ -152,14 +156,36 @@ func setupLogCommands(deps *dependencies.Dependencies) {
 	rootCmd.AddCommand(deps.Commands.Logs.LogsCmd)
 }
 
+func setupMetricsCommands(deps *dependencies.Dependencies) {
+	deps.Commands.Metrics.MetricsCmd = newMetricsCmd(deps)
+
+	rootCmd.AddCommand(deps.Commands.Metrics.MetricsCmd)
+}
+
 func setupWorkspaceCommands(deps *dependencies.Dependencies) {
 	deps.Commands.Workspace.WorkspaceSetCmd = WorkspaceSetCmd(deps)
 
 	workspaceCmd.AddCommand(deps.Commands.Workspace.WorkspaceSetCmd)
+	setupWorkspaceMemberCommands(workspaceCmd, deps)
+}
+
+func setupWorkspaceMemberCommands(parent *cobra.Command, deps *dependencies.Dependencies) {
+	updateRoleCmd := newWorkspaceMembersUpdateRoleCmd(deps)
+	removeCmd := newWorkspaceMembersRemoveCmd(deps)
+	parent.AddCommand(newWorkspaceMembersCmd(
+		newWorkspaceMembersListCmd(deps, updateRoleCmd, removeCmd),
+		updateRoleCmd,
+		removeCmd,
+	))
 }
 
 func setupPGCommands(parent *cobra.Command, deps *dependencies.Dependencies) {
-	parent.AddCommand(newPgCmd(newPgCreateCmd(deps), newPgDeleteCmd(deps), newPgGetCmd(deps), newPgListCmd(deps), newPgUpdateCmd(deps), newPgSuspendCmd(deps), newPgResumeCmd(deps)))
+	parent.AddCommand(newPgCmd(newPgCreateCmd(deps), newPgDeleteCmd(deps), newPgGetCmd(deps), newPgListCmd(deps), newPgUpdateCmd(deps), newPgSuspendCmd(deps), newPgResumeCmd(deps),
+		newPgUsersCmd(newPgUsersListCmd(deps), newPgUsersCreateCmd(deps), newPgUsersDeleteCmd(deps)),
+		newPgExportCmd(newPgExportCreateCmd(deps), newPgExportListCmd(deps), newPgExportDownloadCmd(deps)),
+		newPgRecoverCmd(deps), newPgInsightsCmd(deps),
+		newPgParamsCmd(newPgParamsListCmd(deps), newPgParamsAvailableCmd(deps), newPgParamsSetCmd(deps), newPgParamsUnsetCmd(deps)),
+		newPgFailoverCmd(deps), newPgPromoteCmd(deps), newPgReplicateCmd(deps)))
 }
 
 func setupKVCommands(parent *cobra.Command, deps *dependencies.Dependencies) {
@@ -174,8 +200,79 @@ func setupSandboxGroupsCommands(earlyAccess *cobra.Command, deps *dependencies.D
 	earlyAccess.AddCommand(newSandboxGroupsCmd(newSandboxGroupsListCmd(deps)))
 }
 
+func setupEnvGroupCommands(parent *cobra.Command, deps *dependencies.Dependencies) {
+	parent.AddCommand(newEnvGroupsCmd(
+		newEnvGroupsListCmd(deps),
+		newEnvGroupsGetCmd(deps),
+		newEnvGroupsCreateCmd(deps),
+		newEnvGroupsDeleteCmd(deps),
+		newEnvGroupsSetCmd(deps),
+		newEnvGroupsUnsetCmd(deps),
+		newEnvGroupsLinkCmd(deps),
+		newEnvGroupsUnlinkCmd(deps),
+	))
+}
+
+func setupDiskCommands(parent *cobra.Command, deps *dependencies.Dependencies) {
+	parent.AddCommand(newDisksCmd(
+		newDisksListCmd(deps),
+		newDisksGetCmd(deps),
+		newDisksAddCmd(deps),
+		newDisksResizeCmd(deps),
+		newDisksDeleteCmd(deps),
+		newDisksSnapshotsCmd(
+			newDisksSnapshotsListCmd(deps),
+			newDisksSnapshotsRestoreCmd(deps),
+		),
+	))
+}
+
+func setupWebhookCommands(parent *cobra.Command, deps *dependencies.Dependencies) {
+	parent.AddCommand(newWebhooksCmd(
+		newWebhooksListCmd(deps),
+		newWebhooksCreateCmd(deps),
+		newWebhooksUpdateCmd(deps),
+		newWebhooksDeleteCmd(deps),
+		newWebhooksEventsCmd(deps),
+		newWebhooksListenCmd(deps),
+	))
+}
+
+func setupAuditLogCommands(parent *cobra.Command, deps *dependencies.Dependencies) {
+	parent.AddCommand(newAuditLogsCmd(deps))
+}
+
 func setupServiceCommands(deps *dependencies.Dependencies) {
-	servicesCmd.AddCommand(newServiceDeleteCmd(deps), newServiceUpdateCmd(deps))
+	servicesCmd.AddCommand(
+		newServiceDeleteCmd(deps),
+		newServiceUpdateCmd(deps),
+		newServiceSuspendCmd(deps),
+		newServiceResumeCmd(deps),
+		newServiceScaleCmd(deps),
+		newServiceAutoscalingCmd(
+			newServiceAutoscalingGetCmd(deps),
+			newServiceAutoscalingSetCmd(deps),
+			newServiceAutoscalingDisableCmd(deps),
+		),
+		newServiceEnvCmd(
+			newServiceEnvListCmd(deps),
+			newServiceEnvGetCmd(deps),
+			newServiceEnvSetCmd(deps),
+			newServiceEnvUnsetCmd(deps),
+			newServiceEnvImportCmd(deps),
+			newServiceEnvExportCmd(deps),
+		),
+		newServiceDomainsCmd(
+			newServiceDomainsListCmd(deps),
+			newServiceDomainsAddCmd(deps),
+			newServiceDomainsRemoveCmd(deps),
+			newServiceDomainsVerifyCmd(deps),
+		),
+	)
+}
+
+func setupDeployCommands(deps *dependencies.Dependencies) {
+	deployCmd.AddCommand(newDeployRollbackCmd(deps))
 }
 
 // SetupCommands constructs and registers all CLI commands.
@@ -203,10 +300,16 @@ func setupCommands() (*dependencies.Dependencies, error) {
 	setupWorkflowCommands(deps)
 	setupAnalyticsCommands(rootCmd, deps)
 	setupLogCommands(deps)
+	setupMetricsCommands(deps)
 	setupWorkspaceCommands(deps)
 	setupServiceCommands(deps)
+	setupDeployCommands(deps)
 	setupKVCommands(rootCmd, deps)
 	setupPGCommands(rootCmd, deps)
+	setupEnvGroupCommands(rootCmd, deps)
+	setupDiskCommands(rootCmd, deps)
+	setupWebhookCommands(rootCmd, deps)
+	setupAuditLogCommands(rootCmd, deps)
 	setupSandboxCommands(EarlyAccessCmd, deps)
 	setupSandboxGroupsCommands(EarlyAccessCmd, deps)
 	setupRootCmdPersistentRun(rootCmd, deps)
@@ -3,8 +3,8 @@ package cmd
 import (
 	"fmt"
 
-	wfclient "github.com/render-oss/cli/pkg/client/workflows"
 	"github.com/render-oss/cli/pkg/command"
+	"github.com/render-oss/cli/pkg/tasks"
 	"github.com/render-oss/cli/pkg/text"
 	"github.com/render-oss/cli/pkg/tui/flows"
 	workflowviews "github.com/render-oss/cli/pkg/tui/views/workflows"
@@ -44,7 +44,7 @@ In interactive mode, you will be prompted to select a task run if not provided.`
 				return fmt.Errorf("failed to parse command: %w", err)
 			}
 
-			if nonInteractive, err := command.NonInteractive(cmd, func() (*wfclient.TaskRunDetails, error) {
+			if nonInteractive, err := command.NonInteractive(cmd, func() (*tasks.TaskRunDetails, error) {
 				res, err := deps.WorkflowLoader().LoadTaskRunDetails(cmd.Context(), &input)
 				return res, err
 			}, text.TaskRunDetails); err != nil {
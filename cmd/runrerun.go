@@ -0,0 +1,137 @@
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	wfclient "github.com/render-oss/cli/pkg/client/workflows"
+	"github.com/render-oss/cli/pkg/command"
+	"github.com/render-oss/cli/pkg/input"
+	"github.com/render-oss/cli/pkg/text"
+	"github.com/render-oss/cli/pkg/tui/flows"
+	workflowviews "github.com/render-oss/cli/pkg/tui/views/workflows"
+	"github.com/spf13/cobra"
+)
+
+func NewRunRerunCmd(deps flows.WorkflowDeps) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rerun <taskRunID>",
+		Short: "Start a new task run with the input of an existing one",
+		Long: `Re-run a task run by starting a new run of the same task.
+
+By default the new run reuses the original run's input. To change it:
+  • --input with inline JSON
+  • --input-file with a path to a JSON file
+  • --edit to open the original input in $EDITOR before starting the run
+
+Against the local workflow development server (--local), the new run is
+linked to the original so you can tell re-runs apart while debugging. Against
+Render, the new run is an ordinary run of the same task.
+
+This command only runs non-interactively. If --output interactive is requested,
+it falls back to text output.`,
+		Example: `  # Re-run a local task run with the same input
+  render workflows tasks runs rerun --local trn-xyz789
+
+  # Edit the input in $EDITOR before re-running
+  render workflows tasks runs rerun --local trn-xyz789 --edit
+
+  # Re-run with input from a file
+  render workflows tasks runs rerun --local trn-xyz789 --input-file=input.json`,
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			command.DefaultFormatNonInteractive(cmd)
+
+			deps, local, err := getLocalDeps(cmd, deps)
+			if err != nil {
+				return fmt.Errorf("failed to get local deps: %w", err)
+			}
+
+			var target workflowviews.TaskRunTargetInput
+			if err := command.ParseCommand(cmd, args, &target); err != nil {
+				return fmt.Errorf("failed to parse command: %w", err)
+			}
+
+			taskInput, err := rerunInput(cmd, deps.WorkflowLoader(), &target)
+			if err != nil {
+				return err
+			}
+
+			_, err = command.NonInteractive(cmd, func() (*wfclient.TaskRun, error) {
+				return deps.WorkflowLoader().RerunTaskRun(cmd.Context(), target.TaskRunID, taskInput, local)
+			}, func(run *wfclient.TaskRun) string {
+				return text.FormatStringF("Created task run %s (rerun of %s)", run.Id, target.TaskRunID)
+			})
+			return err
+		},
+	}
+
+	cmd.Flags().String("input", "", "Provide task input as a JSON array instead of reusing the original input")
+	cmd.Flags().String("input-file", "", "Read task input from a JSON file path")
+	cmd.Flags().Bool("edit", false, "Edit the input in $EDITOR before starting the run")
+	cmd.MarkFlagFilename("input-file")
+	cmd.MarkFlagsMutuallyExclusive("input", "input-file")
+	setFlagPlaceholder(cmd.Flags(), "input", "JSON")
+	setFlagPlaceholder(cmd.Flags(), "input-file", "PATH")
+
+	return cmd
+}
+
+// rerunInput returns the JSON input for a re-run: the --input or --input-file
+// value if given, optionally edited in $EDITOR. With --edit alone, the
+// original run's input is edited. Otherwise it returns "", and the original
+// run's input is reused without sending it back.
+func rerunInput(cmd *cobra.Command, loader *workflowviews.WorkflowLoader, target *workflowviews.TaskRunTargetInput) (string, error) {
+	taskInput, err := cmd.Flags().GetString("input")
+	if err != nil {
+		return "", err
+	}
+
+	fileName, err := cmd.Flags().GetString("input-file")
+	if err != nil {
+		return "", err
+	}
+	if fileName != "" {
+		fileName, err = command.ExpandPath(fileName)
+		if err != nil {
+			return "", fmt.Errorf("failed to resolve input file path: %w", err)
+		}
+		content, err := os.ReadFile(fileName)
+		if err != nil {
+			return "", fmt.Errorf("failed to read input file: %w", err)
+		}
+		taskInput = string(content)
+	}
+
+	edit, err := cmd.Flags().GetBool("edit")
+	if err != nil {
+		return "", err
+	}
+	if !edit {
+		return taskInput, nil
+	}
+	if os.Getenv("EDITOR") == "" {
+		return "", errors.New("--edit requires $EDITOR to be set")
+	}
+
+	if taskInput == "" {
+		original, err := loader.LoadTaskRunDetails(cmd.Context(), target)
+		if err != nil {
+			return "", err
+		}
+		content, err := json.MarshalIndent(original.Input, "", "  ")
+		if err != nil {
+			return "", fmt.Errorf("failed to encode original input: %w", err)
+		}
+		taskInput = string(content)
+	}
+
+	taskInput, err = input.OpenEditorForInput("rerun-input-*.json", taskInput)
+	if err != nil {
+		return "", fmt.Errorf("failed to edit input: %w", err)
+	}
+	return taskInput, nil
+}
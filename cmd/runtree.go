@@ -0,0 +1,63 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/render-oss/cli/pkg/command"
+	"github.com/render-oss/cli/pkg/tasks"
+	"github.com/render-oss/cli/pkg/text"
+	"github.com/render-oss/cli/pkg/tui/flows"
+	workflowviews "github.com/render-oss/cli/pkg/tui/views/workflows"
+	"github.com/spf13/cobra"
+)
+
+func NewRunTreeCmd(deps flows.WorkflowDeps) *cobra.Command {
+	return &cobra.Command{
+		Use:   "tree [taskRunID]",
+		Short: "Show a task run and all of its subtask runs as a tree",
+		Long: `Display the tree of task runs that a task run belongs to.
+
+Starting from the root task run, every subtask run it spawned is shown
+beneath its parent along with its:
+  • Task name and status
+  • Number of attempts
+  • Duration
+  • Timeline, charting each run's attempts against the whole tree
+
+In the timeline, failed attempts that were retried are drawn lighter than the
+latest attempt. Runs that are still going are charted up to the current time.
+
+Any run in the tree can be given; the whole tree is shown from its root.
+
+In interactive mode, you will be prompted to select a task run if not provided.`,
+		Example: `  # Show the run tree for a task run
+  render workflows tasks runs tree trn-1234
+
+  # Show the run tree from the local workflow development server
+  render workflows tasks runs tree --local trn-5678`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			deps, local, err := getLocalDeps(cmd, deps)
+			if err != nil {
+				return fmt.Errorf("failed to get local deps: %w", err)
+			}
+
+			var input workflowviews.TaskRunTargetInput
+			err = command.ParseCommand(cmd, args, &input)
+			if err != nil {
+				return fmt.Errorf("failed to parse command: %w", err)
+			}
+
+			if nonInteractive, err := command.NonInteractive(cmd, func() (*tasks.RunNode, error) {
+				return deps.WorkflowLoader().LoadTaskRunTree(cmd.Context(), &input)
+			}, text.TaskRunTree); err != nil {
+				return err
+			} else if nonInteractive {
+				return nil
+			}
+
+			flows.NewWorkflow(deps, flows.NewLogFlow(deps, flows.WithLocal(local)), local).RunTreeFlow(cmd.Context(), &input)
+
+			return nil
+		},
+	}
+}
@@ -86,6 +86,16 @@ func selectResource(ctx context.Context) func(resource.Resource) []views.Palette
 				},
 				allowedTypes: append([]string{postgres.PostgresType, keyvalue.KeyValueType}, service.NonStaticTypes...),
 			},
+			{
+				command: views.PaletteCommand{
+					Name:        "metrics",
+					Description: "View resource metrics",
+					Action: func(ctx context.Context, args []string) tea.Cmd {
+						return InteractiveMetrics(ctx, deps, views.MetricsInput{ResourceIDOrName: r.ID()}, "Metrics")
+					},
+				},
+				allowedTypes: append([]string{postgres.PostgresType, keyvalue.KeyValueType}, service.NonStaticTypes...),
+			},
 			{
 				command: views.PaletteCommand{
 					Name:        "restart",
@@ -0,0 +1,60 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/render-oss/cli/pkg/client"
+	"github.com/render-oss/cli/pkg/dependencies"
+	servicepkg "github.com/render-oss/cli/pkg/service"
+	"github.com/render-oss/cli/pkg/tui"
+)
+
+func newServiceAutoscalingCmd(children ...*cobra.Command) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "autoscaling",
+		Short: "Manage autoscaling for a service",
+		Long: `Manage autoscaling for a web service, private service, or background worker.
+
+With autoscaling enabled, Render adjusts the number of instances between a
+minimum and maximum to keep average CPU and/or memory usage near the target
+percentages. Free instances cannot be scaled, and no service runs more than 100
+instances.
+
+Each subcommand accepts a service ID or a name as its first argument. Name
+lookup is scoped to your active workspace. The set and disable commands print a
+diff of the change and make no changes unless --confirm is passed.`,
+	}
+	cmd.AddCommand(children...)
+	return cmd
+}
+
+// resolveScalableService resolves a service ID or name against the active
+// workspace and reads its scaling settings, rejecting service types that
+// cannot be scaled.
+func resolveScalableService(ctx context.Context, deps *dependencies.Dependencies, idOrName string) (*client.Service, servicepkg.Scaling, error) {
+	serviceID, err := resolveEnvVarServiceID(ctx, deps, idOrName)
+	if err != nil {
+		return nil, servicepkg.Scaling{}, err
+	}
+	svc, err := deps.ServiceRepo().GetService(ctx, serviceID)
+	if err != nil {
+		return nil, servicepkg.Scaling{}, err
+	}
+	scaling, ok := servicepkg.ScalingForService(svc)
+	if !ok {
+		return nil, servicepkg.Scaling{}, tui.UserFacingError{Message: fmt.Sprintf("Services of type %s cannot be scaled.", svc.Type)}
+	}
+	return svc, scaling, nil
+}
+
+func autoscalingOutData(svc *client.Service, scaling servicepkg.Scaling) servicepkg.AutoscalingOutData {
+	return servicepkg.AutoscalingOutData{
+		ServiceID:    svc.Id,
+		ServiceName:  svc.Name,
+		NumInstances: scaling.NumInstances,
+		Config:       scaling.Autoscaling,
+	}
+}
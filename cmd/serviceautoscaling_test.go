@@ -0,0 +1,164 @@
+package cmd
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	renderapi "github.com/render-oss/cli/internal/fakes/renderapi"
+	"github.com/render-oss/cli/pkg/client"
+	autoscaling "github.com/render-oss/cli/pkg/client/autoscaling"
+	"github.com/render-oss/cli/pkg/dependencies"
+	servicepkg "github.com/render-oss/cli/pkg/service"
+)
+
+func newTestServiceAutoscalingCmd(deps *dependencies.Dependencies) *cobra.Command {
+	return newServiceAutoscalingCmd(
+		newServiceAutoscalingGetCmd(deps),
+		newServiceAutoscalingSetCmd(deps),
+		newServiceAutoscalingDisableCmd(deps),
+	)
+}
+
+func seedAutoscaledService(server *renderapi.Server, name string, cfg *autoscaling.AutoscalingConfig) *client.Service {
+	return server.Services.Add(renderapi.NewWebService(renderapi.WebServiceAttrs{
+		Service: renderapi.CommonServiceAttrs{Name: name, OwnerID: serviceTestWorkspaceID},
+		Details: renderapi.WebServiceDetailsAttrs{Autoscaling: cfg, NumInstances: 2},
+	}))
+}
+
+func cpuAutoscaling(min, max, target int) *autoscaling.AutoscalingConfig {
+	return &autoscaling.AutoscalingConfig{
+		Enabled: true,
+		Min:     min,
+		Max:     max,
+		Criteria: autoscaling.AutoscalingCriteria{
+			Cpu: autoscaling.AutoscalingCriteriaPercentage{Enabled: true, Percentage: target},
+		},
+	}
+}
+
+func currentAutoscaling(t *testing.T, svc *client.Service) *autoscaling.AutoscalingConfig {
+	t.Helper()
+	scaling, ok := servicepkg.ScalingForService(svc)
+	require.True(t, ok)
+	return scaling.Autoscaling
+}
+
+func TestServiceAutoscalingGet_Disabled(t *testing.T) {
+	server := renderapi.NewServer(t)
+	seedAutoscaledService(server, "my-api", nil)
+
+	result, err := executeServiceSubcommand(t, server, newTestServiceAutoscalingCmd, "autoscaling", "get", "my-api")
+	require.NoError(t, err, "stderr: %s", result.Stderr)
+
+	assert.Contains(t, result.Stdout, "Autoscaling: disabled")
+	assert.Contains(t, result.Stdout, "Instances: 2")
+}
+
+func TestServiceAutoscalingGet_JSON(t *testing.T) {
+	server := renderapi.NewServer(t)
+	svc := seedAutoscaledService(server, "my-api", cpuAutoscaling(2, 6, 60))
+
+	result, err := executeServiceSubcommand(t, server, newTestServiceAutoscalingCmd, "autoscaling", "get", svc.Id, "--output", "json")
+	require.NoError(t, err, "stderr: %s", result.Stderr)
+
+	var out servicepkg.AutoscalingOut
+	require.NoError(t, json.Unmarshal([]byte(result.Stdout), &out), result.Stdout)
+	assert.Equal(t, cpuAutoscaling(2, 6, 60), out.Data.Config)
+}
+
+func TestServiceAutoscalingSet_PreviewShowsDiff(t *testing.T) {
+	server := renderapi.NewServer(t)
+	svc := seedAutoscaledService(server, "my-api", cpuAutoscaling(2, 6, 60))
+
+	result, err := executeServiceSubcommand(t, server, newTestServiceAutoscalingCmd,
+		"autoscaling", "set", "my-api", "--max", "10", "--memory-target", "80")
+	require.NoError(t, err, "stderr: %s", result.Stderr)
+
+	assert.Contains(t, result.Stdout, "This command would change autoscaling")
+	assert.Contains(t, result.Stdout, "Max:           6 → 10")
+	assert.Contains(t, result.Stdout, "Memory target: off → 80%")
+	assert.NotContains(t, result.Stdout, "Min:")
+	assert.Contains(t, result.Stdout, "Re-run with --confirm to proceed")
+	assert.Equal(t, cpuAutoscaling(2, 6, 60), currentAutoscaling(t, svc))
+}
+
+func TestServiceAutoscalingSet_EnablesWithConfirm(t *testing.T) {
+	server := renderapi.NewServer(t)
+	svc := seedAutoscaledService(server, "my-api", nil)
+
+	result, err := executeServiceSubcommand(t, server, newTestServiceAutoscalingCmd,
+		"autoscaling", "set", "my-api", "--max", "4", "--confirm", "--output", "json")
+	require.NoError(t, err, "stderr: %s", result.Stderr)
+
+	var out servicepkg.AutoscalingChangeOut
+	require.NoError(t, json.Unmarshal([]byte(result.Stdout), &out), result.Stdout)
+	assert.True(t, out.Meta.Applied)
+	require.NotNil(t, out.Diff.Enabled)
+	assert.True(t, out.Diff.Enabled.After)
+	assert.Equal(t, cpuAutoscaling(1, 4, 70), currentAutoscaling(t, svc))
+}
+
+func TestServiceAutoscalingSet_RequiresMaxWhenEnabling(t *testing.T) {
+	server := renderapi.NewServer(t)
+	seedAutoscaledService(server, "my-api", nil)
+
+	_, err := executeServiceSubcommand(t, server, newTestServiceAutoscalingCmd, "autoscaling", "set", "my-api", "--min", "2", "--confirm")
+	require.ErrorContains(t, err, "--max is required")
+}
+
+func TestServiceAutoscalingSet_RejectsFreePlan(t *testing.T) {
+	server := renderapi.NewServer(t)
+	server.Services.Add(renderapi.NewWebService(renderapi.WebServiceAttrs{
+		Service: renderapi.CommonServiceAttrs{Name: "hobby", OwnerID: serviceTestWorkspaceID},
+		Details: renderapi.WebServiceDetailsAttrs{Plan: client.PlanFree},
+	}))
+
+	_, err := executeServiceSubcommand(t, server, newTestServiceAutoscalingCmd, "autoscaling", "set", "hobby", "--max", "3", "--confirm")
+	require.ErrorContains(t, err, "Free instances run a single instance")
+}
+
+func TestServiceAutoscalingSet_RejectsTooManyInstances(t *testing.T) {
+	server := renderapi.NewServer(t)
+	seedAutoscaledService(server, "my-api", nil)
+
+	_, err := executeServiceSubcommand(t, server, newTestServiceAutoscalingCmd, "autoscaling", "set", "my-api", "--max", "101", "--confirm")
+	require.ErrorContains(t, err, "at most 100 instances")
+}
+
+func TestServiceAutoscalingDisable_RemovesConfigWithConfirm(t *testing.T) {
+	server := renderapi.NewServer(t)
+	svc := seedAutoscaledService(server, "my-api", cpuAutoscaling(2, 6, 60))
+
+	result, err := executeServiceSubcommand(t, server, newTestServiceAutoscalingCmd, "autoscaling", "disable", "my-api", "--confirm")
+	require.NoError(t, err, "stderr: %s", result.Stderr)
+
+	assert.Contains(t, result.Stdout, "Updated autoscaling")
+	assert.Contains(t, result.Stdout, "Autoscaling:   enabled → disabled")
+	assert.Nil(t, currentAutoscaling(t, svc))
+}
+
+func TestServiceAutoscalingDisable_AlreadyDisabled(t *testing.T) {
+	server := renderapi.NewServer(t)
+	seedAutoscaledService(server, "my-api", nil)
+
+	result, err := executeServiceSubcommand(t, server, newTestServiceAutoscalingCmd, "autoscaling", "disable", "my-api", "--confirm")
+	require.NoError(t, err, "stderr: %s", result.Stderr)
+
+	assert.Contains(t, result.Stdout, "No changes")
+	for _, r := range server.Requests {
+		assert.NotEqual(t, "DELETE", r.Method)
+	}
+}
+
+func TestServiceScale_RejectsAutoscaledService(t *testing.T) {
+	server := renderapi.NewServer(t)
+	seedAutoscaledService(server, "my-api", cpuAutoscaling(2, 6, 60))
+
+	_, err := executeServiceSubcommand(t, server, newServiceScaleCmd, "scale", "my-api", "--instances", "3", "--confirm")
+	require.ErrorContains(t, err, "has autoscaling enabled")
+}
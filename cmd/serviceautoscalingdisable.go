@@ -0,0 +1,80 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/render-oss/cli/pkg/command"
+	"github.com/render-oss/cli/pkg/dependencies"
+	servicepkg "github.com/render-oss/cli/pkg/service"
+	"github.com/render-oss/cli/pkg/text"
+	servicetypes "github.com/render-oss/cli/pkg/types/service"
+)
+
+func newServiceAutoscalingDisableCmd(deps *dependencies.Dependencies) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "disable <serviceID|serviceName>",
+		Short:        "Turn off autoscaling for a service",
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+		Long: `Turn off autoscaling for a service. The service returns to its manual instance
+count, which you can change with 'render services scale'.
+
+Without --confirm, this command prints a diff of the change and makes no
+changes. Pass --confirm to apply it.
+
+This command only runs non-interactively. If --output interactive is requested,
+it falls back to text output.`,
+		Example: `  # Preview turning off autoscaling
+  render services autoscaling disable my-api
+
+  # Turn it off
+  render services autoscaling disable my-api --confirm`,
+	}
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		command.DefaultFormatNonInteractive(cmd)
+
+		var input servicetypes.AutoscalingDisableInput
+		if err := command.ParseCommand(cmd, args, &input); err != nil {
+			return err
+		}
+		confirm := command.GetConfirmFromContext(cmd.Context())
+
+		loadData := func() (*servicepkg.AutoscalingChangeOut, error) {
+			svc, scaling, err := resolveScalableService(cmd.Context(), deps, input.ServiceIDOrName)
+			if err != nil {
+				return nil, err
+			}
+
+			out := servicepkg.AutoscalingChangeOut{
+				Data: autoscalingOutData(svc, scaling),
+				Diff: servicepkg.NewAutoscalingDiff(scaling.Autoscaling, nil),
+			}
+			out.Data.Config = nil
+			switch {
+			case out.Diff.Empty():
+				out.Meta.Message = "autoscaling is already disabled"
+				return &out, nil
+			case !confirm:
+				out.Meta.Message = "re-run with --confirm to apply"
+				return &out, nil
+			}
+
+			if err := deps.ServiceRepo().DeleteAutoscaling(cmd.Context(), svc.Id); err != nil {
+				return nil, err
+			}
+			svc, scaling, err = resolveScalableService(cmd.Context(), deps, svc.Id)
+			if err != nil {
+				return nil, err
+			}
+			out.Data = autoscalingOutData(svc, scaling)
+			out.Meta.Applied = true
+			return &out, nil
+		}
+
+		_, err := command.NonInteractive(cmd, loadData, text.AutoscalingChange)
+		return err
+	}
+
+	return cmd
+}
@@ -0,0 +1,53 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/render-oss/cli/pkg/command"
+	"github.com/render-oss/cli/pkg/dependencies"
+	servicepkg "github.com/render-oss/cli/pkg/service"
+	"github.com/render-oss/cli/pkg/text"
+	servicetypes "github.com/render-oss/cli/pkg/types/service"
+)
+
+func newServiceAutoscalingGetCmd(deps *dependencies.Dependencies) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "get <serviceID|serviceName>",
+		Short:        "Show the autoscaling config for a service",
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+		Long: `Show whether autoscaling is enabled for a service, its instance range, and
+its CPU and memory targets. When autoscaling is disabled, the manual instance
+count is shown instead.
+
+This command only runs non-interactively. If --output interactive is requested,
+it falls back to text output.`,
+		Example: `  # Show autoscaling for a service
+  render services autoscaling get my-api
+
+  # JSON output
+  render services autoscaling get srv-abc123def456ghi789jkl0 --output json`,
+	}
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		command.DefaultFormatNonInteractive(cmd)
+
+		var input servicetypes.AutoscalingGetInput
+		if err := command.ParseCommand(cmd, args, &input); err != nil {
+			return err
+		}
+
+		loadData := func() (*servicepkg.AutoscalingOut, error) {
+			svc, scaling, err := resolveScalableService(cmd.Context(), deps, input.ServiceIDOrName)
+			if err != nil {
+				return nil, err
+			}
+			return &servicepkg.AutoscalingOut{Data: autoscalingOutData(svc, scaling)}, nil
+		}
+
+		_, err := command.NonInteractive(cmd, loadData, text.Autoscaling)
+		return err
+	}
+
+	return cmd
+}
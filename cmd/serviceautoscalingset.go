@@ -0,0 +1,102 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/render-oss/cli/pkg/command"
+	"github.com/render-oss/cli/pkg/dependencies"
+	servicepkg "github.com/render-oss/cli/pkg/service"
+	"github.com/render-oss/cli/pkg/text"
+	servicetypes "github.com/render-oss/cli/pkg/types/service"
+)
+
+func newServiceAutoscalingSetCmd(deps *dependencies.Dependencies) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "set <serviceID|serviceName>",
+		Short:        "Enable or change autoscaling for a service",
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+		Long: `Enable autoscaling for a service, or change its existing config.
+
+Flags you omit keep their current values. When autoscaling is enabled for the
+first time, --max is required, --min defaults to 1, and the CPU target defaults
+to 70% unless a target is given. Pass 0 to --cpu-target or --memory-target to
+turn that target off; at least one target must stay on.
+
+Without --confirm, this command prints a diff of the change and makes no
+changes. Pass --confirm to apply it.
+
+This command only runs non-interactively. If --output interactive is requested,
+it falls back to text output.`,
+		Example: `  # Preview enabling autoscaling between 2 and 10 instances
+  render services autoscaling set my-api --min 2 --max 10
+
+  # Apply it
+  render services autoscaling set my-api --min 2 --max 10 --confirm
+
+  # Scale on memory instead of CPU
+  render services autoscaling set my-api --cpu-target 0 --memory-target 80 --confirm`,
+	}
+
+	cmd.Flags().Int("min", 0, "Minimum number of instances")
+	cmd.Flags().Int("max", 0, "Maximum number of instances")
+	cmd.Flags().Int("cpu-target", 0, "Target average CPU usage percentage (0 turns it off)")
+	cmd.Flags().Int("memory-target", 0, "Target average memory usage percentage (0 turns it off)")
+	setAllFlagPlaceholders(cmd, map[string]string{
+		"min":           "COUNT",
+		"max":           "COUNT",
+		"cpu-target":    "PERCENT",
+		"memory-target": "PERCENT",
+	})
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		command.DefaultFormatNonInteractive(cmd)
+
+		var input servicetypes.AutoscalingSetInput
+		if err := command.ParseCommand(cmd, args, &input); err != nil {
+			return err
+		}
+		confirm := command.GetConfirmFromContext(cmd.Context())
+
+		loadData := func() (*servicepkg.AutoscalingChangeOut, error) {
+			svc, scaling, err := resolveScalableService(cmd.Context(), deps, input.ServiceIDOrName)
+			if err != nil {
+				return nil, err
+			}
+			cfg, err := servicepkg.ApplyAutoscalingInput(scaling.Autoscaling, input)
+			if err != nil {
+				return nil, err
+			}
+			if err := servicepkg.CheckInstanceLimits(scaling.Plan, cfg.Max); err != nil {
+				return nil, err
+			}
+
+			out := servicepkg.AutoscalingChangeOut{
+				Data: autoscalingOutData(svc, scaling),
+				Diff: servicepkg.NewAutoscalingDiff(scaling.Autoscaling, &cfg),
+			}
+			out.Data.Config = &cfg
+			switch {
+			case out.Diff.Empty():
+				out.Meta.Message = "no changes"
+				return &out, nil
+			case !confirm:
+				out.Meta.Message = "re-run with --confirm to apply"
+				return &out, nil
+			}
+
+			stored, err := deps.ServiceRepo().SetAutoscaling(cmd.Context(), svc.Id, cfg)
+			if err != nil {
+				return nil, err
+			}
+			out.Data.Config = stored
+			out.Meta.Applied = true
+			return &out, nil
+		}
+
+		_, err := command.NonInteractive(cmd, loadData, text.AutoscalingChange)
+		return err
+	}
+
+	return cmd
+}
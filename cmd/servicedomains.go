@@ -0,0 +1,90 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/render-oss/cli/pkg/client"
+	"github.com/render-oss/cli/pkg/command"
+	"github.com/render-oss/cli/pkg/dependencies"
+	servicepkg "github.com/render-oss/cli/pkg/service"
+)
+
+// customDomainPollInterval is how often --wait re-checks DNS verification.
+// Tests shorten it.
+var customDomainPollInterval = 10 * time.Second
+
+func newServiceDomainsCmd(children ...*cobra.Command) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "domains",
+		Aliases: []string{"custom-domains"},
+		Short:   "Manage custom domains for a service",
+		Long: `Manage custom domains for a web service or static site.
+
+Each subcommand accepts a service ID or a name as its first argument. Name
+lookup is scoped to your active workspace.
+
+A domain serves traffic once Render has verified its DNS records. The list,
+add, and verify commands print the records to create with your DNS provider
+for any domain that is not yet verified. Pass --wait to add or verify to block
+until verification succeeds, which is useful in release scripts.`,
+	}
+	cmd.AddCommand(children...)
+	return cmd
+}
+
+// resolveCustomDomainService resolves a service ID or name against the active
+// workspace and returns the service, whose URL the DNS records point at.
+func resolveCustomDomainService(ctx context.Context, deps *dependencies.Dependencies, idOrName string) (*client.Service, error) {
+	serviceID, err := resolveEnvVarServiceID(ctx, deps, idOrName)
+	if err != nil {
+		return nil, err
+	}
+	return deps.ServiceRepo().GetService(ctx, serviceID)
+}
+
+// customDomainListOut builds the output for domains on svc. waitErr is the
+// result of waiting for verification, if the command waited.
+func customDomainListOut(svc *client.Service, domains []client.CustomDomain, timeout time.Duration, waitErr error) (*servicepkg.CustomDomainListOut, error) {
+	out := &servicepkg.CustomDomainListOut{
+		Data: servicepkg.NewCustomDomainOuts(domains, servicepkg.ServiceHostname(svc)),
+		Meta: servicepkg.CustomDomainListOutMeta{
+			ServiceID: svc.Id,
+			Verified:  servicepkg.AllCustomDomainsVerified(domains),
+		},
+	}
+
+	switch {
+	case errors.Is(waitErr, servicepkg.ErrCustomDomainWaitTimeout):
+		out.Meta.TimedOut = true
+		out.Meta.Message = fmt.Sprintf(
+			"Timed out after %s waiting for DNS verification. DNS changes can take a while to propagate; run `render services domains verify %s --wait` to keep waiting.",
+			timeout, svc.Id,
+		)
+	case waitErr != nil:
+		return nil, waitErr
+	}
+	return out, nil
+}
+
+// exitIfCustomDomainWaitTimedOut makes the command exit non-zero after its
+// output has been printed when --wait gave up before verification.
+func exitIfCustomDomainWaitTimedOut(cmd *cobra.Command, out *servicepkg.CustomDomainListOut) error {
+	if out == nil || !out.Meta.TimedOut {
+		return nil
+	}
+	cmd.Root().SilenceErrors = true
+	return command.NewExitError(1, nil)
+}
+
+func customDomainNames(domains []client.CustomDomain) []string {
+	names := make([]string, 0, len(domains))
+	for _, d := range domains {
+		names = append(names, d.Name)
+	}
+	return names
+}
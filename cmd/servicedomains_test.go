@@ -0,0 +1,162 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	renderapi "github.com/render-oss/cli/internal/fakes/renderapi"
+	"github.com/render-oss/cli/pkg/client"
+	"github.com/render-oss/cli/pkg/command"
+	"github.com/render-oss/cli/pkg/dependencies"
+	servicepkg "github.com/render-oss/cli/pkg/service"
+)
+
+func executeServiceDomains(t *testing.T, server *renderapi.Server, args ...string) (CommandResult, error) {
+	t.Helper()
+
+	interval := customDomainPollInterval
+	customDomainPollInterval = 10 * time.Millisecond
+	t.Cleanup(func() { customDomainPollInterval = interval })
+
+	return executeServiceSubcommand(t, server, func(deps *dependencies.Dependencies) *cobra.Command {
+		return newServiceDomainsCmd(
+			newServiceDomainsListCmd(deps),
+			newServiceDomainsAddCmd(deps),
+			newServiceDomainsRemoveCmd(deps),
+			newServiceDomainsVerifyCmd(deps),
+		)
+	}, append([]string{"domains"}, args...)...)
+}
+
+func seedSiteService(server *renderapi.Server, name string) *client.Service {
+	return server.Services.Add(renderapi.NewWebService(renderapi.WebServiceAttrs{
+		Service: renderapi.CommonServiceAttrs{
+			Name:    name,
+			OwnerID: serviceTestWorkspaceID,
+		},
+		Details: renderapi.WebServiceDetailsAttrs{URL: "https://" + name + ".onrender.com"},
+	}))
+}
+
+func TestServiceDomainsList_PrintsRecordsForUnverifiedDomains(t *testing.T) {
+	server := renderapi.NewServer(t)
+	svc := seedSiteService(server, "my-site")
+	verified := renderapi.NewCustomDomain("done.example.com")
+	verified.VerificationStatus = client.CustomDomainVerificationStatusVerified
+	server.Services.AddCustomDomain(svc.Id, verified)
+	server.Services.AddCustomDomain(svc.Id, renderapi.NewCustomDomain("app.example.com"))
+
+	result, err := executeServiceDomains(t, server, "list", "my-site", "--output", "text")
+	require.NoError(t, err)
+
+	assert.Contains(t, result.Stdout, "done.example.com")
+	assert.Contains(t, result.Stdout, "Create these DNS records")
+	assert.Contains(t, result.Stdout, "CNAME")
+	assert.Contains(t, result.Stdout, "my-site.onrender.com")
+	assert.Equal(t, 1, bytes.Count([]byte(result.Stdout), []byte("CNAME")))
+}
+
+func TestServiceDomainsAdd_ApexReturnsRedirectAndRecords(t *testing.T) {
+	server := renderapi.NewServer(t)
+	svc := seedSiteService(server, "my-site")
+
+	result, err := executeServiceDomains(t, server, "add", "my-site", "Example.com", "--output", "json")
+	require.NoError(t, err)
+
+	var out servicepkg.CustomDomainListOut
+	require.NoError(t, json.Unmarshal([]byte(result.Stdout), &out))
+	require.Len(t, out.Data, 2)
+	assert.Equal(t, "example.com", out.Data[0].Name)
+	assert.Equal(t, "ALIAS", out.Data[0].DNSRecords[0].Type)
+	assert.Equal(t, "www.example.com", out.Data[1].Name)
+	assert.Equal(t, "example.com", out.Data[1].RedirectForName)
+	assert.False(t, out.Meta.Verified)
+	assert.Len(t, server.Services.CustomDomains[svc.Id], 2)
+}
+
+func TestServiceDomainsAdd_WaitPollsUntilVerified(t *testing.T) {
+	server := renderapi.NewServer(t)
+	seedSiteService(server, "my-site")
+	refreshes := 0
+	server.Services.OnCustomDomainRefresh = func(_ string, d *client.CustomDomain) {
+		refreshes++
+		if refreshes >= 3 {
+			d.VerificationStatus = client.CustomDomainVerificationStatusVerified
+		}
+	}
+
+	result, err := executeServiceDomains(t, server, "add", "my-site", "app.example.com", "--wait", "--output", "json")
+	require.NoError(t, err)
+
+	var out servicepkg.CustomDomainListOut
+	require.NoError(t, json.Unmarshal([]byte(result.Stdout), &out))
+	assert.True(t, out.Meta.Verified)
+	assert.False(t, out.Meta.TimedOut)
+	assert.Equal(t, 3, refreshes)
+}
+
+func TestServiceDomainsVerify_WaitTimesOutWithNonZeroExit(t *testing.T) {
+	server := renderapi.NewServer(t)
+	svc := seedSiteService(server, "my-site")
+	server.Services.AddCustomDomain(svc.Id, renderapi.NewCustomDomain("app.example.com"))
+
+	result, err := executeServiceDomains(t, server, "verify", "my-site", "--wait", "--timeout", "1", "--output", "json")
+
+	var exitErr command.ExitCoder
+	require.ErrorAs(t, err, &exitErr)
+	assert.Equal(t, 1, exitErr.ExitCode())
+
+	var out servicepkg.CustomDomainListOut
+	require.NoError(t, json.Unmarshal([]byte(result.Stdout), &out))
+	assert.True(t, out.Meta.TimedOut)
+	assert.False(t, out.Meta.Verified)
+	assert.Contains(t, out.Meta.Message, "Timed out")
+}
+
+func TestServiceDomainsVerify_WithoutDomainsErrors(t *testing.T) {
+	server := renderapi.NewServer(t)
+	seedSiteService(server, "my-site")
+
+	_, err := executeServiceDomains(t, server, "verify", "my-site")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "No custom domains on service")
+}
+
+func TestServiceDomainsRemove_PreviewsWithoutConfirm(t *testing.T) {
+	server := renderapi.NewServer(t)
+	svc := seedSiteService(server, "my-site")
+	server.Services.AddCustomDomain(svc.Id, renderapi.NewCustomDomain("app.example.com"))
+
+	result, err := executeServiceDomains(t, server, "remove", "my-site", "app.example.com", "--output", "text")
+	require.NoError(t, err)
+
+	assert.Contains(t, result.Stdout, "would remove custom domain app.example.com")
+	assert.Len(t, server.Services.CustomDomains[svc.Id], 1)
+}
+
+func TestServiceDomainsRemove_Confirm(t *testing.T) {
+	server := renderapi.NewServer(t)
+	svc := seedSiteService(server, "my-site")
+	server.Services.AddCustomDomain(svc.Id, renderapi.NewCustomDomain("app.example.com"))
+
+	result, err := executeServiceDomains(t, server, "remove", "my-site", "app.example.com", "--confirm", "--output", "text")
+	require.NoError(t, err)
+
+	assert.Contains(t, result.Stdout, "Removed custom domain app.example.com")
+	assert.Empty(t, server.Services.CustomDomains[svc.Id])
+}
+
+func TestServiceDomainsRemove_UnknownDomain(t *testing.T) {
+	server := renderapi.NewServer(t)
+	seedSiteService(server, "my-site")
+
+	_, err := executeServiceDomains(t, server, "remove", "my-site", "nope.example.com", "--confirm")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "No custom domain 'nope.example.com'")
+}
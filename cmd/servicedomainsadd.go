@@ -0,0 +1,90 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/render-oss/cli/pkg/command"
+	"github.com/render-oss/cli/pkg/dependencies"
+	servicepkg "github.com/render-oss/cli/pkg/service"
+	"github.com/render-oss/cli/pkg/text"
+	servicetypes "github.com/render-oss/cli/pkg/types/service"
+)
+
+func newServiceDomainsAddCmd(deps *dependencies.Dependencies) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "add <serviceID|serviceName> <domain>",
+		Short:        "Attach a custom domain to a service",
+		Args:         cobra.ExactArgs(2),
+		SilenceUsage: true,
+		Long: `Attach a custom domain to a service and print the DNS records to create
+with your DNS provider.
+
+Adding an apex domain such as example.com also adds www.example.com as a
+redirect, and adding www.example.com also adds example.com. Both are listed in
+the output.
+
+With --wait, the command re-checks DNS until every added domain is verified or
+--timeout seconds pass. It exits non-zero on timeout.
+
+This command only runs non-interactively. If --output interactive is requested,
+it falls back to text output.`,
+		Example: `  # Add a domain and print the DNS records to create
+  render services domains add my-site app.example.com
+
+  # Add a domain and wait up to 15 minutes for DNS verification
+  render services domains add my-site app.example.com --wait --timeout 900
+
+  # JSON output
+  render services domains add srv-abc123def456ghi789jkl0 example.com --output json`,
+	}
+
+	cmd.Flags().Bool("wait", false, "Wait for DNS verification and exit non-zero if it does not succeed before the timeout")
+	cmd.Flags().Int("timeout", servicetypes.DefaultCustomDomainWaitTimeoutSeconds, "Seconds to wait for DNS verification with --wait")
+	setAllFlagPlaceholders(cmd, map[string]string{
+		"timeout": "SECONDS",
+	})
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		command.DefaultFormatNonInteractive(cmd)
+
+		var input servicetypes.CustomDomainAddInput
+		if err := command.ParseCommand(cmd, args, &input); err != nil {
+			return err
+		}
+		domain, err := servicetypes.NormalizeCustomDomainName(input.Domain)
+		if err != nil {
+			return err
+		}
+		timeout, err := servicetypes.CustomDomainWaitTimeout(input.Timeout)
+		if err != nil {
+			return err
+		}
+
+		var result *servicepkg.CustomDomainListOut
+		loadData := func() (*servicepkg.CustomDomainListOut, error) {
+			svc, err := resolveCustomDomainService(cmd.Context(), deps, input.ServiceIDOrName)
+			if err != nil {
+				return nil, err
+			}
+			repo := deps.ServiceRepo()
+			domains, err := repo.CreateCustomDomain(cmd.Context(), svc.Id, domain)
+			if err != nil {
+				return nil, err
+			}
+
+			var waitErr error
+			if input.Wait && !servicepkg.AllCustomDomainsVerified(domains) {
+				domains, waitErr = repo.WaitForCustomDomains(cmd.Context(), svc.Id, customDomainNames(domains), timeout, customDomainPollInterval)
+			}
+			result, err = customDomainListOut(svc, domains, timeout, waitErr)
+			return result, err
+		}
+
+		if _, err := command.NonInteractive(cmd, loadData, text.CustomDomainTable); err != nil {
+			return err
+		}
+		return exitIfCustomDomainWaitTimedOut(cmd, result)
+	}
+
+	return cmd
+}
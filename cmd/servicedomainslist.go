@@ -0,0 +1,57 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/render-oss/cli/pkg/command"
+	"github.com/render-oss/cli/pkg/dependencies"
+	servicepkg "github.com/render-oss/cli/pkg/service"
+	"github.com/render-oss/cli/pkg/text"
+	servicetypes "github.com/render-oss/cli/pkg/types/service"
+)
+
+func newServiceDomainsListCmd(deps *dependencies.Dependencies) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "list <serviceID|serviceName>",
+		Short:        "List custom domains for a service",
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+		Long: `List the custom domains attached to a service with their DNS verification
+status. For each unverified domain, the DNS records to create are printed below
+the table.
+
+This command only runs non-interactively. If --output interactive is requested,
+it falls back to text output.`,
+		Example: `  # List domains and any pending DNS records
+  render services domains list my-site
+
+  # JSON output, including the DNS records for each domain
+  render services domains list srv-abc123def456ghi789jkl0 --output json`,
+	}
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		command.DefaultFormatNonInteractive(cmd)
+
+		var input servicetypes.CustomDomainListInput
+		if err := command.ParseCommand(cmd, args, &input); err != nil {
+			return err
+		}
+
+		loadData := func() (*servicepkg.CustomDomainListOut, error) {
+			svc, err := resolveCustomDomainService(cmd.Context(), deps, input.ServiceIDOrName)
+			if err != nil {
+				return nil, err
+			}
+			domains, err := deps.ServiceRepo().ListCustomDomains(cmd.Context(), svc.Id)
+			if err != nil {
+				return nil, err
+			}
+			return customDomainListOut(svc, domains, 0, nil)
+		}
+
+		_, err := command.NonInteractive(cmd, loadData, text.CustomDomainTable)
+		return err
+	}
+
+	return cmd
+}
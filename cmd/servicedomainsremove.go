@@ -0,0 +1,82 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/render-oss/cli/pkg/command"
+	"github.com/render-oss/cli/pkg/dependencies"
+	servicepkg "github.com/render-oss/cli/pkg/service"
+	"github.com/render-oss/cli/pkg/text"
+	servicetypes "github.com/render-oss/cli/pkg/types/service"
+)
+
+func newServiceDomainsRemoveCmd(deps *dependencies.Dependencies) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "remove <serviceID|serviceName> <domain>",
+		Aliases:      []string{"rm"},
+		Short:        "Detach a custom domain from a service",
+		Args:         cobra.ExactArgs(2),
+		SilenceUsage: true,
+		Long: `Detach a custom domain from a service. The domain stops serving traffic
+immediately. Your DNS records are not changed.
+
+Without --confirm, this command previews the removal and makes no changes.
+Pass --confirm to actually remove the domain.
+
+This command only runs non-interactively. If --output interactive is requested,
+it falls back to text output.`,
+		Example: `  # Preview removal (no changes made)
+  render services domains remove my-site app.example.com
+
+  # Remove the domain
+  render services domains remove my-site app.example.com --confirm
+
+  # JSON output
+  render services domains remove srv-abc123def456ghi789jkl0 app.example.com --confirm --output json`,
+	}
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		command.DefaultFormatNonInteractive(cmd)
+
+		var input servicetypes.CustomDomainRemoveInput
+		if err := command.ParseCommand(cmd, args, &input); err != nil {
+			return err
+		}
+		domain, err := servicetypes.NormalizeCustomDomainName(input.Domain)
+		if err != nil {
+			return err
+		}
+		confirm := command.GetConfirmFromContext(cmd.Context())
+
+		loadData := func() (*servicepkg.CustomDomainRemoveOut, error) {
+			svc, err := resolveCustomDomainService(cmd.Context(), deps, input.ServiceIDOrName)
+			if err != nil {
+				return nil, err
+			}
+			repo := deps.ServiceRepo()
+			d, err := repo.GetCustomDomain(cmd.Context(), svc.Id, domain)
+			if err != nil {
+				return nil, err
+			}
+
+			out := &servicepkg.CustomDomainRemoveOut{
+				Data: servicepkg.CustomDomainOut{CustomDomain: *d, DNSRecords: []servicepkg.DNSRecord{}},
+			}
+			if !confirm {
+				out.Meta.Message = "re-run with --confirm to remove"
+				return out, nil
+			}
+
+			if err := repo.DeleteCustomDomain(cmd.Context(), svc.Id, d.Id); err != nil {
+				return nil, err
+			}
+			out.Meta.Deleted = true
+			return out, nil
+		}
+
+		_, err = command.NonInteractive(cmd, loadData, text.CustomDomainRemove)
+		return err
+	}
+
+	return cmd
+}
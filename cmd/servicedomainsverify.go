@@ -0,0 +1,109 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/render-oss/cli/pkg/client"
+	"github.com/render-oss/cli/pkg/command"
+	"github.com/render-oss/cli/pkg/dependencies"
+	servicepkg "github.com/render-oss/cli/pkg/service"
+	"github.com/render-oss/cli/pkg/text"
+	"github.com/render-oss/cli/pkg/tui"
+	servicetypes "github.com/render-oss/cli/pkg/types/service"
+)
+
+func newServiceDomainsVerifyCmd(deps *dependencies.Dependencies) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "verify <serviceID|serviceName> [domain]",
+		Short:        "Re-check DNS for a service's custom domains",
+		Args:         cobra.RangeArgs(1, 2),
+		SilenceUsage: true,
+		Long: `Ask Render to re-check the DNS records of a custom domain, or of every
+domain on the service when no domain is given, and print the result.
+
+Render checks DNS in the background, so a single verify may report a domain as
+unverified even when its records are correct. With --wait, the command keeps
+re-checking until every domain is verified or --timeout seconds pass. It exits
+non-zero on timeout.
+
+This command only runs non-interactively. If --output interactive is requested,
+it falls back to text output.`,
+		Example: `  # Re-check every domain on a service
+  render services domains verify my-site
+
+  # Wait for one domain to verify, for use in release scripts
+  render services domains verify my-site app.example.com --wait
+
+  # JSON output
+  render services domains verify srv-abc123def456ghi789jkl0 --wait --output json`,
+	}
+
+	cmd.Flags().Bool("wait", false, "Wait for DNS verification and exit non-zero if it does not succeed before the timeout")
+	cmd.Flags().Int("timeout", servicetypes.DefaultCustomDomainWaitTimeoutSeconds, "Seconds to wait for DNS verification with --wait")
+	setAllFlagPlaceholders(cmd, map[string]string{
+		"timeout": "SECONDS",
+	})
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		command.DefaultFormatNonInteractive(cmd)
+
+		var input servicetypes.CustomDomainVerifyInput
+		if err := command.ParseCommand(cmd, args, &input); err != nil {
+			return err
+		}
+		if input.Domain != "" {
+			domain, err := servicetypes.NormalizeCustomDomainName(input.Domain)
+			if err != nil {
+				return err
+			}
+			input.Domain = domain
+		}
+		timeout, err := servicetypes.CustomDomainWaitTimeout(input.Timeout)
+		if err != nil {
+			return err
+		}
+
+		var result *servicepkg.CustomDomainListOut
+		loadData := func() (*servicepkg.CustomDomainListOut, error) {
+			svc, err := resolveCustomDomainService(cmd.Context(), deps, input.ServiceIDOrName)
+			if err != nil {
+				return nil, err
+			}
+			repo := deps.ServiceRepo()
+
+			names := []string{input.Domain}
+			if input.Domain == "" {
+				all, err := repo.ListCustomDomains(cmd.Context(), svc.Id)
+				if err != nil {
+					return nil, err
+				}
+				if len(all) == 0 {
+					return nil, tui.UserFacingError{Message: fmt.Sprintf("No custom domains on service %s. Run `render services domains add` first.", svc.Id)}
+				}
+				names = customDomainNames(all)
+			}
+
+			var domains []client.CustomDomain
+			var waitErr error
+			if input.Wait {
+				domains, waitErr = repo.WaitForCustomDomains(cmd.Context(), svc.Id, names, timeout, customDomainPollInterval)
+			} else {
+				domains, err = repo.RefreshCustomDomains(cmd.Context(), svc.Id, names)
+				if err != nil {
+					return nil, err
+				}
+			}
+			result, err = customDomainListOut(svc, domains, timeout, waitErr)
+			return result, err
+		}
+
+		if _, err := command.NonInteractive(cmd, loadData, text.CustomDomainTable); err != nil {
+			return err
+		}
+		return exitIfCustomDomainWaitTimedOut(cmd, result)
+	}
+
+	return cmd
+}
@@ -0,0 +1,39 @@
+package cmd
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	"github.com/render-oss/cli/pkg/config"
+	"github.com/render-oss/cli/pkg/dependencies"
+)
+
+func newServiceEnvCmd(children ...*cobra.Command) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "env",
+		Aliases: []string{"env-vars"},
+		Short:   "Manage environment variables for a service",
+		Long: `Manage environment variables for a service.
+
+Each subcommand accepts a service ID (including srv- or crn- IDs) or a name as
+its first argument. Name lookup is scoped to your active workspace.
+
+Changing environment variables does not trigger a deploy. Run
+'render deploys create <service>' to pick up the new values.
+
+Text output masks values. Pass --show-values to reveal them, or use
+--output json or yaml, which always include values.`,
+	}
+	cmd.AddCommand(children...)
+	return cmd
+}
+
+// resolveEnvVarServiceID resolves a service ID or name against the active
+// workspace for the `services env` subcommands.
+func resolveEnvVarServiceID(ctx context.Context, deps *dependencies.Dependencies, idOrName string) (string, error) {
+	if _, err := config.WorkspaceID(); err != nil {
+		return "", err
+	}
+	return deps.ServiceRepo().ResolveServiceIDFromNameOrID(ctx, idOrName)
+}
@@ -0,0 +1,239 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	renderapi "github.com/render-oss/cli/internal/fakes/renderapi"
+	"github.com/render-oss/cli/pkg/client"
+	"github.com/render-oss/cli/pkg/dependencies"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func executeServiceEnv(t *testing.T, server *renderapi.Server, args ...string) (CommandResult, error) {
+	t.Helper()
+	return executeServiceSubcommand(t, server, func(deps *dependencies.Dependencies) *cobra.Command {
+		return newServiceEnvCmd(
+			newServiceEnvListCmd(deps),
+			newServiceEnvGetCmd(deps),
+			newServiceEnvSetCmd(deps),
+			newServiceEnvUnsetCmd(deps),
+			newServiceEnvImportCmd(deps),
+			newServiceEnvExportCmd(deps),
+		)
+	}, append([]string{"env"}, args...)...)
+}
+
+func seedServiceWithEnvVars(server *renderapi.Server, name string, envVars ...client.EnvVar) *client.Service {
+	svc := seedService(server, name)
+	server.Services.SetEnvVars(svc.Id, envVars...)
+	return svc
+}
+
+func TestServiceEnvList_MasksValuesByDefault(t *testing.T) {
+	server := renderapi.NewServer(t)
+	seedServiceWithEnvVars(server, "my-api",
+		client.EnvVar{Key: "DATABASE_URL", Value: "postgres://secret"},
+		client.EnvVar{Key: "API_KEY", Value: "hunter2"},
+	)
+
+	result, err := executeServiceEnv(t, server, "list", "my-api", "--output", "text")
+	require.NoError(t, err)
+
+	assert.Contains(t, result.Stdout, "API_KEY")
+	assert.Contains(t, result.Stdout, "DATABASE_URL")
+	assert.NotContains(t, result.Stdout, "hunter2")
+	assert.NotContains(t, result.Stdout, "postgres://secret")
+	assert.Less(t, bytes.Index([]byte(result.Stdout), []byte("API_KEY")), bytes.Index([]byte(result.Stdout), []byte("DATABASE_URL")))
+}
+
+func TestServiceEnvList_ShowValues(t *testing.T) {
+	server := renderapi.NewServer(t)
+	seedServiceWithEnvVars(server, "my-api", client.EnvVar{Key: "API_KEY", Value: "hunter2"})
+
+	result, err := executeServiceEnv(t, server, "list", "my-api", "--show-values", "--output", "text")
+	require.NoError(t, err)
+
+	assert.Contains(t, result.Stdout, "hunter2")
+}
+
+func TestServiceEnvList_JSONIncludesValues(t *testing.T) {
+	server := renderapi.NewServer(t)
+	seedServiceWithEnvVars(server, "my-api", client.EnvVar{Key: "API_KEY", Value: "hunter2"})
+
+	result, err := executeServiceEnv(t, server, "list", "my-api", "--output", "json")
+	require.NoError(t, err)
+
+	var out struct {
+		Data []client.EnvVar `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(result.Stdout), &out))
+	assert.Equal(t, []client.EnvVar{{Key: "API_KEY", Value: "hunter2"}}, out.Data)
+}
+
+func TestServiceEnvGet_MissingKey_Errors(t *testing.T) {
+	server := renderapi.NewServer(t)
+	seedServiceWithEnvVars(server, "my-api")
+
+	_, err := executeServiceEnv(t, server, "get", "my-api", "MISSING", "--output", "text")
+	require.Error(t, err)
+
+	assert.Contains(t, err.Error(), "No environment variable 'MISSING'")
+}
+
+func TestServiceEnvSet_AddsAndUpdates(t *testing.T) {
+	server := renderapi.NewServer(t)
+	svc := seedServiceWithEnvVars(server, "my-api",
+		client.EnvVar{Key: "KEEP", Value: "1"},
+		client.EnvVar{Key: "LOG_LEVEL", Value: "info"},
+	)
+
+	result, err := executeServiceEnv(t, server, "set", "my-api", "LOG_LEVEL=debug", "NEW=a=b", "--output", "text")
+	require.NoError(t, err)
+
+	assert.ElementsMatch(t, []client.EnvVar{
+		{Key: "KEEP", Value: "1"},
+		{Key: "LOG_LEVEL", Value: "debug"},
+		{Key: "NEW", Value: "a=b"},
+	}, server.Services.EnvVars[svc.Id])
+	assert.Contains(t, result.Stdout, "+ NEW")
+	assert.Contains(t, result.Stdout, "~ LOG_LEVEL")
+	assert.NotContains(t, result.Stdout, "KEEP")
+}
+
+func TestServiceEnvSet_InvalidAssignment_MakesNoRequests(t *testing.T) {
+	server := renderapi.NewServer(t)
+	seedServiceWithEnvVars(server, "my-api")
+
+	_, err := executeServiceEnv(t, server, "set", "my-api", "NOVALUE", "--output", "text")
+	require.Error(t, err)
+
+	assert.Contains(t, err.Error(), "expected KEY=VALUE")
+	assert.False(t, server.HasRequest("PUT", "/env-vars"))
+}
+
+func TestServiceEnvUnset_PreviewDoesNotDelete(t *testing.T) {
+	server := renderapi.NewServer(t)
+	svc := seedServiceWithEnvVars(server, "my-api", client.EnvVar{Key: "OLD", Value: "x"})
+
+	result, err := executeServiceEnv(t, server, "unset", "my-api", "OLD", "--output", "text")
+	require.NoError(t, err)
+
+	assert.Len(t, server.Services.EnvVars[svc.Id], 1)
+	assert.False(t, server.HasDeleteRequest())
+	assert.Contains(t, result.Stdout, "- OLD")
+	assert.Contains(t, result.Stdout, "--confirm")
+}
+
+func TestServiceEnvUnset_Confirm_Deletes(t *testing.T) {
+	server := renderapi.NewServer(t)
+	svc := seedServiceWithEnvVars(server, "my-api",
+		client.EnvVar{Key: "OLD", Value: "x"},
+		client.EnvVar{Key: "KEEP", Value: "y"},
+	)
+
+	_, err := executeServiceEnv(t, server, "unset", "my-api", "OLD", "--confirm", "--output", "text")
+	require.NoError(t, err)
+
+	assert.Equal(t, []client.EnvVar{{Key: "KEEP", Value: "y"}}, server.Services.EnvVars[svc.Id])
+	assert.True(t, server.HasRequest("DELETE", "/services/"+svc.Id+"/env-vars/OLD"))
+}
+
+func TestServiceEnvUnset_UnknownKey_ErrorsBeforeDeleting(t *testing.T) {
+	server := renderapi.NewServer(t)
+	svc := seedServiceWithEnvVars(server, "my-api", client.EnvVar{Key: "OLD", Value: "x"})
+
+	_, err := executeServiceEnv(t, server, "unset", "my-api", "OLD", "MISSING", "--confirm", "--output", "text")
+	require.Error(t, err)
+
+	assert.Contains(t, err.Error(), "MISSING")
+	assert.Len(t, server.Services.EnvVars[svc.Id], 1)
+	assert.False(t, server.HasDeleteRequest())
+}
+
+func writeEnvFile(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), ".env")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func TestServiceEnvImport_MergesByDefault(t *testing.T) {
+	server := renderapi.NewServer(t)
+	svc := seedServiceWithEnvVars(server, "my-api",
+		client.EnvVar{Key: "KEEP", Value: "1"},
+		client.EnvVar{Key: "SAME", Value: "s"},
+	)
+	path := writeEnvFile(t, "SAME=s\nNEW=n\n")
+
+	result, err := executeServiceEnv(t, server, "import", "my-api", "--file", path, "--output", "text")
+	require.NoError(t, err)
+
+	assert.ElementsMatch(t, []client.EnvVar{
+		{Key: "KEEP", Value: "1"},
+		{Key: "SAME", Value: "s"},
+		{Key: "NEW", Value: "n"},
+	}, server.Services.EnvVars[svc.Id])
+	assert.False(t, server.HasRequest("PUT", "/env-vars/SAME"), "unchanged keys should not be written")
+	assert.Contains(t, result.Stdout, "+ NEW")
+}
+
+func TestServiceEnvImport_ReplaceRequiresConfirm(t *testing.T) {
+	server := renderapi.NewServer(t)
+	svc := seedServiceWithEnvVars(server, "my-api", client.EnvVar{Key: "OLD", Value: "x"})
+	path := writeEnvFile(t, "NEW=n\n")
+
+	result, err := executeServiceEnv(t, server, "import", "my-api", "--file", path, "--replace", "--output", "text")
+	require.NoError(t, err)
+
+	assert.Equal(t, []client.EnvVar{{Key: "OLD", Value: "x"}}, server.Services.EnvVars[svc.Id])
+	assert.Contains(t, result.Stdout, "+ NEW")
+	assert.Contains(t, result.Stdout, "- OLD")
+	assert.Contains(t, result.Stdout, "--confirm")
+
+	_, err = executeServiceEnv(t, server, "import", "my-api", "--file", path, "--replace", "--confirm", "--output", "text")
+	require.NoError(t, err)
+
+	assert.Equal(t, []client.EnvVar{{Key: "NEW", Value: "n"}}, server.Services.EnvVars[svc.Id])
+}
+
+func TestServiceEnvExport_WritesFileThatImportsBack(t *testing.T) {
+	server := renderapi.NewServer(t)
+	seedServiceWithEnvVars(server, "my-api",
+		client.EnvVar{Key: "MULTILINE", Value: "line1\nline2"},
+		client.EnvVar{Key: "PLAIN", Value: "value"},
+	)
+	target := seedServiceWithEnvVars(server, "my-worker")
+	path := filepath.Join(t.TempDir(), "api.env")
+
+	result, err := executeServiceEnv(t, server, "export", "my-api", "--file", path, "--output", "text")
+	require.NoError(t, err)
+	assert.Contains(t, result.Stdout, "Exported 2 environment variables to "+path)
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	_, err = executeServiceEnv(t, server, "import", "my-worker", "--file", path, "--output", "text")
+	require.NoError(t, err)
+
+	assert.ElementsMatch(t, []client.EnvVar{
+		{Key: "MULTILINE", Value: "line1\nline2"},
+		{Key: "PLAIN", Value: "value"},
+	}, server.Services.EnvVars[target.Id])
+}
+
+func TestServiceEnvExport_Stdout(t *testing.T) {
+	server := renderapi.NewServer(t)
+	seedServiceWithEnvVars(server, "my-api", client.EnvVar{Key: "PLAIN", Value: "value"})
+
+	result, err := executeServiceEnv(t, server, "export", "my-api", "--output", "text")
+	require.NoError(t, err)
+
+	assert.Contains(t, result.Stdout, `PLAIN="value"`)
+}
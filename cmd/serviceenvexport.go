@@ -0,0 +1,107 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/render-oss/cli/pkg/client"
+	"github.com/render-oss/cli/pkg/command"
+	"github.com/render-oss/cli/pkg/dependencies"
+	servicepkg "github.com/render-oss/cli/pkg/service"
+	servicetypes "github.com/render-oss/cli/pkg/types/service"
+	"github.com/render-oss/cli/pkg/utils"
+)
+
+func newServiceEnvExportCmd(deps *dependencies.Dependencies) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "export <serviceID|serviceName>",
+		Short:        "Export environment variables to a .env file",
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+		Long: `Export a service's environment variables in .env format.
+
+Without --file, the .env content is written to stdout. With --file, it is
+written to that path (overwriting any existing file) with permissions
+restricted to the current user.
+
+Unlike other env subcommands, exported values are never masked.
+
+This command only runs non-interactively. If --output interactive is requested,
+it falls back to text output.`,
+		Example: `  # Print .env content
+  render services env export my-api
+
+  # Write to a file
+  render services env export my-api --file .env.production
+
+  # Copy variables from one service to another
+  render services env export my-api --file api.env
+  render services env import my-worker --file api.env`,
+	}
+
+	cmd.Flags().String("file", "", "Write to this path instead of stdout")
+	setAllFlagPlaceholders(cmd, map[string]string{
+		"file": "PATH",
+	})
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		command.DefaultFormatNonInteractive(cmd)
+
+		var input servicetypes.EnvVarExportInput
+		if err := command.ParseCommand(cmd, args, &input); err != nil {
+			return err
+		}
+
+		loadData := func() (*servicepkg.EnvVarExportOut, error) {
+			serviceID, err := resolveEnvVarServiceID(cmd.Context(), deps, input.ServiceIDOrName)
+			if err != nil {
+				return nil, err
+			}
+			envVars, err := deps.ServiceRepo().ListEnvVars(cmd.Context(), serviceID)
+			if err != nil {
+				return nil, err
+			}
+			if envVars == nil {
+				envVars = []client.EnvVar{}
+			}
+			servicepkg.SortEnvVars(envVars)
+
+			content, err := formatEnvVarsAsEnvFile(envVars)
+			if err != nil {
+				return nil, err
+			}
+			if input.File != "" {
+				if err := os.WriteFile(input.File, []byte(content), 0o600); err != nil {
+					return nil, fmt.Errorf("failed to write %s: %w", input.File, err)
+				}
+			}
+			return &servicepkg.EnvVarExportOut{
+				Data:    envVars,
+				Meta:    servicepkg.EnvVarExportOutMeta{Path: input.File},
+				Content: content,
+			}, nil
+		}
+
+		_, err := command.NonInteractive(cmd, loadData, serviceEnvExportTextOutput)
+		return err
+	}
+
+	return cmd
+}
+
+func serviceEnvExportTextOutput(out *servicepkg.EnvVarExportOut) string {
+	if out.Meta.Path != "" {
+		return fmt.Sprintf("Exported %d environment variables to %s\n", len(out.Data), out.Meta.Path)
+	}
+	return out.Content
+}
+
+func formatEnvVarsAsEnvFile(envVars []client.EnvVar) (string, error) {
+	vars := make(map[string]string, len(envVars))
+	for _, ev := range envVars {
+		vars[ev.Key] = ev.Value
+	}
+	return utils.FormatEnvFile(vars)
+}
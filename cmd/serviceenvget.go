@@ -0,0 +1,67 @@
+package cmd
+
+import (
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/render-oss/cli/pkg/command"
+	"github.com/render-oss/cli/pkg/dependencies"
+	servicepkg "github.com/render-oss/cli/pkg/service"
+	"github.com/render-oss/cli/pkg/text"
+	servicetypes "github.com/render-oss/cli/pkg/types/service"
+)
+
+func newServiceEnvGetCmd(deps *dependencies.Dependencies) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "get <serviceID|serviceName> <key>",
+		Short:        "Get one environment variable for a service",
+		Args:         cobra.ExactArgs(2),
+		SilenceUsage: true,
+		Long: `Get a single environment variable set on a service.
+
+Text output masks the value unless --show-values is passed. JSON and YAML
+output always include the value.
+
+This command only runs non-interactively. If --output interactive is requested,
+it falls back to text output.`,
+		Example: `  # Check whether a variable is set
+  render services env get my-api DATABASE_URL
+
+  # Print the value
+  render services env get my-api DATABASE_URL --show-values
+
+  # JSON output
+  render services env get my-api DATABASE_URL --output json`,
+	}
+
+	cmd.Flags().Bool("show-values", false, "Show the value in text output instead of masking it")
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		command.DefaultFormatNonInteractive(cmd)
+
+		var input servicetypes.EnvVarGetInput
+		if err := command.ParseCommand(cmd, args, &input); err != nil {
+			return err
+		}
+
+		loadData := func() (*servicepkg.EnvVarGetOut, error) {
+			serviceID, err := resolveEnvVarServiceID(cmd.Context(), deps, input.ServiceIDOrName)
+			if err != nil {
+				return nil, err
+			}
+			envVar, err := deps.ServiceRepo().GetEnvVar(cmd.Context(), serviceID, strings.TrimSpace(input.Key))
+			if err != nil {
+				return nil, err
+			}
+			return &servicepkg.EnvVarGetOut{Data: *envVar, ShowValues: input.ShowValues}, nil
+		}
+
+		_, err := command.NonInteractive(cmd, loadData, func(out *servicepkg.EnvVarGetOut) string {
+			return text.EnvVarDetail(out.Data, out.ShowValues)
+		})
+		return err
+	}
+
+	return cmd
+}
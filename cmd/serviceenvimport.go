@@ -0,0 +1,113 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/render-oss/cli/pkg/command"
+	"github.com/render-oss/cli/pkg/dependencies"
+	servicepkg "github.com/render-oss/cli/pkg/service"
+	"github.com/render-oss/cli/pkg/text"
+	servicetypes "github.com/render-oss/cli/pkg/types/service"
+	"github.com/render-oss/cli/pkg/utils"
+)
+
+func newServiceEnvImportCmd(deps *dependencies.Dependencies) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "import <serviceID|serviceName> --file <path>",
+		Short:        "Import environment variables from a .env file",
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+		Long: `Import environment variables from a .env file into a service.
+
+By default, variables in the file are added to the service or overwrite
+existing values with the same key. Variables that are not in the file are left
+unchanged.
+
+With --replace, the file becomes the service's complete set of environment
+variables: any variable not in the file is removed. Without --confirm, a
+--replace import previews the changes and makes no changes.
+
+This command only runs non-interactively. If --output interactive is requested,
+it falls back to text output.`,
+		Example: `  # Merge a .env file into a service
+  render services env import my-api --file .env.production
+
+  # Preview replacing all variables with the file's contents
+  render services env import my-api --file .env.production --replace
+
+  # Replace all variables
+  render services env import my-api --file .env.production --replace --confirm`,
+	}
+
+	cmd.Flags().String("file", "", "Path to a .env file to import")
+	cmd.Flags().Bool("replace", false, "Remove variables that are not in the file")
+	setAllFlagPlaceholders(cmd, map[string]string{
+		"file": "PATH",
+	})
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		command.DefaultFormatNonInteractive(cmd)
+
+		var input servicetypes.EnvVarImportInput
+		if err := command.ParseCommand(cmd, args, &input); err != nil {
+			return err
+		}
+		confirm := command.GetConfirmFromContext(cmd.Context())
+
+		fileVars, _, err := utils.LoadEnvFiles([]string{input.File}, true)
+		if err != nil {
+			return err
+		}
+		if len(fileVars) == 0 {
+			return fmt.Errorf("no environment variables found in %s", input.File)
+		}
+		imported := servicepkg.EnvVarsFromMap(fileVars)
+
+		loadData := func() (*servicepkg.EnvVarChangeOut, error) {
+			repo := deps.ServiceRepo()
+			serviceID, err := resolveEnvVarServiceID(cmd.Context(), deps, input.ServiceIDOrName)
+			if err != nil {
+				return nil, err
+			}
+			before, err := repo.ListEnvVars(cmd.Context(), serviceID)
+			if err != nil {
+				return nil, err
+			}
+
+			if input.Replace {
+				if !confirm {
+					servicepkg.SortEnvVars(before)
+					return &servicepkg.EnvVarChangeOut{
+						Data: before,
+						Meta: servicepkg.EnvVarChangeMeta{
+							ServiceID: serviceID,
+							Changes:   servicepkg.DiffEnvVars(before, imported),
+							Message:   "re-run with --confirm to replace",
+						},
+					}, nil
+				}
+				if _, err := repo.ReplaceEnvVars(cmd.Context(), serviceID, imported); err != nil {
+					return nil, err
+				}
+				return envVarChangeOutAfterApply(cmd, deps, serviceID, before)
+			}
+
+			// Only write keys whose value actually changes, so a merge never
+			// touches variables the file doesn't mention.
+			changes := servicepkg.DiffEnvVars(before, servicepkg.MergeEnvVars(before, imported))
+			for _, key := range append(changes.Added, changes.Updated...) {
+				if _, err := repo.SetEnvVar(cmd.Context(), serviceID, key, fileVars[key]); err != nil {
+					return nil, err
+				}
+			}
+			return envVarChangeOutAfterApply(cmd, deps, serviceID, before)
+		}
+
+		_, err = command.NonInteractive(cmd, loadData, text.EnvVarChanges)
+		return err
+	}
+
+	return cmd
+}
@@ -0,0 +1,71 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/render-oss/cli/pkg/client"
+	"github.com/render-oss/cli/pkg/command"
+	"github.com/render-oss/cli/pkg/dependencies"
+	servicepkg "github.com/render-oss/cli/pkg/service"
+	"github.com/render-oss/cli/pkg/text"
+	servicetypes "github.com/render-oss/cli/pkg/types/service"
+)
+
+func newServiceEnvListCmd(deps *dependencies.Dependencies) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "list <serviceID|serviceName>",
+		Short:        "List environment variables for a service",
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+		Long: `List the environment variables set directly on a service. Variables
+inherited from linked environment groups are not included.
+
+Text output masks values unless --show-values is passed. JSON and YAML output
+always include values.
+
+This command only runs non-interactively. If --output interactive is requested,
+it falls back to text output.`,
+		Example: `  # List keys with masked values
+  render services env list my-api
+
+  # Reveal values
+  render services env list my-api --show-values
+
+  # JSON output
+  render services env list srv-abc123def456ghi789jkl0 --output json`,
+	}
+
+	cmd.Flags().Bool("show-values", false, "Show values in text output instead of masking them")
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		command.DefaultFormatNonInteractive(cmd)
+
+		var input servicetypes.EnvVarListInput
+		if err := command.ParseCommand(cmd, args, &input); err != nil {
+			return err
+		}
+
+		loadData := func() (*servicepkg.EnvVarListOut, error) {
+			serviceID, err := resolveEnvVarServiceID(cmd.Context(), deps, input.ServiceIDOrName)
+			if err != nil {
+				return nil, err
+			}
+			envVars, err := deps.ServiceRepo().ListEnvVars(cmd.Context(), serviceID)
+			if err != nil {
+				return nil, err
+			}
+			if envVars == nil {
+				envVars = []client.EnvVar{}
+			}
+			servicepkg.SortEnvVars(envVars)
+			return &servicepkg.EnvVarListOut{Data: envVars, ShowValues: input.ShowValues}, nil
+		}
+
+		_, err := command.NonInteractive(cmd, loadData, func(out *servicepkg.EnvVarListOut) string {
+			return text.EnvVarTable(out.Data, out.ShowValues)
+		})
+		return err
+	}
+
+	return cmd
+}
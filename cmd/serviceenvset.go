@@ -0,0 +1,97 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/render-oss/cli/pkg/client"
+	"github.com/render-oss/cli/pkg/command"
+	"github.com/render-oss/cli/pkg/dependencies"
+	servicepkg "github.com/render-oss/cli/pkg/service"
+	"github.com/render-oss/cli/pkg/text"
+	servicetypes "github.com/render-oss/cli/pkg/types/service"
+)
+
+func newServiceEnvSetCmd(deps *dependencies.Dependencies) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "set <serviceID|serviceName> <KEY=VALUE>...",
+		Short:        "Set environment variables for a service",
+		Args:         cobra.MinimumNArgs(2),
+		SilenceUsage: true,
+		Long: `Add or update one or more environment variables on a service. Variables
+not named on the command line are left unchanged.
+
+Values are taken verbatim after the first '='. Quote values that contain
+spaces or shell metacharacters. If a key is repeated, the last value wins.
+
+The output lists which keys were added or updated but never prints values.
+
+This command only runs non-interactively. If --output interactive is requested,
+it falls back to text output.`,
+		Example: `  # Set a single variable
+  render services env set my-api LOG_LEVEL=debug
+
+  # Set several variables at once
+  render services env set my-api LOG_LEVEL=info FEATURE_FLAG=on
+
+  # JSON output
+  render services env set srv-abc123def456ghi789jkl0 LOG_LEVEL=debug --output json`,
+	}
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		command.DefaultFormatNonInteractive(cmd)
+
+		var input servicetypes.EnvVarSetInput
+		if err := command.ParseCommand(cmd, args, &input); err != nil {
+			return err
+		}
+		envVars, err := servicetypes.ParseEnvVarAssignments(args[1:])
+		if err != nil {
+			return err
+		}
+		input.EnvVars = envVars
+
+		loadData := func() (*servicepkg.EnvVarChangeOut, error) {
+			repo := deps.ServiceRepo()
+			serviceID, err := resolveEnvVarServiceID(cmd.Context(), deps, input.ServiceIDOrName)
+			if err != nil {
+				return nil, err
+			}
+			before, err := repo.ListEnvVars(cmd.Context(), serviceID)
+			if err != nil {
+				return nil, err
+			}
+			for _, ev := range input.EnvVars {
+				if _, err := repo.SetEnvVar(cmd.Context(), serviceID, ev.Key, ev.Value); err != nil {
+					return nil, err
+				}
+			}
+			return envVarChangeOutAfterApply(cmd, deps, serviceID, before)
+		}
+
+		_, err = command.NonInteractive(cmd, loadData, text.EnvVarChanges)
+		return err
+	}
+
+	return cmd
+}
+
+// envVarChangeOutAfterApply re-reads the service's environment variables
+// after a change and diffs them against before.
+func envVarChangeOutAfterApply(cmd *cobra.Command, deps *dependencies.Dependencies, serviceID string, before []client.EnvVar) (*servicepkg.EnvVarChangeOut, error) {
+	after, err := deps.ServiceRepo().ListEnvVars(cmd.Context(), serviceID)
+	if err != nil {
+		return nil, err
+	}
+	if after == nil {
+		after = []client.EnvVar{}
+	}
+	servicepkg.SortEnvVars(after)
+	return &servicepkg.EnvVarChangeOut{
+		Data: after,
+		Meta: servicepkg.EnvVarChangeMeta{
+			ServiceID: serviceID,
+			Applied:   true,
+			Changes:   servicepkg.DiffEnvVars(before, after),
+		},
+	}, nil
+}
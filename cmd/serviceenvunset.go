@@ -0,0 +1,119 @@
+package cmd
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/render-oss/cli/pkg/client"
+	"github.com/render-oss/cli/pkg/command"
+	"github.com/render-oss/cli/pkg/dependencies"
+	servicepkg "github.com/render-oss/cli/pkg/service"
+	"github.com/render-oss/cli/pkg/text"
+	"github.com/render-oss/cli/pkg/tui"
+	servicetypes "github.com/render-oss/cli/pkg/types/service"
+)
+
+func newServiceEnvUnsetCmd(deps *dependencies.Dependencies) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "unset <serviceID|serviceName> <KEY>...",
+		Short:        "Remove environment variables from a service",
+		Args:         cobra.MinimumNArgs(2),
+		SilenceUsage: true,
+		Long: `Remove one or more environment variables from a service.
+
+Without --confirm, this command previews which keys would be removed and makes
+no changes. Pass --confirm to actually remove them.
+
+Every key must currently be set on the service; otherwise the command fails
+without removing anything.
+
+This command only runs non-interactively. If --output interactive is requested,
+it falls back to text output.`,
+		Example: `  # Preview removal (no changes made)
+  render services env unset my-api LEGACY_TOKEN
+
+  # Remove several variables
+  render services env unset my-api LEGACY_TOKEN OLD_FLAG --confirm
+
+  # JSON output
+  render services env unset srv-abc123def456ghi789jkl0 LEGACY_TOKEN --confirm --output json`,
+	}
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		command.DefaultFormatNonInteractive(cmd)
+
+		var input servicetypes.EnvVarUnsetInput
+		if err := command.ParseCommand(cmd, args, &input); err != nil {
+			return err
+		}
+		keys, err := servicetypes.ParseEnvVarKeys(args[1:])
+		if err != nil {
+			return err
+		}
+		input.Keys = keys
+		confirm := command.GetConfirmFromContext(cmd.Context())
+
+		loadData := func() (*servicepkg.EnvVarChangeOut, error) {
+			repo := deps.ServiceRepo()
+			serviceID, err := resolveEnvVarServiceID(cmd.Context(), deps, input.ServiceIDOrName)
+			if err != nil {
+				return nil, err
+			}
+			before, err := repo.ListEnvVars(cmd.Context(), serviceID)
+			if err != nil {
+				return nil, err
+			}
+			if err := requireEnvVarKeysSet("service "+serviceID, before, input.Keys); err != nil {
+				return nil, err
+			}
+
+			if !confirm {
+				remaining := slices.DeleteFunc(slices.Clone(before), func(ev client.EnvVar) bool {
+					return slices.Contains(input.Keys, ev.Key)
+				})
+				servicepkg.SortEnvVars(before)
+				return &servicepkg.EnvVarChangeOut{
+					Data: before,
+					Meta: servicepkg.EnvVarChangeMeta{
+						ServiceID: serviceID,
+						Changes:   servicepkg.DiffEnvVars(before, remaining),
+						Message:   "re-run with --confirm to unset",
+					},
+				}, nil
+			}
+
+			for _, key := range input.Keys {
+				if err := repo.DeleteEnvVar(cmd.Context(), serviceID, key); err != nil {
+					return nil, err
+				}
+			}
+			return envVarChangeOutAfterApply(cmd, deps, serviceID, before)
+		}
+
+		_, err = command.NonInteractive(cmd, loadData, text.EnvVarChanges)
+		return err
+	}
+
+	return cmd
+}
+
+// requireEnvVarKeysSet errors if any of keys is missing from envVars. target
+// names the resource in the message, e.g. "service srv-...".
+func requireEnvVarKeysSet(target string, envVars []client.EnvVar, keys []string) error {
+	var missing []string
+	for _, key := range keys {
+		if !slices.ContainsFunc(envVars, func(ev client.EnvVar) bool { return ev.Key == key }) {
+			missing = append(missing, key)
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+	return tui.UserFacingError{Message: fmt.Sprintf(
+		"Environment variables not set on %s: %s. No changes were made.",
+		target, strings.Join(missing, ", "),
+	)}
+}
@@ -0,0 +1,69 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/render-oss/cli/pkg/command"
+	"github.com/render-oss/cli/pkg/dependencies"
+	servicepkg "github.com/render-oss/cli/pkg/service"
+	"github.com/render-oss/cli/pkg/text"
+	servicetypes "github.com/render-oss/cli/pkg/types/service"
+)
+
+func newServiceResumeCmd(deps *dependencies.Dependencies) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "resume <serviceID|serviceName>",
+		Short:        "Resume a suspended service",
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+		Long: `Resume a suspended service on Render.
+
+The positional argument accepts a service ID or a name. Name lookup is scoped
+to your active workspace. If the name matches more than one service, pass the
+service ID directly.
+
+This command only runs non-interactively. If --output interactive is requested,
+it falls back to text output.`,
+		Example: `  # Resume by ID
+  render services resume srv-abc123def456ghi789jkl0
+
+  # Resume by name
+  render services resume my-api
+
+  # JSON output
+  render services resume srv-abc123def456ghi789jkl0 --output json`,
+	}
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		command.DefaultFormatNonInteractive(cmd)
+
+		var input servicetypes.ServiceResumeInput
+		if err := command.ParseCommand(cmd, args, &input); err != nil {
+			return err
+		}
+
+		loadData := func() (*servicepkg.ResumeOut, error) {
+			model, err := resolveServiceModel(cmd.Context(), deps, input.ServiceIDOrName)
+			if err != nil {
+				return nil, err
+			}
+			if err := deps.ServiceRepo().ResumeService(cmd.Context(), model.Service.Id); err != nil {
+				return nil, err
+			}
+			model, err = deps.ServiceService().GetService(cmd.Context(), model.Service.Id)
+			if err != nil {
+				return nil, err
+			}
+			return &servicepkg.ResumeOut{Data: servicepkg.NewServiceOutFromModel(model)}, nil
+		}
+
+		_, err := command.NonInteractive(cmd, loadData, serviceResumeTextOutput)
+		return err
+	}
+
+	return cmd
+}
+
+func serviceResumeTextOutput(out *servicepkg.ResumeOut) string {
+	return "Resumed this service:\n\n" + text.ServiceDetail(&out.Data) + "\n"
+}
@@ -0,0 +1,120 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/render-oss/cli/pkg/command"
+	"github.com/render-oss/cli/pkg/dependencies"
+	servicepkg "github.com/render-oss/cli/pkg/service"
+	"github.com/render-oss/cli/pkg/text"
+	"github.com/render-oss/cli/pkg/tui"
+	servicetypes "github.com/render-oss/cli/pkg/types/service"
+)
+
+func newServiceScaleCmd(deps *dependencies.Dependencies) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "scale <serviceID|serviceName> --instances <count>",
+		Short:        "Set the number of instances for a service",
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+		Long: `Manually scale a web service, private service, or background worker to a fixed
+number of instances.
+
+Without --confirm, this command previews the change and makes no changes. Pass
+--confirm to actually scale the service.
+
+Services with autoscaling enabled cannot be scaled manually; disable
+autoscaling first with 'render services autoscaling disable'. Free instances
+cannot be scaled, and no service runs more than 100 instances.
+
+The positional argument accepts a service ID or a name. Name lookup is scoped
+to your active workspace. If the name matches more than one service, pass the
+service ID directly.
+
+This command only runs non-interactively. If --output interactive is requested,
+it falls back to text output.`,
+		Example: `  # Preview scaling to three instances (no changes made)
+  render services scale my-api --instances 3
+
+  # Scale to three instances
+  render services scale srv-abc123def456ghi789jkl0 --instances 3 --confirm
+
+  # JSON output
+  render services scale my-api --instances 1 --confirm --output json`,
+	}
+
+	cmd.Flags().Int("instances", 0, "Number of instances to run (at least 1)")
+	setAllFlagPlaceholders(cmd, map[string]string{
+		"instances": "COUNT",
+	})
+	_ = cmd.MarkFlagRequired("instances")
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		command.DefaultFormatNonInteractive(cmd)
+
+		var input servicetypes.ServiceScaleInput
+		if err := command.ParseCommand(cmd, args, &input); err != nil {
+			return err
+		}
+		confirm := command.GetConfirmFromContext(cmd.Context())
+
+		loadData := func() (*servicepkg.ScaleOut, error) {
+			model, err := resolveServiceModel(cmd.Context(), deps, input.ServiceIDOrName)
+			if err != nil {
+				return nil, err
+			}
+			scaling, ok := servicepkg.ScalingForService(model.Service)
+			if !ok {
+				return nil, tui.UserFacingError{Message: fmt.Sprintf("Services of type %s cannot be scaled.", model.Service.Type)}
+			}
+			if scaling.Autoscaled() {
+				return nil, tui.UserFacingError{Message: fmt.Sprintf(
+					"Service %s has autoscaling enabled (%d-%d instances). Disable autoscaling before scaling manually.",
+					model.Service.Name, scaling.Autoscaling.Min, scaling.Autoscaling.Max,
+				)}
+			}
+			if err := servicepkg.CheckInstanceLimits(scaling.Plan, input.Instances); err != nil {
+				return nil, err
+			}
+
+			out := servicepkg.ScaleOut{
+				Data: servicepkg.NewServiceOutFromModel(model),
+				Meta: servicepkg.ScaleOutMeta{
+					Before: scaling.NumInstances,
+					After:  input.Instances,
+					Scaled: confirm,
+				},
+			}
+			if !confirm {
+				out.Meta.Message = "re-run with --confirm to scale"
+				return &out, nil
+			}
+			if err := deps.ServiceRepo().ScaleService(cmd.Context(), model.Service.Id, input.Instances); err != nil {
+				return nil, err
+			}
+			model, err = deps.ServiceService().GetService(cmd.Context(), model.Service.Id)
+			if err != nil {
+				return nil, err
+			}
+			out.Data = servicepkg.NewServiceOutFromModel(model)
+			return &out, nil
+		}
+
+		_, err := command.NonInteractive(cmd, loadData, serviceScaleTextOutput)
+		return err
+	}
+
+	return cmd
+}
+
+func serviceScaleTextOutput(r *servicepkg.ScaleOut) string {
+	change := fmt.Sprintf("Instances: %d → %d", r.Meta.Before, r.Meta.After)
+	if r.Meta.Scaled {
+		return "Scaled this service:\n\n" + text.ServiceDetail(&r.Data) + "\n" + change + "\n"
+	}
+	return "This command would scale this service:\n\n" +
+		text.ServiceDetail(&r.Data) + "\n" + change +
+		"\n\nRe-run with --confirm to proceed\n"
+}
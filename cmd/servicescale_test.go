@@ -0,0 +1,64 @@
+package cmd
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	renderapi "github.com/render-oss/cli/internal/fakes/renderapi"
+	servicepkg "github.com/render-oss/cli/pkg/service"
+)
+
+func TestServiceScale_PreviewWithoutConfirm(t *testing.T) {
+	server := renderapi.NewServer(t)
+	svc := seedService(server, "my-api")
+
+	result, err := executeServiceSubcommand(t, server, newServiceScaleCmd, "scale", "my-api", "--instances", "3")
+	require.NoError(t, err, "stderr: %s", result.Stderr)
+
+	assert.Contains(t, result.Stdout, "This command would scale this service")
+	assert.Contains(t, result.Stdout, "Instances: 1 → 3")
+	scaling, ok := servicepkg.ScalingForService(svc)
+	require.True(t, ok)
+	assert.Equal(t, 1, scaling.NumInstances)
+}
+
+func TestServiceScale_ScalesWithConfirm(t *testing.T) {
+	server := renderapi.NewServer(t)
+	svc := seedService(server, "my-api")
+
+	result, err := executeServiceSubcommand(t, server, newServiceScaleCmd, "scale", svc.Id, "--instances", "3", "--confirm", "--output", "json")
+	require.NoError(t, err, "stderr: %s", result.Stderr)
+
+	var out servicepkg.ScaleOut
+	require.NoError(t, json.Unmarshal([]byte(result.Stdout), &out), result.Stdout)
+	assert.Equal(t, servicepkg.ScaleOutMeta{Before: 1, After: 3, Scaled: true}, out.Meta)
+
+	scaling, ok := servicepkg.ScalingForService(svc)
+	require.True(t, ok)
+	assert.Equal(t, 3, scaling.NumInstances)
+}
+
+func TestServiceScale_RejectsStaticSite(t *testing.T) {
+	server := renderapi.NewServer(t)
+	server.Services.Add(renderapi.NewStaticSite(renderapi.StaticSiteAttrs{
+		Service: renderapi.CommonServiceAttrs{Name: "docs", OwnerID: serviceTestWorkspaceID},
+	}))
+
+	_, err := executeServiceSubcommand(t, server, newServiceScaleCmd, "scale", "docs", "--instances", "2", "--confirm")
+	require.ErrorContains(t, err, "cannot be scaled")
+}
+
+func TestServiceScale_RequiresPositiveInstances(t *testing.T) {
+	server := renderapi.NewServer(t)
+	seedService(server, "my-api")
+
+	_, err := executeServiceSubcommand(t, server, newServiceScaleCmd, "scale", "my-api", "--instances", "0")
+	require.ErrorContains(t, err, "--instances must be at least 1")
+
+	for _, r := range server.Requests {
+		assert.NotContains(t, r.URI, "/scale")
+	}
+}
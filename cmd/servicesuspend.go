@@ -0,0 +1,105 @@
+package cmd
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	"github.com/render-oss/cli/pkg/command"
+	"github.com/render-oss/cli/pkg/dependencies"
+	servicepkg "github.com/render-oss/cli/pkg/service"
+	"github.com/render-oss/cli/pkg/text"
+	servicetypes "github.com/render-oss/cli/pkg/types/service"
+)
+
+func newServiceSuspendCmd(deps *dependencies.Dependencies) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "suspend <serviceID|serviceName>",
+		Short:        "Suspend a service",
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+		Long: `Suspend a service on Render. A suspended service stops serving traffic and
+running instances until it is resumed.
+
+Without --confirm, this command previews what would be suspended and makes no
+changes. Pass --confirm to actually suspend the service.
+
+The positional argument accepts a service ID or a name. Name lookup is scoped
+to your active workspace. If the name matches more than one service, pass the
+service ID directly.
+
+This command only runs non-interactively. If --output interactive is requested,
+it falls back to text output.`,
+		Example: `  # Preview suspension (no changes made)
+  render services suspend srv-abc123def456ghi789jkl0
+
+  # Suspend by ID
+  render services suspend srv-abc123def456ghi789jkl0 --confirm
+
+  # Suspend by name
+  render services suspend my-api --confirm
+
+  # JSON output
+  render services suspend srv-abc123def456ghi789jkl0 --confirm --output json`,
+	}
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		command.DefaultFormatNonInteractive(cmd)
+
+		var input servicetypes.ServiceSuspendInput
+		if err := command.ParseCommand(cmd, args, &input); err != nil {
+			return err
+		}
+		confirm := command.GetConfirmFromContext(cmd.Context())
+
+		loadData := func() (*servicepkg.SuspendOut, error) {
+			model, err := resolveServiceModel(cmd.Context(), deps, input.ServiceIDOrName)
+			if err != nil {
+				return nil, err
+			}
+			out := servicepkg.SuspendOut{
+				Data: servicepkg.NewServiceOutFromModel(model),
+				Meta: servicepkg.SuspendOutMeta{
+					Suspended: confirm,
+				},
+			}
+			if !confirm {
+				out.Meta.Message = "re-run with --confirm to suspend"
+				return &out, nil
+			}
+			if err := deps.ServiceRepo().SuspendService(cmd.Context(), model.Service.Id); err != nil {
+				return nil, err
+			}
+			model, err = deps.ServiceService().GetService(cmd.Context(), model.Service.Id)
+			if err != nil {
+				return nil, err
+			}
+			out.Data = servicepkg.NewServiceOutFromModel(model)
+			return &out, nil
+		}
+
+		_, err := command.NonInteractive(cmd, loadData, serviceSuspendTextOutput)
+		return err
+	}
+
+	return cmd
+}
+
+// resolveServiceModel resolves a service ID or name against the active
+// workspace and loads the service with its project and environment.
+func resolveServiceModel(ctx context.Context, deps *dependencies.Dependencies, idOrName string) (*servicepkg.Model, error) {
+	serviceID, err := resolveEnvVarServiceID(ctx, deps, idOrName)
+	if err != nil {
+		return nil, err
+	}
+	return deps.ServiceService().GetService(ctx, serviceID)
+}
+
+func serviceSuspendTextOutput(r *servicepkg.SuspendOut) string {
+	if r.Meta.Suspended {
+		return "Suspended this service:\n\n" + text.ServiceDetail(&r.Data) + "\n"
+	}
+	return "This command would suspend this service:\n\n" +
+		text.ServiceDetail(&r.Data) +
+		"\n\nRe-run with --confirm to proceed\n"
+}
@@ -0,0 +1,80 @@
+package cmd
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	renderapi "github.com/render-oss/cli/internal/fakes/renderapi"
+	"github.com/render-oss/cli/pkg/client"
+	"github.com/render-oss/cli/pkg/dependencies"
+)
+
+// executeServiceSubcommand runs `render services <args>` with only the
+// subcommand built by newCmd registered.
+func executeServiceSubcommand(t *testing.T, server *renderapi.Server, newCmd func(*dependencies.Dependencies) *cobra.Command, args ...string) (CommandResult, error) {
+	t.Helper()
+	return executeInWorkspace(t, server, "", func(root *cobra.Command, deps *dependencies.Dependencies) {
+		services := cobraServicesCommand()
+		services.AddCommand(newCmd(deps))
+		root.AddCommand(services)
+	}, append([]string{"services"}, args...)...)
+}
+
+func TestServiceSuspend_PreviewWithoutConfirm(t *testing.T) {
+	server := renderapi.NewServer(t)
+	svc := seedService(server, "my-api")
+
+	result, err := executeServiceSubcommand(t, server, newServiceSuspendCmd, "suspend", "my-api")
+	require.NoError(t, err, "stderr: %s", result.Stderr)
+
+	assert.Contains(t, result.Stdout, "This command would suspend this service")
+	assert.Contains(t, result.Stdout, "Re-run with --confirm to proceed")
+	assert.NotEqual(t, client.ServiceSuspendedSuspended, svc.Suspended)
+	for _, r := range server.Requests {
+		assert.NotEqual(t, "/services/"+svc.Id+"/suspend", r.URI)
+	}
+}
+
+func TestServiceSuspend_SuspendsWithConfirm(t *testing.T) {
+	server := renderapi.NewServer(t)
+	svc := seedService(server, "my-api")
+
+	result, err := executeServiceSubcommand(t, server, newServiceSuspendCmd, "suspend", svc.Id, "--confirm", "--output", "json")
+	require.NoError(t, err, "stderr: %s", result.Stderr)
+
+	var out struct {
+		Data struct {
+			ID        string `json:"id"`
+			Suspended string `json:"suspended"`
+		} `json:"data"`
+		Meta struct {
+			Suspended bool `json:"suspended"`
+		} `json:"meta"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(result.Stdout), &out), result.Stdout)
+	assert.Equal(t, svc.Id, out.Data.ID)
+	assert.Equal(t, "suspended", out.Data.Suspended)
+	assert.True(t, out.Meta.Suspended)
+}
+
+func TestServiceResume_ResumesWithoutConfirm(t *testing.T) {
+	server := renderapi.NewServer(t)
+	svc := server.Services.Add(renderapi.NewWebService(renderapi.WebServiceAttrs{
+		Service: renderapi.CommonServiceAttrs{
+			Name:      "my-api",
+			OwnerID:   serviceTestWorkspaceID,
+			Suspended: client.ServiceSuspendedSuspended,
+		},
+	}))
+
+	result, err := executeServiceSubcommand(t, server, newServiceResumeCmd, "resume", "my-api")
+	require.NoError(t, err, "stderr: %s", result.Stderr)
+
+	assert.Contains(t, result.Stdout, "Resumed this service")
+	assert.NotContains(t, result.Stdout, "Status: suspended")
+	assert.Equal(t, client.ServiceSuspendedNotSuspended, svc.Suspended)
+}
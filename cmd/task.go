@@ -30,7 +30,7 @@ var tasksRunsCmd = &cobra.Command{
 	Short: "Start, list, and inspect task runs",
 	Long: `Manage runs of workflow tasks.
 
-A task run represents a single execution of a task with specific input parameters. Use these commands to start new runs, view task run history, inspect details, and cancel in-progress runs.`,
+A task run represents a single execution of a task with specific input parameters. Use these commands to start new runs, view task run history, inspect details, chart a run and its subtasks as a tree, cancel in-progress runs, and re-run previous ones.`,
 	Example: `  # Start a task run
   render workflows tasks runs start --task my-task --input='["arg1"]'
 
@@ -40,8 +40,14 @@ A task run represents a single execution of a task with specific input parameter
   # Show details for a task run
   render workflows tasks runs show trn-1234
 
+  # Show a task run and all of its subtask runs as a tree
+  render workflows tasks runs tree trn-1234
+
   # Cancel a task run
-  render workflows tasks runs cancel trn-1234`,
+  render workflows tasks runs cancel trn-1234
+
+  # Re-run a task run with the same input
+  render workflows tasks runs rerun trn-1234`,
 }
 
 func init() {
@@ -68,7 +74,7 @@ func newLocalDeps(deps flows.WorkflowDeps, localPort int) (*localDeps, error) {
 }
 
 func (d *localDeps) TaskRepo() *tasks.Repo {
-	return tasks.NewRepo(d.client)
+	return tasks.NewLocalRepo(d.client, client.LocalConfig(d.localPort))
 }
 
 func (d *localDeps) LogRepo() *logs.LogRepo {
@@ -1,11 +1,20 @@
 package cmd
 
 import (
+	"bytes"
 	"os"
+	"strings"
 	"testing"
 
-	"github.com/render-oss/cli/internal/testrequire"
+	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/require"
+
+	renderapi "github.com/render-oss/cli/internal/fakes/renderapi"
+	"github.com/render-oss/cli/internal/testrequire"
+	"github.com/render-oss/cli/pkg/client"
+	"github.com/render-oss/cli/pkg/command"
+	"github.com/render-oss/cli/pkg/config"
+	"github.com/render-oss/cli/pkg/dependencies"
 )
 
 // CommandResult holds the captured output from a CLI command execution.
@@ -23,6 +32,43 @@ func newTestConfigPath(t *testing.T) string {
 	return tmpCfg.Name()
 }
 
+// executeInWorkspace runs the root command with args against server, with the
+// test workspace seeded and active and stdin as the user's input to any
+// prompt. register adds the commands under test to root.
+func executeInWorkspace(t *testing.T, server *renderapi.Server, stdin string, register func(root *cobra.Command, deps *dependencies.Dependencies), args ...string) (CommandResult, error) {
+	t.Helper()
+
+	server.Owners.Add(renderapi.NewOwner(client.Owner{Id: serviceTestWorkspaceID, Name: serviceTestWorkspaceName}))
+	t.Setenv("RENDER_CLI_CONFIG_PATH", newTestConfigPath(t))
+	t.Setenv("RENDER_HOST", server.URL())
+	t.Setenv("RENDER_API_KEY", "test-api-key")
+	t.Setenv("RENDER_WORKSPACE", "")
+	require.NoError(t, (&config.Config{
+		Workspace:     serviceTestWorkspaceID,
+		WorkspaceName: serviceTestWorkspaceName,
+	}).Persist())
+
+	c, err := client.NewClientWithResponses(server.URL())
+	require.NoError(t, err)
+	deps := dependencies.New(c)
+	deps.DetectRuntimeSignals = func() (command.RuntimeSignals, error) {
+		return command.RuntimeSignals{}, nil
+	}
+
+	root := newRootCmd()
+	register(root, deps)
+	setupRootCmdPersistentRun(root, deps)
+
+	var stdout, stderr bytes.Buffer
+	root.SetIn(strings.NewReader(stdin))
+	root.SetOut(&stdout)
+	root.SetErr(&stderr)
+	root.SetArgs(args)
+
+	execErr := root.Execute()
+	return CommandResult{Stdout: stdout.String(), Stderr: stderr.String()}, execErr
+}
+
 // requireSubMap returns the nested map stored at key, failing the test if the
 // key is absent or the value is not a map[string]any.
 //
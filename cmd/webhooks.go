@@ -0,0 +1,24 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+func newWebhooksCmd(children ...*cobra.Command) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "webhooks",
+		Aliases: []string{"webhook"},
+		Short:   "Manage webhooks and inspect deliveries",
+		Long: `Manage webhooks in the active workspace.
+
+A webhook sends an HTTP POST to a URL you choose whenever a selected event
+happens in the workspace, such as a deploy finishing or a service failing.
+
+Each subcommand that takes a webhook accepts either its ID (whk-...) or its
+name. Use 'render webhooks listen' to receive deliveries on your machine while
+you develop a webhook consumer.`,
+		GroupID: GroupCore.ID,
+	}
+	cmd.AddCommand(children...)
+	return cmd
+}
@@ -0,0 +1,153 @@
+package cmd
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	renderapi "github.com/render-oss/cli/internal/fakes/renderapi"
+	"github.com/render-oss/cli/internal/testids"
+	eventtypes "github.com/render-oss/cli/pkg/client/eventtypes"
+	webhooks "github.com/render-oss/cli/pkg/client/webhooks"
+	"github.com/render-oss/cli/pkg/pointers"
+)
+
+func executeWebhooks(t *testing.T, server *renderapi.Server, args ...string) (CommandResult, error) {
+	t.Helper()
+	return executeInWorkspace(t, server, "", setupWebhookCommands, append([]string{"webhooks"}, args...)...)
+}
+
+func seedWebhook(server *renderapi.Server, name string) *webhooks.Webhook {
+	return server.Webhooks.AddOwned(serviceTestWorkspaceID, renderapi.NewWebhook(webhooks.Webhook{
+		Name:    name,
+		Enabled: true,
+	}))
+}
+
+func TestWebhooksList_ScopedToActiveWorkspace(t *testing.T) {
+	server := renderapi.NewServer(t)
+	seedWebhook(server, "deploys")
+	server.Webhooks.AddOwned(testids.WorkspaceID("other"), renderapi.NewWebhook(webhooks.Webhook{Name: "other-team"}))
+
+	result, err := executeWebhooks(t, server, "list", "--output", "text")
+	require.NoError(t, err, "stderr: %s", result.Stderr)
+
+	assert.Contains(t, result.Stdout, "deploys")
+	assert.NotContains(t, result.Stdout, "other-team")
+	assert.NotContains(t, result.Stdout, "whsec_")
+}
+
+func TestWebhooksCreate(t *testing.T) {
+	server := renderapi.NewServer(t)
+
+	result, err := executeWebhooks(t, server, "create",
+		"--name", "deploys", "--url", "https://example.com/render",
+		"--event", "deploy_started", "--event", "deploy_ended",
+		"--output", "text")
+	require.NoError(t, err, "stderr: %s", result.Stderr)
+
+	hook := server.Webhooks.Only(t)
+	assert.Equal(t, "deploys", hook.Name)
+	assert.True(t, hook.Enabled)
+	assert.Equal(t, webhooks.EventFilter{eventtypes.EventTypeDeployStarted, eventtypes.EventTypeDeployEnded}, hook.EventFilter)
+	assert.Contains(t, result.Stdout, "Signing secret: "+hook.Secret)
+}
+
+func TestWebhooksCreate_RejectsUnknownEvent(t *testing.T) {
+	server := renderapi.NewServer(t)
+
+	_, err := executeWebhooks(t, server, "create", "--name", "deploys", "--url", "https://example.com/render", "--event", "deploy_finished")
+	require.ErrorContains(t, err, `unknown event type "deploy_finished"`)
+	assert.Empty(t, server.Webhooks.Instances)
+}
+
+func TestWebhooksUpdate(t *testing.T) {
+	t.Run("changes only the given fields", func(t *testing.T) {
+		server := renderapi.NewServer(t)
+		hook := seedWebhook(server, "deploys")
+		hook.EventFilter = webhooks.EventFilter{eventtypes.EventTypeDeployEnded}
+
+		result, err := executeWebhooks(t, server, "update", "deploys", "--enabled=false")
+		require.NoError(t, err, "stderr: %s", result.Stderr)
+
+		assert.False(t, hook.Enabled)
+		assert.Equal(t, "https://example.com/render", hook.Url)
+		assert.Equal(t, webhooks.EventFilter{eventtypes.EventTypeDeployEnded}, hook.EventFilter)
+	})
+
+	t.Run("--all-events clears the filter", func(t *testing.T) {
+		server := renderapi.NewServer(t)
+		hook := seedWebhook(server, "deploys")
+		hook.EventFilter = webhooks.EventFilter{eventtypes.EventTypeDeployEnded}
+
+		_, err := executeWebhooks(t, server, "update", hook.Id, "--all-events")
+		require.NoError(t, err)
+
+		assert.Empty(t, hook.EventFilter)
+	})
+
+	t.Run("requires a change", func(t *testing.T) {
+		server := renderapi.NewServer(t)
+		seedWebhook(server, "deploys")
+
+		_, err := executeWebhooks(t, server, "update", "deploys")
+		require.ErrorContains(t, err, "nothing to update")
+	})
+}
+
+func TestWebhooksDelete(t *testing.T) {
+	t.Run("previews without --confirm", func(t *testing.T) {
+		server := renderapi.NewServer(t)
+		seedWebhook(server, "deploys")
+
+		result, err := executeWebhooks(t, server, "delete", "deploys")
+		require.NoError(t, err, "stderr: %s", result.Stderr)
+
+		assert.Contains(t, result.Stdout, "Re-run with --confirm to proceed")
+		assert.Len(t, server.Webhooks.Instances, 1)
+	})
+
+	t.Run("deletes with --confirm", func(t *testing.T) {
+		server := renderapi.NewServer(t)
+		seedWebhook(server, "deploys")
+
+		result, err := executeWebhooks(t, server, "delete", "deploys", "--confirm")
+		require.NoError(t, err, "stderr: %s", result.Stderr)
+
+		assert.Contains(t, result.Stdout, "Deleted this webhook")
+		assert.Empty(t, server.Webhooks.Instances)
+	})
+}
+
+func TestWebhooksEvents_NewestFirst(t *testing.T) {
+	server := renderapi.NewServer(t)
+	hook := seedWebhook(server, "deploys")
+	sent := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	server.Webhooks.AddEvent(hook.Id, webhooks.WebhookEvent{
+		Id: "whe-older", EventId: "evt-older", EventType: eventtypes.EventTypeDeployStarted,
+		SentAt: sent, StatusCode: pointers.From(200),
+	})
+	server.Webhooks.AddEvent(hook.Id, webhooks.WebhookEvent{
+		Id: "whe-newer", EventId: "evt-newer", EventType: eventtypes.EventTypeDeployEnded,
+		SentAt: sent.Add(time.Minute), Error: pointers.From("connection refused"),
+	})
+
+	result, err := executeWebhooks(t, server, "events", "deploys", "--output", "json")
+	require.NoError(t, err, "stderr: %s", result.Stderr)
+
+	var out struct {
+		Data []webhooks.WebhookEvent `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(result.Stdout), &out))
+	require.Len(t, out.Data, 2)
+	assert.Equal(t, "evt-newer", out.Data[0].EventId)
+
+	result, err = executeWebhooks(t, server, "events", "deploys", "--output", "text")
+	require.NoError(t, err)
+	assert.Contains(t, result.Stdout, "error: connection refused")
+	assert.Less(t, strings.Index(result.Stdout, "evt-newer"), strings.Index(result.Stdout, "evt-older"))
+}
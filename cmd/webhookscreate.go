@@ -0,0 +1,88 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	eventtypes "github.com/render-oss/cli/pkg/client/eventtypes"
+	webhooks "github.com/render-oss/cli/pkg/client/webhooks"
+	"github.com/render-oss/cli/pkg/command"
+	"github.com/render-oss/cli/pkg/config"
+	"github.com/render-oss/cli/pkg/dependencies"
+	"github.com/render-oss/cli/pkg/text"
+	webhooktypes "github.com/render-oss/cli/pkg/types/webhook"
+	"github.com/render-oss/cli/pkg/webhook"
+)
+
+func newWebhooksCreateCmd(deps *dependencies.Dependencies) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "create",
+		Short:        "Create a webhook",
+		Args:         cobra.NoArgs,
+		SilenceUsage: true,
+		Long: `Create a webhook in the active workspace.
+
+By default the webhook fires for every event type. Pass --event (repeatable) to
+subscribe to specific events, for example deploy_ended or server_failed. Pass
+--disabled to create the webhook without sending deliveries yet.
+
+The output includes the signing secret used to verify deliveries.
+
+This command only runs non-interactively. If --output interactive is requested,
+it falls back to text output.`,
+		Example: `  # Send every event to an endpoint
+  render webhooks create --name all-events --url https://example.com/render
+
+  # Only deploy events
+  render webhooks create --name deploys --url https://example.com/render --event deploy_started --event deploy_ended`,
+	}
+
+	cmd.Flags().String("name", "", "Name of the webhook (required)")
+	cmd.Flags().String("url", "", "URL deliveries are sent to (required)")
+	cmd.Flags().StringArray("event", nil, "Event type that triggers the webhook (can be specified multiple times; default all)")
+	cmd.Flags().Bool("disabled", false, "Create the webhook disabled")
+	setAllFlagPlaceholders(cmd, map[string]string{
+		"name":  "NAME",
+		"url":   "URL",
+		"event": "EVENT",
+	})
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		command.DefaultFormatNonInteractive(cmd)
+
+		var input webhooktypes.CreateInput
+		if err := command.ParseCommand(cmd, args, &input); err != nil {
+			return err
+		}
+
+		_, err := command.NonInteractive(cmd, func() (*webhook.ChangeOut, error) {
+			workspaceID, err := config.WorkspaceID()
+			if err != nil {
+				return nil, err
+			}
+			w, err := deps.WebhookRepo().CreateWebhook(cmd.Context(), webhooks.WebhookPOSTInput{
+				OwnerId:     workspaceID,
+				Name:        input.Name,
+				Url:         input.URL,
+				Enabled:     !input.Disabled,
+				EventFilter: eventFilter(input.Events),
+			})
+			if err != nil {
+				return nil, err
+			}
+			return &webhook.ChangeOut{Data: w}, nil
+		}, text.WebhookChange("Created"))
+		return err
+	}
+
+	return cmd
+}
+
+// eventFilter converts --event values into the API's filter. An empty filter
+// subscribes to every event type.
+func eventFilter(events []string) webhooks.EventFilter {
+	filter := webhooks.EventFilter{}
+	for _, e := range events {
+		filter = append(filter, eventtypes.EventType(e))
+	}
+	return filter
+}
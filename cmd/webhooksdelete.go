@@ -0,0 +1,64 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/render-oss/cli/pkg/command"
+	"github.com/render-oss/cli/pkg/dependencies"
+	"github.com/render-oss/cli/pkg/text"
+	webhooktypes "github.com/render-oss/cli/pkg/types/webhook"
+	"github.com/render-oss/cli/pkg/webhook"
+)
+
+func newWebhooksDeleteCmd(deps *dependencies.Dependencies) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "delete <webhookID|webhookName>",
+		Short:        "Delete a webhook",
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+		Long: `Delete a webhook. No further deliveries are sent to its URL.
+
+Without --confirm, this command previews what would be deleted and makes no
+changes. Pass --confirm to actually delete the webhook.
+
+This command only runs non-interactively. If --output interactive is requested,
+it falls back to text output.`,
+		Example: `  # Preview deletion (no changes made)
+  render webhooks delete deploys
+
+  # Delete
+  render webhooks delete deploys --confirm`,
+	}
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		command.DefaultFormatNonInteractive(cmd)
+
+		var input webhooktypes.DeleteInput
+		if err := command.ParseCommand(cmd, args, &input); err != nil {
+			return err
+		}
+		confirm := command.GetConfirmFromContext(cmd.Context())
+
+		_, err := command.NonInteractive(cmd, func() (*webhook.DeleteOut, error) {
+			w, err := deps.WebhookRepo().ResolveWebhook(cmd.Context(), input.IDOrName)
+			if err != nil {
+				return nil, err
+			}
+			out := &webhook.DeleteOut{
+				Data: w,
+				Meta: webhook.DeleteOutMeta{Deleted: confirm},
+			}
+			if confirm {
+				if err := deps.WebhookRepo().DeleteWebhook(cmd.Context(), w.Id); err != nil {
+					return nil, err
+				}
+			} else {
+				out.Meta.Message = "re-run with --confirm to delete"
+			}
+			return out, nil
+		}, text.WebhookDelete)
+		return err
+	}
+
+	return cmd
+}
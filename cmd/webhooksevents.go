@@ -0,0 +1,73 @@
+package cmd
+
+import (
+	"sort"
+
+	"github.com/spf13/cobra"
+
+	"github.com/render-oss/cli/pkg/client"
+	"github.com/render-oss/cli/pkg/command"
+	"github.com/render-oss/cli/pkg/dependencies"
+	"github.com/render-oss/cli/pkg/pointers"
+	"github.com/render-oss/cli/pkg/text"
+	webhooktypes "github.com/render-oss/cli/pkg/types/webhook"
+	"github.com/render-oss/cli/pkg/webhook"
+)
+
+func newWebhooksEventsCmd(deps *dependencies.Dependencies) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "events <webhookID|webhookName>",
+		Short:        "List recent deliveries of a webhook",
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+		Long: `List recent delivery attempts for a webhook, newest first, with the response
+status code or the error that prevented delivery.
+
+JSON and YAML output also include the response body returned by your endpoint.
+
+This command only runs non-interactively. If --output interactive is requested,
+it falls back to text output.`,
+		Example: `  # Show the last 20 deliveries
+  render webhooks events deploys
+
+  # Show the last 100 deliveries as JSON
+  render webhooks events deploys --limit 100 --output json`,
+	}
+
+	cmd.Flags().Int("limit", webhooktypes.DefaultEventsLimit, "Maximum number of deliveries to show (1-100)")
+	setAllFlagPlaceholders(cmd, map[string]string{
+		"limit": "COUNT",
+	})
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		command.DefaultFormatNonInteractive(cmd)
+
+		var input webhooktypes.EventsInput
+		if err := command.ParseCommand(cmd, args, &input); err != nil {
+			return err
+		}
+
+		_, err := command.NonInteractive(cmd, func() (*webhook.EventsOut, error) {
+			w, err := deps.WebhookRepo().ResolveWebhook(cmd.Context(), input.IDOrName)
+			if err != nil {
+				return nil, err
+			}
+			events, err := deps.WebhookRepo().ListEvents(cmd.Context(), w.Id, &client.ListWebhookEventsParams{
+				Limit: pointers.From(input.Limit),
+			})
+			if err != nil {
+				return nil, err
+			}
+			sort.SliceStable(events, func(i, j int) bool {
+				return events[i].SentAt.After(events[j].SentAt)
+			})
+			return &webhook.EventsOut{
+				Data: events,
+				Meta: webhook.EventsOutMeta{WebhookID: w.Id, WebhookName: w.Name},
+			}, nil
+		}, text.WebhookEvents)
+		return err
+	}
+
+	return cmd
+}
@@ -0,0 +1,50 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	webhooks "github.com/render-oss/cli/pkg/client/webhooks"
+	"github.com/render-oss/cli/pkg/command"
+	"github.com/render-oss/cli/pkg/dependencies"
+	"github.com/render-oss/cli/pkg/text"
+	"github.com/render-oss/cli/pkg/webhook"
+)
+
+func newWebhooksListCmd(deps *dependencies.Dependencies) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "list",
+		Short:        "List webhooks",
+		Args:         cobra.NoArgs,
+		SilenceUsage: true,
+		Long: `List webhooks in the active workspace with the events they subscribe to.
+
+Signing secrets are omitted from text output. JSON and YAML output include
+them.
+
+This command only runs non-interactively. If --output interactive is requested,
+it falls back to text output.`,
+		Example: `  # List webhooks
+  render webhooks list
+
+  # JSON output
+  render webhooks list --output json`,
+	}
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		command.DefaultFormatNonInteractive(cmd)
+
+		_, err := command.NonInteractive(cmd, func() (*webhook.ListOut, error) {
+			found, err := deps.WebhookRepo().ListWebhooks(cmd.Context())
+			if err != nil {
+				return nil, err
+			}
+			if found == nil {
+				found = []*webhooks.Webhook{}
+			}
+			return &webhook.ListOut{Data: found}, nil
+		}, text.WebhookTable)
+		return err
+	}
+
+	return cmd
+}
@@ -0,0 +1,160 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/render-oss/cli/pkg/command"
+	"github.com/render-oss/cli/pkg/dependencies"
+	renderstyle "github.com/render-oss/cli/pkg/style"
+	"github.com/render-oss/cli/pkg/text"
+	webhooktypes "github.com/render-oss/cli/pkg/types/webhook"
+	"github.com/render-oss/cli/pkg/webhook"
+)
+
+func newWebhooksListenCmd(deps *dependencies.Dependencies) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "listen",
+		Short:        "Receive webhook deliveries locally",
+		Args:         cobra.NoArgs,
+		SilenceUsage: true,
+		Long: `Run a local HTTP receiver that prints every webhook delivery it gets, with
+headers and payload, so you can develop a webhook consumer without deploying it.
+
+Pass --forward-to to relay each delivery to your app. The path and query the
+delivery was received on are appended to the --forward-to URL. The receiver
+answers the sender with your app's response, so delivery failures show up as
+they would in production. Bodies over 1 MiB are rejected with 413.
+
+Render cannot reach localhost directly. Expose the receiver's port with a
+tunnel and point a webhook's URL at it, or replay payloads with curl.
+
+Pass --webhook to verify each delivery's signature with that webhook's signing
+secret, or --secret to supply the secret directly.
+
+With --output json, each delivery is printed as one JSON object per line. The
+receiver runs until interrupted.`,
+		Example: `  # Print deliveries received on port 4242
+  render webhooks listen
+
+  # Forward deliveries to a local app and verify signatures
+  render webhooks listen --forward-to http://localhost:3000/webhooks --webhook deploys
+
+  # Stream deliveries as JSON lines
+  render webhooks listen --port 9000 --output json`,
+	}
+
+	cmd.Flags().Int("port", webhooktypes.DefaultListenPort, "Port to receive deliveries on")
+	cmd.Flags().String("forward-to", "", "URL to relay each delivery to")
+	cmd.Flags().String("webhook", "", "Webhook (ID or name) whose signing secret verifies deliveries")
+	cmd.Flags().String("secret", "", "Signing secret used to verify deliveries")
+	setAllFlagPlaceholders(cmd, map[string]string{
+		"port":       "PORT",
+		"forward-to": "URL",
+		"webhook":    "WEBHOOK",
+		"secret":     "SECRET",
+	})
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		command.DefaultFormatNonInteractive(cmd)
+		ctx := cmd.Context()
+
+		var input webhooktypes.ListenInput
+		if err := command.ParseCommand(cmd, args, &input); err != nil {
+			return err
+		}
+
+		opts := webhook.ReceiverOptions{OnDelivery: deliveryPrinter(cmd)}
+		if input.ForwardTo != nil {
+			opts.ForwardTo = *input.ForwardTo
+		}
+		if input.Secret != nil {
+			opts.Secret = *input.Secret
+		}
+		if input.Webhook != nil {
+			w, err := deps.WebhookRepo().ResolveWebhook(ctx, *input.Webhook)
+			if err != nil {
+				return err
+			}
+			opts.Secret = w.Secret
+		}
+
+		receiver, err := webhook.NewReceiver(opts)
+		if err != nil {
+			return err
+		}
+
+		listener, err := net.Listen("tcp", fmt.Sprintf("localhost:%d", input.Port))
+		if err != nil {
+			if errors.Is(err, syscall.EADDRINUSE) {
+				return fmt.Errorf("port %d is already in use. Stop the other process or use --port to pick a different one", input.Port)
+			}
+			return fmt.Errorf("failed to start receiver on port %d: %w", input.Port, err)
+		}
+
+		server := &http.Server{Handler: receiver, ReadHeaderTimeout: 5 * time.Second}
+		serveErr := make(chan error, 1)
+		go func() {
+			serveErr <- server.Serve(listener)
+		}()
+
+		stderr := cmd.ErrOrStderr()
+		fmt.Fprintf(stderr, "Listening for webhook deliveries on %s\n",
+			renderstyle.Bold("http://"+listener.Addr().String()))
+		if opts.ForwardTo != "" {
+			fmt.Fprintf(stderr, "Forwarding deliveries to %s\n", renderstyle.Bold(opts.ForwardTo))
+		}
+		if opts.Secret != "" {
+			fmt.Fprintln(stderr, "Verifying delivery signatures")
+		}
+		fmt.Fprintln(stderr, renderstyle.SubtleText.Render("Press Ctrl+C to stop"))
+		fmt.Fprintln(stderr)
+
+		select {
+		case <-ctx.Done():
+			shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			return server.Shutdown(shutdownCtx)
+		case err := <-serveErr:
+			return err
+		}
+	}
+
+	return cmd
+}
+
+// deliveryPrinter writes each delivery to stdout in the requested format.
+// Deliveries can arrive concurrently, so writes are serialized.
+func deliveryPrinter(cmd *cobra.Command) func(webhook.Delivery) {
+	var mu sync.Mutex
+	format := command.GetFormatFromContext(cmd.Context())
+	return func(d webhook.Delivery) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if format != nil && *format == command.JSON {
+			line, err := json.Marshal(d)
+			if err != nil {
+				fmt.Fprintf(cmd.ErrOrStderr(), "failed to encode delivery: %v\n", err)
+				return
+			}
+			_, _ = cmd.OutOrStdout().Write(append(line, '\n'))
+			return
+		}
+		if format != nil && *format == command.YAML {
+			_, _ = cmd.OutOrStdout().Write([]byte("---\n"))
+		}
+		if _, err := command.PrintData(cmd, d, text.WebhookDelivery); err != nil {
+			fmt.Fprintf(cmd.ErrOrStderr(), "failed to print delivery: %v\n", err)
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	webhooks "github.com/render-oss/cli/pkg/client/webhooks"
+	"github.com/render-oss/cli/pkg/command"
+	"github.com/render-oss/cli/pkg/dependencies"
+	"github.com/render-oss/cli/pkg/pointers"
+	"github.com/render-oss/cli/pkg/text"
+	webhooktypes "github.com/render-oss/cli/pkg/types/webhook"
+	"github.com/render-oss/cli/pkg/webhook"
+)
+
+func newWebhooksUpdateCmd(deps *dependencies.Dependencies) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "update <webhookID|webhookName>",
+		Short:        "Update a webhook",
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+		Long: `Update a webhook's name, URL, events, or enabled state. Only the flags you
+pass are changed.
+
+--event replaces the webhook's event list. Pass --all-events to subscribe to
+every event type instead.
+
+This command only runs non-interactively. If --output interactive is requested,
+it falls back to text output.`,
+		Example: `  # Point a webhook at a new URL
+  render webhooks update deploys --url https://example.com/hooks/render
+
+  # Pause deliveries
+  render webhooks update deploys --enabled=false
+
+  # Subscribe to every event type
+  render webhooks update whk-abc123def456ghi789jkl0 --all-events`,
+	}
+
+	cmd.Flags().String("name", "", "New name for the webhook")
+	cmd.Flags().String("url", "", "New URL deliveries are sent to")
+	cmd.Flags().StringArray("event", nil, "Event type that triggers the webhook (can be specified multiple times; replaces the current list)")
+	cmd.Flags().Bool("all-events", false, "Trigger the webhook for every event type")
+	cmd.Flags().Bool("enabled", true, "Enable or disable deliveries")
+	setAllFlagPlaceholders(cmd, map[string]string{
+		"name":  "NAME",
+		"url":   "URL",
+		"event": "EVENT",
+	})
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		command.DefaultFormatNonInteractive(cmd)
+
+		var input webhooktypes.UpdateInput
+		if err := command.ParseCommand(cmd, args, &input); err != nil {
+			return err
+		}
+
+		_, err := command.NonInteractive(cmd, func() (*webhook.ChangeOut, error) {
+			w, err := deps.WebhookRepo().ResolveWebhook(cmd.Context(), input.IDOrName)
+			if err != nil {
+				return nil, err
+			}
+			body := webhooks.WebhookPATCHInput{
+				Name:    input.Name,
+				Url:     input.URL,
+				Enabled: input.Enabled,
+			}
+			if input.AllEvents || len(input.Events) > 0 {
+				body.EventFilter = pointers.From(eventFilter(input.Events))
+			}
+			updated, err := deps.WebhookRepo().UpdateWebhook(cmd.Context(), w.Id, body)
+			if err != nil {
+				return nil, err
+			}
+			return &webhook.ChangeOut{Data: updated}, nil
+		}, text.WebhookChange("Updated"))
+		return err
+	}
+
+	return cmd
+}
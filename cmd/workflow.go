@@ -1,10 +1,14 @@
 package cmd
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"maps"
 	"net/http"
+	"os"
 	"path/filepath"
+	"reflect"
 	"sort"
 	"strings"
 	"syscall"
@@ -15,10 +19,12 @@ import (
 	renderstyle "github.com/render-oss/cli/pkg/style"
 	"github.com/render-oss/cli/pkg/utils"
 	"github.com/render-oss/cli/pkg/workflows/apiserver"
+	"github.com/render-oss/cli/pkg/workflows/devconfig"
 	logstore "github.com/render-oss/cli/pkg/workflows/logs"
 	"github.com/render-oss/cli/pkg/workflows/orchestrator"
 	"github.com/render-oss/cli/pkg/workflows/store"
 	"github.com/render-oss/cli/pkg/workflows/taskserver"
+	"github.com/render-oss/cli/pkg/workflows/watcher"
 	"github.com/spf13/cobra"
 )
 
@@ -39,17 +45,31 @@ var WorkflowsCmd = &cobra.Command{
 }
 
 var workflowDevCmd = &cobra.Command{
-	Use:          "dev -- <command to start a workflow service>",
+	Use:          "dev [--config <file> | -- <command to start a workflow service>]",
 	Short:        "Start a workflow service in development mode",
 	SilenceUsage: true,
 	Long: `Start a workflow service in development mode for local testing.
-Required input: -- <command to start a workflow service>
+Required input: -- <command to start a workflow service>, or --config <file>
 
-This command runs your workflow service locally on port 8120, allowing you to list and run tasks without deploying to Render. Task runs and their logs are stored in memory, so you can query them after tasks complete.
+This command runs your workflow service locally on port 8120, allowing you to list and run tasks without deploying to Render. Task runs and their logs are kept so you can query them after tasks complete: in memory by default, or on disk with --state-dir.
+
+To reload tasks when your code changes:
+  • Specify --watch to re-register tasks whenever a file in the current directory changes
+  • With --config, the directory of each source is watched instead
+  • Files matched by .gitignore are not watched
+  • Added and removed tasks and retry config changes are printed, as are registration errors
+
+To keep task runs and logs across restarts:
+  • Specify --state-dir to save them to a directory and reload them on startup
+  • Task runs are saved to task-runs.jsonl and logs to logs.jsonl in that directory
+  • Task runs that were still in progress when the server stopped are marked as failed
+  • On startup, logs beyond the most recent 100,000 are dropped
+  • Delete the directory, or the files in it, while the server is stopped to clear saved runs and logs
 
 The command will spawn a new subprocess with your specified command whenever it needs to run a task or list the defined tasks.
 
 To interact with the local task server:
+  • Open http://localhost:8120 in a browser to list tasks, start runs, and follow run trees and logs
   • Use the --local flag with other task commands (e.g., 'render workflows tasks list --local')
   • Or set RENDER_USE_LOCAL_DEV=true when using the workflow client SDK
 
@@ -57,6 +77,46 @@ To use a different port:
   • Specify --port when starting the dev server
   • Then use --port with other task commands, or set RENDER_LOCAL_DEV_URL in the SDK
 
+To develop a workflow whose tasks span several services (e.g. Python and TypeScript):
+  • List the services as sources in a config file and pass it with --config instead of a command
+  • Each source registers its own tasks, and runs of a task start the source that registered it
+  • Tasks can start each other's tasks as subtasks across sources
+  • Task names must be unique across sources
+
+  sources:
+    - name: python
+      command: python main.py
+      dir: services/python
+    - name: node
+      command: npx tsx src/main.ts
+      dir: services/node
+      env:
+        LOG_LEVEL: debug
+
+  A source's dir is relative to the config file, and its env overrides values from env files.
+  A string command is split on whitespace without shell quoting; for arguments with spaces, write
+  the command as a list, e.g. [python, -c, "print(1)"].
+
+To limit how many task runs execute at once:
+  • Specify --max-concurrency to cap runs across all tasks
+  • Specify --task-concurrency NAME=N (repeatable) to cap runs of a single task
+  • Runs over the limit wait in a FIFO queue as pending, like on Render
+  • A run waiting on a subtask gives up its slot until the subtask finishes, then gets the next free slot ahead of queued runs
+
+To limit how long a task run can take:
+  • Tasks that register a timeout with the SDK are stopped once an attempt exceeds it
+  • Specify --default-timeout to apply a limit to tasks that don't register one
+  • Timed-out attempts are recorded as failures and retried per the task's retry config
+
+To test retry and idempotency behavior before deploying:
+  • Specify --inject-fault KIND:TASK[@ATTEMPT][:DELAY] (repeatable) to disrupt runs of a task
+  • fail fails an attempt after the task reports its result, so its side effects still happen
+  • kill kills the task process once an attempt has run for DELAY
+  • delay-result withholds a finished subtask's result from its parent for DELAY
+  • drop-callback discards the result a task reports, so the attempt fails when the process exits
+  • Without @ATTEMPT, a fault applies to every attempt
+  • Add and remove faults while the server runs with the /v1/faults endpoint
+
 Environment variables:
   • A .env file in the current directory is loaded automatically if present
   • Use --env-file to load one or more specific files (later files override earlier ones)
@@ -67,6 +127,24 @@ Environment variables:
   # Start local workflow development server on a custom port
   render workflows dev --port 9000 -- "npm start"
 
+  # Host tasks from several services listed in a config file
+  render workflows dev --config workflows.yaml
+
+  # Run at most 4 tasks at once, and 1 run of send_email at a time
+  render workflows dev --max-concurrency 4 --task-concurrency send_email=1 -- "python main.py"
+
+  # Stop any task attempt that runs for more than 5 minutes
+  render workflows dev --default-timeout 5m -- "python main.py"
+
+  # Fail the first attempt of charge_card, and kill every attempt of sync after 2 seconds
+  render workflows dev --inject-fault fail:charge_card@1 --inject-fault kill:sync:2s -- "python main.py"
+
+  # Re-register tasks whenever project files change
+  render workflows dev --watch -- "python main.py"
+
+  # Keep task runs and logs across restarts
+  render workflows dev --state-dir .render/workflows -- "python main.py"
+
   # Load environment variables from custom files
   render workflows dev --env-file .env --env-file .env.local -- "python main.py"
 
@@ -79,7 +157,20 @@ Environment variables:
 			commandArgs = args[cmd.ArgsLenAtDash():]
 		}
 
-		if len(commandArgs) == 0 {
+		configPath, err := cmd.Flags().GetString("config")
+		if err != nil {
+			return fmt.Errorf("failed to get config flag: %w", err)
+		}
+		var devConfig *devconfig.Config
+		if configPath != "" {
+			if len(commandArgs) > 0 {
+				return errors.New("pass either --config or a command after --, not both")
+			}
+			devConfig, err = devconfig.Load(configPath)
+			if err != nil {
+				return err
+			}
+		} else if len(commandArgs) == 0 {
 			return errors.New("command is required")
 		}
 
@@ -102,6 +193,10 @@ Environment variables:
 			return err
 		}
 		extraEnv := utils.EnvMapToKVStrings(envVars)
+		sourceDescription := describeWorkflowSource(commandArgs)
+		if devConfig != nil {
+			sourceDescription = filepath.Base(configPath)
+		}
 
 		socketTracker, err := orchestrator.NewSocketTracker(ctx)
 		if err != nil {
@@ -110,8 +205,64 @@ Environment variables:
 
 		taskServerFactory := taskserver.NewTaskServerFactory()
 
-		logs := logstore.NewLogStore()
-		store := store.NewTaskStore()
+		stateDir, err := cmd.Flags().GetString("state-dir")
+		if err != nil {
+			return fmt.Errorf("failed to get state-dir flag: %w", err)
+		}
+
+		maxConcurrency, err := cmd.Flags().GetInt("max-concurrency")
+		if err != nil {
+			return fmt.Errorf("failed to get max-concurrency flag: %w", err)
+		}
+		taskConcurrency, err := cmd.Flags().GetStringToInt("task-concurrency")
+		if err != nil {
+			return fmt.Errorf("failed to get task-concurrency flag: %w", err)
+		}
+		if maxConcurrency < 0 {
+			return errors.New("--max-concurrency must not be negative")
+		}
+		for name, limit := range taskConcurrency {
+			if limit < 0 {
+				return fmt.Errorf("--task-concurrency for %s must not be negative", name)
+			}
+		}
+
+		defaultTimeout, err := cmd.Flags().GetDuration("default-timeout")
+		if err != nil {
+			return fmt.Errorf("failed to get default-timeout flag: %w", err)
+		}
+		if defaultTimeout < 0 {
+			return errors.New("--default-timeout must not be negative")
+		}
+
+		faultSpecs, err := cmd.Flags().GetStringSlice("inject-fault")
+		if err != nil {
+			return fmt.Errorf("failed to get inject-fault flag: %w", err)
+		}
+		var injected []orchestrator.Fault
+		for _, spec := range faultSpecs {
+			fault, err := orchestrator.ParseFault(spec)
+			if err != nil {
+				return fmt.Errorf("--inject-fault: %w", err)
+			}
+			injected = append(injected, fault)
+		}
+		faults, err := orchestrator.NewFaults(injected...)
+		if err != nil {
+			return err
+		}
+
+		watch, err := cmd.Flags().GetBool("watch")
+		if err != nil {
+			return fmt.Errorf("failed to get watch flag: %w", err)
+		}
+
+		logs, taskStore, err := newWorkflowDevStores(stateDir)
+		if err != nil {
+			return err
+		}
+		defer logs.Close()
+		defer taskStore.Close()
 		var (
 			pending []string
 			ready   bool
@@ -130,13 +281,26 @@ Environment variables:
 			orchestrator.WithStatusReporterTaskEnqueued(debugMode),
 			orchestrator.WithStatusReporterIncludeInputs(true),
 		)
+		coordinatorOpts := []orchestrator.CoordinatorOption{
+			orchestrator.WithMaxConcurrency(maxConcurrency),
+			orchestrator.WithTaskConcurrency(taskConcurrency),
+			orchestrator.WithDefaultTimeout(defaultTimeout),
+			orchestrator.WithFaults(faults),
+		}
+		var sdkExec *orchestrator.Exec
+		if devConfig != nil {
+			coordinatorOpts = append(coordinatorOpts, orchestrator.WithSources(newWorkflowSources(logs, debugMode, envVars, devConfig)...))
+		} else {
+			sdkExec = orchestrator.NewExec(logs, debugMode, commandArgs[0], extraEnv, commandArgs[1:]...)
+		}
 		coordinator := orchestrator.NewCoordinator(
 			ctx,
-			store,
-			orchestrator.NewExec(logs, debugMode, commandArgs[0], extraEnv, commandArgs[1:]...),
+			taskStore,
+			sdkExec,
 			socketTracker,
 			taskServerFactory,
 			statusReporter,
+			coordinatorOpts...,
 		)
 
 		upgrader := &websocket.Upgrader{
@@ -145,7 +309,7 @@ Environment variables:
 			},
 		}
 
-		api := apiserver.NewHandler(coordinator, store, logs, upgrader)
+		api := apiserver.NewHandler(coordinator, taskStore, logs, upgrader)
 		apiSrv, err := apiserver.Start(api, port)
 		if err != nil {
 			if errors.Is(err, syscall.EADDRINUSE) {
@@ -170,6 +334,13 @@ Environment variables:
 			)
 		}
 
+		if len(injected) > 0 {
+			warn := lipgloss.NewStyle().Foreground(renderstyle.ColorWarning)
+			for _, fault := range faults.List() {
+				command.Println(cmd, "%s %s", warn.Render("Injecting fault"), renderstyle.Bold(fault.String()))
+			}
+		}
+
 		logs.Start(ctx)
 
 		registeredTasks, err := coordinator.PopulateTasks(ctx)
@@ -177,13 +348,16 @@ Environment variables:
 			return fmt.Errorf("failed to load tasks: %w", err)
 		}
 
-		command.Println(cmd, "%s", formatTaskSummary(info, registeredTasks, describeWorkflowSource(commandArgs)))
+		command.Println(cmd, "%s", formatSourceSummaries(info, coordinator, registeredTasks, sourceDescription))
 		command.Println(cmd, "")
 
 		portFlag := ""
 		if port != defaultTaskAPIPort {
 			portFlag = fmt.Sprintf(" --port %d", port)
 		}
+		command.Println(cmd, "%s", dim.Render("To browse and run tasks in your browser, open:"))
+		command.Println(cmd, "  %s", renderstyle.Bold(fmt.Sprintf("http://localhost:%d", port)))
+		command.Println(cmd, "")
 		command.Println(cmd, "%s", dim.Render("To browse and run tasks, open another terminal and run:"))
 		command.Println(cmd, "  %s", renderstyle.Bold(fmt.Sprintf("render workflows tasks list --local%s", portFlag)))
 		command.Println(cmd, "")
@@ -197,21 +371,59 @@ Environment variables:
 		}
 		pending = nil
 
+		if watch {
+			var opts []watcher.Option
+			if stateDir != "" {
+				opts = append(opts, watcher.WithIgnoredPaths(stateDir))
+			}
+			w, err := watcher.New(watchDirs(devConfig), opts...)
+			if err != nil {
+				return fmt.Errorf("failed to watch project directory: %w", err)
+			}
+			command.Println(cmd, "%s", dim.Render("Watching for changes..."))
+			// SetTasks updates retry configs of existing tasks in place, so
+			// keep a copy to diff the next registration against.
+			retryConfigs := retryConfigsByName(registeredTasks)
+			go func() {
+				err := w.Watch(ctx, func(changed []string) {
+					if tasks := reloadTasks(ctx, cmd, coordinator, retryConfigs, changed, sourceDescription); tasks != nil {
+						retryConfigs = retryConfigsByName(tasks)
+					}
+				})
+				if err != nil {
+					command.Println(cmd, "%s", lipgloss.NewStyle().Foreground(renderstyle.ColorError).Render(fmt.Sprintf("Stopped watching for changes: %s", err)))
+				}
+			}()
+		}
+
 		<-ctx.Done()
 
 		apiSrv.Shutdown(ctx)
 
 		return nil
 	},
-	Args: cobra.MinimumNArgs(1),
+	Args: cobra.ArbitraryArgs,
 }
 
 func init() {
 	workflowDevCmd.Flags().Int("port", defaultTaskAPIPort, "Set the port of the local task server")
 	workflowDevCmd.Flags().Bool("debug", false, "Print detailed workflow task execution events")
+	workflowDevCmd.Flags().String("config", "", "Path to a workflow config file listing the task sources to host, instead of a command after --")
 	workflowDevCmd.Flags().StringSlice("env-file", []string{defaultEnvFile}, "Path to an env file to load into the workflow subprocess. Repeat to load multiple files (later files override earlier ones).")
 	setFlagPlaceholder(workflowDevCmd.Flags(), "port", "PORT")
+	setFlagPlaceholder(workflowDevCmd.Flags(), "config", "PATH")
+	workflowDevCmd.Flags().Int("max-concurrency", 0, "Maximum number of task runs to execute at once (0 for no limit)")
+	workflowDevCmd.Flags().StringToInt("task-concurrency", nil, "Maximum concurrent runs of a task, as NAME=N. Repeat for multiple tasks")
+	workflowDevCmd.Flags().Duration("default-timeout", 0, "Maximum run time of a task attempt for tasks that don't set their own timeout (0 for no limit)")
+	workflowDevCmd.Flags().StringSlice("inject-fault", nil, "Inject a fault into runs of a task, as KIND:TASK[@ATTEMPT][:DELAY] where KIND is fail, kill, delay-result, or drop-callback. Repeat for multiple faults")
+	workflowDevCmd.Flags().Bool("watch", false, "Re-register tasks when files in the current directory, or with --config in each source's dir, change")
+	workflowDevCmd.Flags().String("state-dir", "", "Save task runs and logs to this directory and reload them on startup")
 	setFlagPlaceholder(workflowDevCmd.Flags(), "env-file", "PATH")
+	setFlagPlaceholder(workflowDevCmd.Flags(), "max-concurrency", "N")
+	setFlagPlaceholder(workflowDevCmd.Flags(), "task-concurrency", "NAME=N")
+	setFlagPlaceholder(workflowDevCmd.Flags(), "default-timeout", "DURATION")
+	setFlagPlaceholder(workflowDevCmd.Flags(), "state-dir", "DIR")
+	setFlagPlaceholder(workflowDevCmd.Flags(), "inject-fault", "SPEC")
 	// The args after "--" form a shell command, so keep file completion.
 	workflowDevCmd.CompletionOptions.SetDefaultShellCompDirective(cobra.ShellCompDirectiveDefault)
 
@@ -219,6 +431,63 @@ func init() {
 	WorkflowsCmd.AddCommand(workflowDevCmd)
 }
 
+// newWorkflowDevStores returns in-memory stores, or stores backed by stateDir
+// when it is set.
+func newWorkflowDevStores(stateDir string) (*logstore.LogStore, *store.TaskStore, error) {
+	if stateDir == "" {
+		return logstore.NewLogStore(), store.NewTaskStore(), nil
+	}
+
+	logs, err := logstore.NewPersistentLogStore(stateDir)
+	if err != nil {
+		return nil, nil, err
+	}
+	taskStore, err := store.NewPersistentTaskStore(stateDir)
+	if err != nil {
+		logs.Close()
+		return nil, nil, err
+	}
+	return logs, taskStore, nil
+}
+
+// newWorkflowSources returns a source for each entry in config. A source's
+// own env overrides the values loaded from env files.
+func newWorkflowSources(logs *logstore.LogStore, debug bool, envVars map[string]string, config *devconfig.Config) []orchestrator.Source {
+	sources := make([]orchestrator.Source, len(config.Sources))
+	for i, source := range config.Sources {
+		env := make(map[string]string, len(envVars)+len(source.Env))
+		maps.Copy(env, envVars)
+		maps.Copy(env, source.Env)
+		sources[i] = orchestrator.Source{
+			Name: source.Name,
+			Exec: orchestrator.NewExec(logs, debug, source.Command[0], utils.EnvMapToKVStrings(env), source.Command[1:]...).InDir(source.Dir),
+		}
+	}
+	return sources
+}
+
+// watchDirs returns the directories --watch watches: the current directory,
+// or with a config file, each source's directory. Directories are made
+// relative to the current directory where possible, so changed files are
+// reported with short paths.
+func watchDirs(config *devconfig.Config) []string {
+	if config == nil {
+		return []string{"."}
+	}
+	wd, wdErr := os.Getwd()
+	dirs := make([]string, len(config.Sources))
+	for i, source := range config.Sources {
+		dirs[i] = source.Dir
+		if wdErr != nil {
+			continue
+		}
+		if rel, err := filepath.Rel(wd, source.Dir); err == nil {
+			dirs[i] = rel
+		}
+	}
+	return dirs
+}
+
 func describeWorkflowSource(commandArgs []string) string {
 	if len(commandArgs) == 0 {
 		return ""
@@ -233,6 +502,26 @@ func describeWorkflowSource(commandArgs []string) string {
 	return strings.Join(commandArgs, " ")
 }
 
+// formatSourceSummaries summarizes the registered tasks of each of the
+// coordinator's sources, or of source when it has a single unnamed one.
+func formatSourceSummaries(info lipgloss.Style, coordinator *orchestrator.Coordinator, tasks []*store.Task, source string) string {
+	names := coordinator.SourceNames()
+	if len(names) == 1 && names[0] == "" {
+		return formatTaskSummary(info, tasks, source)
+	}
+
+	bySource := make(map[string][]*store.Task, len(names))
+	for _, task := range tasks {
+		name := coordinator.TaskSource(task.Name)
+		bySource[name] = append(bySource[name], task)
+	}
+	summaries := make([]string, len(names))
+	for i, name := range names {
+		summaries[i] = formatTaskSummary(info, bySource[name], name)
+	}
+	return strings.Join(summaries, "\n")
+}
+
 func formatTaskSummary(info lipgloss.Style, tasks []*store.Task, source string) string {
 	if len(tasks) == 0 {
 		return fmt.Sprintf("0 tasks found in %s (waiting for registration)", renderstyle.Bold(source))
@@ -259,3 +548,92 @@ func formatTaskSummary(info lipgloss.Style, tasks []*store.Task, source string)
 	}
 	return b.String()
 }
+
+// reloadTasks re-registers tasks after a file change and prints how the set of
+// tasks changed, or the registration error if the new code is broken. It
+// returns the registered tasks, or nil if registration failed.
+func reloadTasks(ctx context.Context, cmd *cobra.Command, coordinator *orchestrator.Coordinator, before map[string]*taskserver.RetryConfig, changed []string, source string) []*store.Task {
+	info := lipgloss.NewStyle().Foreground(renderstyle.ColorInfo)
+	dim := lipgloss.NewStyle().Foreground(renderstyle.ColorDeprioritized)
+	errStyle := lipgloss.NewStyle().Foreground(renderstyle.ColorError)
+
+	command.Println(cmd, "%s", dim.Render(fmt.Sprintf("Detected changes in %s, re-registering tasks...", describeChangedFiles(changed))))
+
+	tasks, err := coordinator.PopulateTasks(ctx)
+	if err != nil {
+		if ctx.Err() == nil {
+			command.Println(cmd, "%s", errStyle.Render(fmt.Sprintf("Task registration failed: %s", err)))
+		}
+		return nil
+	}
+
+	command.Println(cmd, "%s", formatSourceSummaries(info, coordinator, tasks, source))
+	command.Println(cmd, "%s", formatTaskChanges(diffTasks(before, tasks)))
+	return tasks
+}
+
+func describeChangedFiles(changed []string) string {
+	if len(changed) == 1 {
+		return changed[0]
+	}
+	return fmt.Sprintf("%d files", len(changed))
+}
+
+type taskChanges struct {
+	Added        []string
+	Removed      []string
+	RetryChanged []string
+}
+
+func retryConfigsByName(tasks []*store.Task) map[string]*taskserver.RetryConfig {
+	configs := make(map[string]*taskserver.RetryConfig, len(tasks))
+	for _, task := range tasks {
+		configs[task.Name] = task.RetryConfig
+	}
+	return configs
+}
+
+func diffTasks(before map[string]*taskserver.RetryConfig, after []*store.Task) taskChanges {
+	var changes taskChanges
+	seen := make(map[string]bool, len(after))
+	for _, task := range after {
+		seen[task.Name] = true
+		previous, ok := before[task.Name]
+		if !ok {
+			changes.Added = append(changes.Added, task.Name)
+		} else if !reflect.DeepEqual(previous, task.RetryConfig) {
+			changes.RetryChanged = append(changes.RetryChanged, task.Name)
+		}
+	}
+	for name := range before {
+		if !seen[name] {
+			changes.Removed = append(changes.Removed, name)
+		}
+	}
+	sort.Strings(changes.Added)
+	sort.Strings(changes.Removed)
+	sort.Strings(changes.RetryChanged)
+	return changes
+}
+
+func formatTaskChanges(changes taskChanges) string {
+	if len(changes.Added)+len(changes.Removed)+len(changes.RetryChanged) == 0 {
+		return "No task changes"
+	}
+
+	ok := lipgloss.NewStyle().Foreground(renderstyle.ColorOK)
+	warn := lipgloss.NewStyle().Foreground(renderstyle.ColorWarning)
+	errStyle := lipgloss.NewStyle().Foreground(renderstyle.ColorError)
+
+	var lines []string
+	for _, name := range changes.Added {
+		lines = append(lines, ok.Render("  + "+name))
+	}
+	for _, name := range changes.Removed {
+		lines = append(lines, errStyle.Render("  - "+name))
+	}
+	for _, name := range changes.RetryChanged {
+		lines = append(lines, warn.Render("  ~ "+name+" (retry config changed)"))
+	}
+	return "Task changes:\n" + strings.Join(lines, "\n")
+}
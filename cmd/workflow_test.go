@@ -1,11 +1,63 @@
 package cmd
 
 import (
+	"path/filepath"
 	"testing"
 
 	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/render-oss/cli/pkg/pointers"
+	"github.com/render-oss/cli/pkg/workflows/devconfig"
+	"github.com/render-oss/cli/pkg/workflows/store"
+	"github.com/render-oss/cli/pkg/workflows/taskserver"
 )
 
 func TestWorkflowDevKeepsFileCompletion(t *testing.T) {
 	requireCompletionDirective(t, []string{"workflows", "dev", ""}, cobra.ShellCompDirectiveDefault)
 }
+
+func TestDiffTasks(t *testing.T) {
+	before := retryConfigsByName([]*store.Task{
+		{Name: "kept"},
+		{Name: "removed"},
+		{Name: "retried", RetryConfig: &taskserver.RetryConfig{MaxRetries: pointers.From(1)}},
+	})
+
+	changes := diffTasks(before, []*store.Task{
+		{Name: "kept"},
+		{Name: "added"},
+		{Name: "retried", RetryConfig: &taskserver.RetryConfig{MaxRetries: pointers.From(3)}},
+	})
+
+	assert.Equal(t, taskChanges{
+		Added:        []string{"added"},
+		Removed:      []string{"removed"},
+		RetryChanged: []string{"retried"},
+	}, changes)
+
+	assert.Equal(t, "No task changes", formatTaskChanges(diffTasks(before, []*store.Task{
+		{Name: "kept"},
+		{Name: "removed"},
+		{Name: "retried", RetryConfig: &taskserver.RetryConfig{MaxRetries: pointers.From(1)}},
+	})))
+}
+
+func TestWatchDirs(t *testing.T) {
+	assert.Equal(t, []string{"."}, watchDirs(nil))
+
+	base := t.TempDir()
+	t.Chdir(base)
+	config, err := devconfig.Parse([]byte(`
+sources:
+  - name: api
+    command: python main.py
+    dir: services/api
+  - name: worker
+    command: go run .
+`), base)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{filepath.Join("services", "api"), "."}, watchDirs(config))
+}
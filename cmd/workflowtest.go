@@ -0,0 +1,194 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/render-oss/cli/pkg/command"
+	"github.com/render-oss/cli/pkg/text"
+	"github.com/render-oss/cli/pkg/utils"
+	logstore "github.com/render-oss/cli/pkg/workflows/logs"
+	"github.com/render-oss/cli/pkg/workflows/orchestrator"
+	"github.com/render-oss/cli/pkg/workflows/store"
+	"github.com/render-oss/cli/pkg/workflows/taskserver"
+	"github.com/render-oss/cli/pkg/workflows/testharness"
+)
+
+var workflowTestCmd = &cobra.Command{
+	Use:          "test --file <scenarios> -- <command to start a workflow service>",
+	Short:        "Run workflow task scenarios and check their results",
+	SilenceUsage: true,
+	Long: `Run workflow tasks against scenarios and report which passed, for testing task code in CI.
+Required input: -- <command to start a workflow service>
+
+This command runs the same orchestrator as 'render workflows dev' in-process, without starting a server. Each scenario starts one task run and waits for it and all of its subtask runs to finish. Scenarios run one at a time, in order.
+
+Scenarios are read from a YAML or JSON file:
+
+  scenarios:
+    - name: adds two numbers
+      task: add
+      input: [1, 2]
+      expect:
+        output: 3
+    - name: fans out
+      task: process_all
+      input: {"items": ["a", "b"]}
+      expect:
+        subtasks:
+          - task: process
+            input: ["a"]
+          - task: process
+            input: ["b"]
+    - name: rejects bad input
+      task: add
+      input: ["x", 2]
+      timeout: 10s
+      expect:
+        error: "unsupported operand"
+
+Each scenario can expect:
+  • output: the task's result (a task run completing is always expected unless error is set)
+  • error: text the run's error must contain; "" accepts any failure
+  • subtasks: the subtask runs the task starts directly, in any order; input is optional
+
+Results are printed as text, or as JSON with --output json. Use --junit to also write a JUnit XML report. The command exits with a non-zero status if any scenario fails.`,
+	Example: `  # Run scenarios against a Python workflow service
+  render workflows test --file scenarios.yaml -- "python main.py"
+
+  # Write a JUnit report for CI
+  render workflows test --file scenarios.yaml --junit report.xml -- "npm start"
+
+  # Print results as JSON
+  render workflows test --file scenarios.yaml --output json -- "python main.py"`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		command.DefaultFormatNonInteractive(cmd)
+		ctx := cmd.Context()
+
+		var commandArgs []string
+		if cmd.ArgsLenAtDash() >= 0 {
+			commandArgs = args[cmd.ArgsLenAtDash():]
+		}
+		if len(commandArgs) == 0 {
+			return errors.New("command is required")
+		}
+
+		file, err := cmd.Flags().GetString("file")
+		if err != nil {
+			return fmt.Errorf("failed to get file flag: %w", err)
+		}
+		junitPath, err := cmd.Flags().GetString("junit")
+		if err != nil {
+			return fmt.Errorf("failed to get junit flag: %w", err)
+		}
+		timeout, err := cmd.Flags().GetDuration("timeout")
+		if err != nil {
+			return fmt.Errorf("failed to get timeout flag: %w", err)
+		}
+		if timeout <= 0 {
+			return errors.New("--timeout must be positive")
+		}
+		debugMode, err := cmd.Flags().GetBool("debug")
+		if err != nil {
+			return fmt.Errorf("failed to get debug flag: %w", err)
+		}
+		envFiles, err := cmd.Flags().GetStringSlice("env-file")
+		if err != nil {
+			return fmt.Errorf("failed to get env-file flag: %w", err)
+		}
+
+		suite, err := testharness.LoadSuite(file)
+		if err != nil {
+			return err
+		}
+
+		envVars, _, err := utils.LoadEnvFiles(envFiles, cmd.Flags().Changed("env-file"))
+		if err != nil {
+			return err
+		}
+
+		socketTracker, err := orchestrator.NewSocketTracker(ctx)
+		if err != nil {
+			return err
+		}
+
+		logs := logstore.NewLogStore()
+		taskStore := store.NewTaskStore()
+		logs.Start(ctx)
+
+		statusReporter := orchestrator.NewPrintStatusReporter(
+			func(format string, args ...any) {
+				if debugMode {
+					fmt.Fprintf(cmd.ErrOrStderr(), format+"\n", args...)
+				}
+			},
+			orchestrator.WithStatusReporterIncludeInputs(true),
+		)
+		coordinator := orchestrator.NewCoordinator(
+			ctx,
+			taskStore,
+			orchestrator.NewExec(logs, debugMode, commandArgs[0], utils.EnvMapToKVStrings(envVars), commandArgs[1:]...),
+			socketTracker,
+			taskserver.NewTaskServerFactory(),
+			statusReporter,
+		)
+
+		if _, err := coordinator.PopulateTasks(ctx); err != nil {
+			return fmt.Errorf("failed to load tasks: %w", err)
+		}
+
+		report := testharness.NewRunner(coordinator, taskStore, logs, testharness.WithTimeout(timeout)).Run(ctx, suite)
+
+		if junitPath != "" {
+			if err := writeJUnitReport(junitPath, filepath.Base(file), report); err != nil {
+				return err
+			}
+		}
+
+		if _, err := command.NonInteractive(cmd, func() (*testharness.Report, error) {
+			return report, nil
+		}, text.WorkflowTestReport); err != nil {
+			return err
+		}
+
+		if report.Failed > 0 {
+			return fmt.Errorf("%d of %d scenarios failed", report.Failed, len(report.Results))
+		}
+		return nil
+	},
+	Args: cobra.MinimumNArgs(1),
+}
+
+func writeJUnitReport(path, suiteName string, report *testharness.Report) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create JUnit report: %w", err)
+	}
+	if err := testharness.WriteJUnit(f, suiteName, report); err != nil {
+		f.Close()
+		return fmt.Errorf("failed to write JUnit report: %w", err)
+	}
+	return f.Close()
+}
+
+func init() {
+	workflowTestCmd.Flags().StringP("file", "f", "", "Path to a YAML or JSON file of scenarios")
+	workflowTestCmd.Flags().String("junit", "", "Also write results as JUnit XML to this path")
+	workflowTestCmd.Flags().Duration("timeout", time.Minute, "Maximum time a scenario may take, unless it sets its own timeout")
+	workflowTestCmd.Flags().Bool("debug", false, "Print task output and workflow task execution events")
+	workflowTestCmd.Flags().StringSlice("env-file", []string{defaultEnvFile}, "Path to an env file to load into the workflow subprocess. Repeat to load multiple files (later files override earlier ones).")
+	_ = workflowTestCmd.MarkFlagRequired("file")
+	setFlagPlaceholder(workflowTestCmd.Flags(), "file", "PATH")
+	setFlagPlaceholder(workflowTestCmd.Flags(), "junit", "PATH")
+	setFlagPlaceholder(workflowTestCmd.Flags(), "timeout", "DURATION")
+	setFlagPlaceholder(workflowTestCmd.Flags(), "env-file", "PATH")
+	// The args after "--" form a shell command, so keep file completion.
+	workflowTestCmd.CompletionOptions.SetDefaultShellCompDirective(cobra.ShellCompDirectiveDefault)
+
+	WorkflowsCmd.AddCommand(workflowTestCmd)
+}
@@ -5,15 +5,21 @@ import (
 )
 
 var workspaceCmd = &cobra.Command{
-	Use:     "workspace",
-	Short:   "Manage the CLI's active workspace",
-	Long:    `Manage the CLI's active workspace. All CLI commands run against the active workspace.`,
+	Use:   "workspace",
+	Short: "Manage the CLI's active workspace",
+	Long: `Manage the CLI's active workspace. All CLI commands run against the active workspace.
+
+Use the members subcommands to list the workspace's members, change their
+roles, and remove them.`,
 	GroupID: GroupAuth.ID,
 	Example: `  # Show the active workspace
   render workspace current
 
   # Set the active workspace
-  render workspace set ws-abc123`,
+  render workspace set ws-abc123
+
+  # List members of the active workspace
+  render workspace members list`,
 }
 
 func init() {
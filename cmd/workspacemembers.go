@@ -0,0 +1,122 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/spf13/cobra"
+
+	"github.com/render-oss/cli/pkg/client"
+	"github.com/render-oss/cli/pkg/command"
+	"github.com/render-oss/cli/pkg/config"
+	"github.com/render-oss/cli/pkg/dependencies"
+	"github.com/render-oss/cli/pkg/owner"
+	"github.com/render-oss/cli/pkg/text"
+	"github.com/render-oss/cli/pkg/tui"
+	"github.com/render-oss/cli/pkg/tui/views"
+	workspacetypes "github.com/render-oss/cli/pkg/types/workspace"
+)
+
+func newWorkspaceMembersCmd(children ...*cobra.Command) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "members",
+		Aliases: []string{"member"},
+		Short:   "Manage members of the active workspace",
+		Long: `List workspace members, change their roles, and remove them.
+
+Each subcommand that takes a member accepts either their email address or their
+user ID (usr-...).
+
+Roles: ` + strings.Join(workspacetypes.RoleValues(), ", ") + `
+
+Changing roles and removing members requires the admin role.`,
+	}
+	cmd.AddCommand(children...)
+	return cmd
+}
+
+// updateMemberRole changes a member's role and reports the role they had
+// before. A member who already has the role is returned unchanged.
+func updateMemberRole(ctx context.Context, deps *dependencies.Dependencies, idOrEmail string, role client.TeamMemberRole) (*owner.MemberRoleOut, error) {
+	workspaceID, err := config.WorkspaceID()
+	if err != nil {
+		return nil, err
+	}
+	m, err := deps.OwnerRepo().ResolveMember(ctx, workspaceID, idOrEmail)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &owner.MemberRoleOut{Data: m, Meta: owner.MemberRoleOutMeta{PreviousRole: m.Role}}
+	if m.Role == role {
+		return out, nil
+	}
+	updated, err := deps.OwnerRepo().UpdateMemberRole(ctx, workspaceID, m.UserId, role)
+	if err != nil {
+		return nil, err
+	}
+	out.Data = updated
+	return out, nil
+}
+
+func removeMember(ctx context.Context, deps *dependencies.Dependencies, m *client.TeamMember) (*owner.MemberRemoveOut, error) {
+	workspaceID, err := config.WorkspaceID()
+	if err != nil {
+		return nil, err
+	}
+	if err := deps.OwnerRepo().RemoveMember(ctx, workspaceID, m.UserId); err != nil {
+		return nil, err
+	}
+	return &owner.MemberRemoveOut{Data: m, Meta: owner.MemberRemoveOutMeta{Removed: true}}, nil
+}
+
+// commandsForMember lists the palette actions for a member selected in the
+// interactive member list: one per role they don't have, and remove.
+func commandsForMember(deps *dependencies.Dependencies, m *client.TeamMember, updateRoleCmd, removeCmd *cobra.Command) []views.PaletteCommand {
+	var commands []views.PaletteCommand
+	for _, name := range workspacetypes.RoleValues() {
+		role, _ := workspacetypes.ParseRole(name)
+		if role == m.Role {
+			continue
+		}
+		commands = append(commands, views.PaletteCommand{
+			Name:        name,
+			Description: fmt.Sprintf("Change role to %s", name),
+			Action: func(ctx context.Context, _ []string) tea.Cmd {
+				input := workspacetypes.UpdateRoleInput{Member: m.UserId, Role: name}
+				action := command.LoadCmd(ctx, func(ctx context.Context, _ *workspacetypes.UpdateRoleInput) (string, error) {
+					out, err := updateMemberRole(ctx, deps, m.UserId, role)
+					if err != nil {
+						return "", err
+					}
+					return text.WorkspaceMemberRole(out), nil
+				}, &input)
+				return command.AddToStackFunc(ctx, updateRoleCmd, "Change role", &input, tui.NewSimpleModel(action))
+			},
+		})
+	}
+
+	commands = append(commands, views.PaletteCommand{
+		Name:        "remove",
+		Description: "Remove from the workspace",
+		Action: func(ctx context.Context, _ []string) tea.Cmd {
+			input := workspacetypes.RemoveInput{Member: m.UserId}
+			action := command.LoadCmd(ctx, func(ctx context.Context, _ *workspacetypes.RemoveInput) (string, error) {
+				out, err := removeMember(ctx, deps, m)
+				if err != nil {
+					return "", err
+				}
+				return text.WorkspaceMemberRemove(out), nil
+			}, &input)
+			if !command.GetConfirmFromContext(ctx) {
+				action = command.WrapInConfirm(action, func() (string, error) {
+					return fmt.Sprintf("Remove %s from the workspace?", m.Email), nil
+				})
+			}
+			return command.AddToStackFunc(ctx, removeCmd, "Remove member", &input, tui.NewSimpleModel(action))
+		},
+	})
+	return commands
+}
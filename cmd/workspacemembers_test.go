@@ -0,0 +1,127 @@
+package cmd
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	renderapi "github.com/render-oss/cli/internal/fakes/renderapi"
+	"github.com/render-oss/cli/pkg/client"
+	"github.com/render-oss/cli/pkg/dependencies"
+)
+
+func executeWorkspaceMembers(t *testing.T, server *renderapi.Server, args ...string) (CommandResult, error) {
+	t.Helper()
+	return executeInWorkspace(t, server, "", func(root *cobra.Command, deps *dependencies.Dependencies) {
+		workspace := &cobra.Command{Use: "workspace"}
+		root.AddCommand(workspace)
+		setupWorkspaceMemberCommands(workspace, deps)
+	}, append([]string{"workspace", "members"}, args...)...)
+}
+
+func seedMember(server *renderapi.Server, email string, role client.TeamMemberRole) *client.TeamMember {
+	return server.Members.Add(serviceTestWorkspaceID, renderapi.NewMember(client.TeamMember{
+		Name:  "Member " + email,
+		Email: email,
+		Role:  role,
+	}))
+}
+
+func TestWorkspaceMembersList(t *testing.T) {
+	server := renderapi.NewServer(t)
+	seedMember(server, "alice@example.com", client.ADMIN)
+	seedMember(server, "bob@example.com", client.WORKSPACEVIEWER)
+	server.Members.Add("tea-other", renderapi.NewMember(client.TeamMember{Email: "eve@example.com"}))
+
+	result, err := executeWorkspaceMembers(t, server, "list", "--output", "json")
+	require.NoError(t, err, "stderr: %s", result.Stderr)
+
+	var out struct {
+		Data []client.TeamMember `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(result.Stdout), &out))
+	require.Len(t, out.Data, 2)
+	assert.Equal(t, "alice@example.com", out.Data[0].Email)
+
+	result, err = executeWorkspaceMembers(t, server, "list", "--output", "text")
+	require.NoError(t, err)
+	assert.Contains(t, result.Stdout, "viewer")
+	assert.NotContains(t, result.Stdout, "eve@example.com")
+}
+
+func TestWorkspaceMembersUpdateRole(t *testing.T) {
+	t.Run("changes the role by email", func(t *testing.T) {
+		server := renderapi.NewServer(t)
+		bob := seedMember(server, "bob@example.com", client.DEVELOPER)
+
+		result, err := executeWorkspaceMembers(t, server, "update-role", "Bob@Example.com", "viewer")
+		require.NoError(t, err, "stderr: %s", result.Stderr)
+
+		assert.Equal(t, client.WORKSPACEVIEWER, bob.Role)
+		assert.Contains(t, result.Stdout, "Changed role of bob@example.com from developer to viewer")
+	})
+
+	t.Run("skips the API when the role is unchanged", func(t *testing.T) {
+		server := renderapi.NewServer(t)
+		bob := seedMember(server, "bob@example.com", client.ADMIN)
+
+		result, err := executeWorkspaceMembers(t, server, "update-role", bob.UserId, "ADMIN")
+		require.NoError(t, err, "stderr: %s", result.Stderr)
+
+		assert.Contains(t, result.Stdout, "bob@example.com already has role admin")
+		for _, req := range server.Requests {
+			assert.NotEqual(t, "PATCH", req.Method)
+		}
+	})
+
+	t.Run("rejects unknown roles", func(t *testing.T) {
+		server := renderapi.NewServer(t)
+		seedMember(server, "bob@example.com", client.DEVELOPER)
+
+		_, err := executeWorkspaceMembers(t, server, "update-role", "bob@example.com", "owner")
+		require.ErrorContains(t, err, `unknown role "owner"`)
+	})
+
+	t.Run("reports unknown members", func(t *testing.T) {
+		server := renderapi.NewServer(t)
+
+		_, err := executeWorkspaceMembers(t, server, "update-role", "nobody@example.com", "viewer")
+		require.ErrorContains(t, err, "member 'nobody@example.com' not found in the active workspace")
+	})
+}
+
+func TestWorkspaceMembersRemove(t *testing.T) {
+	t.Run("previews without --confirm", func(t *testing.T) {
+		server := renderapi.NewServer(t)
+		seedMember(server, "bob@example.com", client.DEVELOPER)
+
+		result, err := executeWorkspaceMembers(t, server, "remove", "bob@example.com")
+		require.NoError(t, err, "stderr: %s", result.Stderr)
+
+		assert.Contains(t, result.Stdout, "Re-run with --confirm to proceed")
+		assert.Len(t, server.Members.Members[serviceTestWorkspaceID], 1)
+	})
+
+	t.Run("removes with --confirm", func(t *testing.T) {
+		server := renderapi.NewServer(t)
+		seedMember(server, "alice@example.com", client.ADMIN)
+		seedMember(server, "bob@example.com", client.DEVELOPER)
+
+		result, err := executeWorkspaceMembers(t, server, "remove", "bob@example.com", "--confirm", "--output", "json")
+		require.NoError(t, err, "stderr: %s", result.Stderr)
+
+		var out struct {
+			Meta struct {
+				Removed bool `json:"removed"`
+			} `json:"meta"`
+		}
+		require.NoError(t, json.Unmarshal([]byte(result.Stdout), &out))
+		assert.True(t, out.Meta.Removed)
+		members := server.Members.Members[serviceTestWorkspaceID]
+		require.Len(t, members, 1)
+		assert.Equal(t, "alice@example.com", members[0].Email)
+	})
+}
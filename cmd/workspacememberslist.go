@@ -0,0 +1,73 @@
+package cmd
+
+import (
+	"context"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/spf13/cobra"
+
+	"github.com/render-oss/cli/pkg/client"
+	"github.com/render-oss/cli/pkg/command"
+	"github.com/render-oss/cli/pkg/config"
+	"github.com/render-oss/cli/pkg/dependencies"
+	"github.com/render-oss/cli/pkg/owner"
+	"github.com/render-oss/cli/pkg/text"
+	"github.com/render-oss/cli/pkg/tui/views"
+)
+
+// newWorkspaceMembersListCmd takes the update-role and remove commands so the
+// interactive list can offer them as actions on a selected member.
+func newWorkspaceMembersListCmd(deps *dependencies.Dependencies, updateRoleCmd, removeCmd *cobra.Command) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "list",
+		Short:        "List members of the active workspace",
+		Args:         cobra.NoArgs,
+		SilenceUsage: true,
+		Long: `List members of the active workspace with their role, status, and whether
+they have multi-factor authentication enabled.
+
+In interactive mode, select a member to change their role or remove them.`,
+		Example: `  # Browse members interactively
+  render workspace members list
+
+  # JSON output
+  render workspace members list --output json`,
+	}
+
+	loadMembers := func(ctx context.Context, _ views.ListWorkspaceMembersInput) ([]*client.TeamMember, error) {
+		workspaceID, err := config.WorkspaceID()
+		if err != nil {
+			return nil, err
+		}
+		return deps.OwnerRepo().ListMembers(ctx, workspaceID)
+	}
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		var input views.ListWorkspaceMembersInput
+		if err := command.ParseCommand(cmd, args, &input); err != nil {
+			return err
+		}
+
+		if nonInteractive, err := command.NonInteractive(cmd, func() (*owner.MembersOut, error) {
+			members, err := loadMembers(cmd.Context(), input)
+			if err != nil {
+				return nil, err
+			}
+			return &owner.MembersOut{Data: members}, nil
+		}, text.WorkspaceMemberTable); err != nil {
+			return err
+		} else if nonInteractive {
+			return nil
+		}
+
+		ctx := cmd.Context()
+		command.AddToStackFunc(ctx, cmd, "Members", &input, views.NewWorkspaceMemberListView(ctx, input, loadMembers,
+			func(m *client.TeamMember) tea.Cmd {
+				return InteractivePalette(ctx, commandsForMember(deps, m, updateRoleCmd, removeCmd), m.Email)
+			},
+		))
+		return nil
+	}
+
+	return cmd
+}
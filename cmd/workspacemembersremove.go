@@ -0,0 +1,66 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/render-oss/cli/pkg/command"
+	"github.com/render-oss/cli/pkg/config"
+	"github.com/render-oss/cli/pkg/dependencies"
+	"github.com/render-oss/cli/pkg/owner"
+	"github.com/render-oss/cli/pkg/text"
+	workspacetypes "github.com/render-oss/cli/pkg/types/workspace"
+)
+
+func newWorkspaceMembersRemoveCmd(deps *dependencies.Dependencies) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "remove <email|userID>",
+		Aliases:      []string{"rm"},
+		Short:        "Remove a member from the active workspace",
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+		Long: `Remove a member from the active workspace. They immediately lose access to
+the workspace's resources.
+
+Without --confirm, this command previews the removal and makes no changes.
+Pass --confirm to actually remove the member.
+
+This command only runs non-interactively. If --output interactive is requested,
+it falls back to text output.`,
+		Example: `  # Preview removal (no changes made)
+  render workspace members remove alice@example.com
+
+  # Remove the member
+  render workspace members remove alice@example.com --confirm`,
+	}
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		command.DefaultFormatNonInteractive(cmd)
+
+		var input workspacetypes.RemoveInput
+		if err := command.ParseCommand(cmd, args, &input); err != nil {
+			return err
+		}
+		confirm := command.GetConfirmFromContext(cmd.Context())
+
+		_, err := command.NonInteractive(cmd, func() (*owner.MemberRemoveOut, error) {
+			workspaceID, err := config.WorkspaceID()
+			if err != nil {
+				return nil, err
+			}
+			m, err := deps.OwnerRepo().ResolveMember(cmd.Context(), workspaceID, input.Member)
+			if err != nil {
+				return nil, err
+			}
+			if !confirm {
+				return &owner.MemberRemoveOut{
+					Data: m,
+					Meta: owner.MemberRemoveOutMeta{Message: "re-run with --confirm to remove"},
+				}, nil
+			}
+			return removeMember(cmd.Context(), deps, m)
+		}, text.WorkspaceMemberRemove)
+		return err
+	}
+
+	return cmd
+}
@@ -0,0 +1,53 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/render-oss/cli/pkg/command"
+	"github.com/render-oss/cli/pkg/dependencies"
+	"github.com/render-oss/cli/pkg/owner"
+	"github.com/render-oss/cli/pkg/text"
+	workspacetypes "github.com/render-oss/cli/pkg/types/workspace"
+)
+
+func newWorkspaceMembersUpdateRoleCmd(deps *dependencies.Dependencies) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "update-role <email|userID> <role>",
+		Short:        "Change a workspace member's role",
+		Args:         cobra.ExactArgs(2),
+		SilenceUsage: true,
+		Long: `Change the role of a member of the active workspace. The change takes effect
+immediately.
+
+Roles: admin, developer, contributor, viewer, billing. The API names
+(WORKSPACE_VIEWER, etc.) are also accepted.
+
+This command only runs non-interactively. If --output interactive is requested,
+it falls back to text output.`,
+		Example: `  # Make a member a viewer
+  render workspace members update-role alice@example.com viewer
+
+  # Promote by user ID, with JSON output
+  render workspace members update-role usr-abc123 admin --output json`,
+	}
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		command.DefaultFormatNonInteractive(cmd)
+
+		var input workspacetypes.UpdateRoleInput
+		if err := command.ParseCommand(cmd, args, &input); err != nil {
+			return err
+		}
+		role, err := workspacetypes.ParseRole(input.Role)
+		if err != nil {
+			return err
+		}
+
+		_, err = command.NonInteractive(cmd, func() (*owner.MemberRoleOut, error) {
+			return updateMemberRole(cmd.Context(), deps, input.Member, role)
+		}, text.WorkspaceMemberRole)
+		return err
+	}
+
+	return cmd
+}
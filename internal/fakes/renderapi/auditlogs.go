@@ -0,0 +1,125 @@
+package renderapi
+
+import (
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/render-oss/cli/pkg/client"
+)
+
+// AuditLogResource holds audit log entries for the fake server, keyed by the
+// workspace or organization ID they belong to.
+type AuditLogResource struct {
+	Entries    map[string][]client.AuditLog
+	errorQueue []int
+}
+
+// RespondWith queues an HTTP status code to return on the next audit log
+// request handled by the fake server. The queue is drained in FIFO order.
+func (r *AuditLogResource) RespondWith(status int) {
+	r.errorQueue = append(r.errorQueue, status)
+}
+
+func (r *AuditLogResource) nextError() (int, bool) {
+	if len(r.errorQueue) == 0 {
+		return 0, false
+	}
+	status := r.errorQueue[0]
+	r.errorQueue = r.errorQueue[1:]
+	return status, true
+}
+
+// Add stores an entry under the workspace or organization with the given ID.
+func (r *AuditLogResource) Add(scopeID string, entry client.AuditLog) {
+	if r.Entries == nil {
+		r.Entries = map[string][]client.AuditLog{}
+	}
+	r.Entries[scopeID] = append(r.Entries[scopeID], entry)
+}
+
+// page returns entries in the scope within the requested time range, newest
+// first. Cursors are offsets into that ordering.
+func (r *AuditLogResource) page(req *http.Request, scopeID string) ([]client.AuditLogWithCursor, bool) {
+	query := req.URL.Query()
+	var start, end time.Time
+	if v := query.Get("startTime"); v != "" {
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return nil, false
+		}
+		start = t
+	}
+	if v := query.Get("endTime"); v != "" {
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return nil, false
+		}
+		end = t
+	}
+
+	var matching []client.AuditLog
+	for _, entry := range r.Entries[scopeID] {
+		if !start.IsZero() && entry.Timestamp.Before(start) {
+			continue
+		}
+		if !end.IsZero() && entry.Timestamp.After(end) {
+			continue
+		}
+		matching = append(matching, entry)
+	}
+	slices.SortStableFunc(matching, func(a, b client.AuditLog) int {
+		return b.Timestamp.Compare(a.Timestamp)
+	})
+
+	offset := 0
+	if v := query.Get("cursor"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, false
+		}
+		offset = n + 1
+	}
+	limit := 20
+	if v := query.Get("limit"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, false
+		}
+		limit = n
+	}
+
+	result := []client.AuditLogWithCursor{}
+	for i := offset; i < len(matching) && len(result) < limit; i++ {
+		result = append(result, client.AuditLogWithCursor{
+			Cursor:   client.Cursor(strconv.Itoa(i)),
+			AuditLog: matching[i],
+		})
+	}
+	return result, true
+}
+
+func registerAuditLogRoutes(mux *http.ServeMux, s *Server, record func(*http.Request)) {
+	handle := func(pathKey string) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			record(r)
+			if status, hasError := s.AuditLogs.nextError(); hasError {
+				w.WriteHeader(status)
+				return
+			}
+			result, ok := s.AuditLogs.page(r, r.PathValue(pathKey))
+			if !ok {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			writeJSON(w, http.StatusOK, result)
+		}
+	}
+
+	// GET /owners/{ownerId}/audit-logs - list a workspace's audit log
+	mux.HandleFunc("GET /owners/{ownerId}/audit-logs", handle("ownerId"))
+
+	// GET /organizations/{orgId}/audit-logs - list an organization's audit log
+	mux.HandleFunc("GET /organizations/{orgId}/audit-logs", handle("orgId"))
+}
@@ -0,0 +1,262 @@
+package renderapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/render-oss/cli/internal/testids"
+	"github.com/render-oss/cli/pkg/client"
+	disks "github.com/render-oss/cli/pkg/client/disks"
+)
+
+// DiskResource holds persistent disk state and error injection for the fake
+// server. Tests can assert against Instances and Restores.
+type DiskResource struct {
+	Resource[*disks.DiskDetails]
+	// Snapshots holds the snapshots returned for each disk ID.
+	Snapshots map[string][]client.DiskSnapshot
+	// Restores records every snapshot restore request in order.
+	Restores   []DiskRestore
+	errorQueue []int
+}
+
+// DiskRestore is one recorded POST /disks/{id}/snapshots/restore request.
+type DiskRestore struct {
+	DiskID string
+	Body   client.SnapshotRestorePOST
+}
+
+// RespondWith queues an HTTP status code to return on the next disk operation
+// handled by the fake server. The queue is drained in FIFO order.
+func (r *DiskResource) RespondWith(status int) {
+	r.errorQueue = append(r.errorQueue, status)
+}
+
+func (r *DiskResource) nextError() (int, bool) {
+	if len(r.errorQueue) == 0 {
+		return 0, false
+	}
+	status := r.errorQueue[0]
+	r.errorQueue = r.errorQueue[1:]
+	return status, true
+}
+
+// AddSnapshot registers a snapshot for the disk with the given ID.
+func (r *DiskResource) AddSnapshot(diskID string, snapshot client.DiskSnapshot) {
+	if r.Snapshots == nil {
+		r.Snapshots = map[string][]client.DiskSnapshot{}
+	}
+	r.Snapshots[diskID] = append(r.Snapshots[diskID], snapshot)
+}
+
+func (r *DiskResource) byID(id string) (*disks.DiskDetails, bool) {
+	for _, d := range r.Instances {
+		if d.Id == id {
+			return d, true
+		}
+	}
+	return nil, false
+}
+
+// NewDisk returns a DiskDetails with sensible defaults for any zero-value
+// fields.
+func NewDisk(d disks.DiskDetails) *disks.DiskDetails {
+	if d.Id == "" {
+		d.Id = testids.RandomDiskID()
+	}
+	if d.Name == "" {
+		d.Name = "data"
+	}
+	if d.MountPath == "" {
+		d.MountPath = "/var/data"
+	}
+	if d.SizeGB == 0 {
+		d.SizeGB = 10
+	}
+	now := time.Now()
+	if d.CreatedAt.IsZero() {
+		d.CreatedAt = now
+	}
+	if d.UpdatedAt.IsZero() {
+		d.UpdatedAt = now
+	}
+	return &d
+}
+
+// diskOwnerID returns the owner of the service a disk is attached to, since
+// disks do not record an owner themselves.
+func (s *Server) diskOwnerID(d *disks.DiskDetails) string {
+	if d.ServiceId == nil {
+		return ""
+	}
+	svc, ok := s.serviceByID(*d.ServiceId)
+	if !ok {
+		return ""
+	}
+	return svc.OwnerId
+}
+
+func registerDiskRoutes(mux *http.ServeMux, s *Server, record func(*http.Request)) {
+	// GET /disks - list disks (supports ?ownerId=, ?diskId=, ?name=, and ?serviceId= filters)
+	mux.HandleFunc("GET /disks", func(w http.ResponseWriter, r *http.Request) {
+		record(r)
+		if status, hasError := s.Disks.nextError(); hasError {
+			w.WriteHeader(status)
+			return
+		}
+		ownerIDs := queryListValues(r, "ownerId")
+		diskIDs := queryListValues(r, "diskId")
+		names := queryListValues(r, "name")
+		serviceIDs := queryListValues(r, "serviceId")
+		result := make([]client.DiskWithCursor, 0, len(s.Disks.Instances))
+		for i, d := range s.Disks.Instances {
+			if len(ownerIDs) > 0 && !slices.Contains(ownerIDs, s.diskOwnerID(d)) {
+				continue
+			}
+			if len(diskIDs) > 0 && !slices.Contains(diskIDs, d.Id) {
+				continue
+			}
+			if len(names) > 0 && !slices.Contains(names, d.Name) {
+				continue
+			}
+			if len(serviceIDs) > 0 && (d.ServiceId == nil || !slices.Contains(serviceIDs, *d.ServiceId)) {
+				continue
+			}
+			result = append(result, client.DiskWithCursor{
+				Cursor: client.Cursor(fmt.Sprintf("c%d", i)),
+				Disk:   *d,
+			})
+		}
+		writeJSON(w, http.StatusOK, result)
+	})
+
+	// POST /disks - create a disk and attach it to a service
+	mux.HandleFunc("POST /disks", func(w http.ResponseWriter, r *http.Request) {
+		record(r)
+		if status, hasError := s.Disks.nextError(); hasError {
+			w.WriteHeader(status)
+			return
+		}
+		var body disks.DiskPOST
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		d := s.Disks.Add(NewDisk(disks.DiskDetails{
+			Name:      body.Name,
+			MountPath: body.MountPath,
+			SizeGB:    body.SizeGB,
+			ServiceId: &body.ServiceId,
+		}))
+		writeJSON(w, http.StatusCreated, d)
+	})
+
+	// GET /disks/{id} - retrieve a disk
+	mux.HandleFunc("GET /disks/{id}", func(w http.ResponseWriter, r *http.Request) {
+		record(r)
+		if status, hasError := s.Disks.nextError(); hasError {
+			w.WriteHeader(status)
+			return
+		}
+		d, ok := s.Disks.byID(r.PathValue("id"))
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		writeJSON(w, http.StatusOK, d)
+	})
+
+	// PATCH /disks/{id} - update a disk's name, mount path, or size
+	mux.HandleFunc("PATCH /disks/{id}", func(w http.ResponseWriter, r *http.Request) {
+		record(r)
+		if status, hasError := s.Disks.nextError(); hasError {
+			w.WriteHeader(status)
+			return
+		}
+		d, ok := s.Disks.byID(r.PathValue("id"))
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		var body disks.DiskPATCH
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if body.Name != nil {
+			d.Name = *body.Name
+		}
+		if body.MountPath != nil {
+			d.MountPath = *body.MountPath
+		}
+		if body.SizeGB != nil {
+			d.SizeGB = *body.SizeGB
+		}
+		d.UpdatedAt = time.Now()
+		writeJSON(w, http.StatusOK, d)
+	})
+
+	// DELETE /disks/{id} - delete a disk and its snapshots
+	mux.HandleFunc("DELETE /disks/{id}", func(w http.ResponseWriter, r *http.Request) {
+		record(r)
+		if status, hasError := s.Disks.nextError(); hasError {
+			w.WriteHeader(status)
+			return
+		}
+		id := r.PathValue("id")
+		i := slices.IndexFunc(s.Disks.Instances, func(d *disks.DiskDetails) bool {
+			return d.Id == id
+		})
+		if i == -1 {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		s.Disks.Instances = slices.Delete(s.Disks.Instances, i, i+1)
+		delete(s.Disks.Snapshots, id)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	// GET /disks/{id}/snapshots - list a disk's snapshots
+	mux.HandleFunc("GET /disks/{id}/snapshots", func(w http.ResponseWriter, r *http.Request) {
+		record(r)
+		if status, hasError := s.Disks.nextError(); hasError {
+			w.WriteHeader(status)
+			return
+		}
+		id := r.PathValue("id")
+		if _, ok := s.Disks.byID(id); !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		snapshots := s.Disks.Snapshots[id]
+		if snapshots == nil {
+			snapshots = []client.DiskSnapshot{}
+		}
+		// The API documents this endpoint as returning 201.
+		writeJSON(w, http.StatusCreated, snapshots)
+	})
+
+	// POST /disks/{id}/snapshots/restore - restore a disk from a snapshot
+	mux.HandleFunc("POST /disks/{id}/snapshots/restore", func(w http.ResponseWriter, r *http.Request) {
+		record(r)
+		if status, hasError := s.Disks.nextError(); hasError {
+			w.WriteHeader(status)
+			return
+		}
+		d, ok := s.Disks.byID(r.PathValue("id"))
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		var body client.SnapshotRestorePOST
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		s.Disks.Restores = append(s.Disks.Restores, DiskRestore{DiskID: d.Id, Body: body})
+		writeJSON(w, http.StatusOK, d)
+	})
+}
@@ -0,0 +1,310 @@
+package renderapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/render-oss/cli/internal/testids"
+	"github.com/render-oss/cli/pkg/client"
+	envvar "github.com/render-oss/cli/pkg/client/envvar"
+)
+
+// EnvGroupResource holds env-group state and error injection for the fake
+// server. Tests can assert against Instances.
+type EnvGroupResource struct {
+	Resource[*client.EnvGroup]
+	errorQueue []int
+}
+
+// RespondWith queues an HTTP status code to return on the next env-group
+// operation handled by the fake server. The queue is drained in FIFO order.
+func (r *EnvGroupResource) RespondWith(status int) {
+	r.errorQueue = append(r.errorQueue, status)
+}
+
+func (r *EnvGroupResource) nextError() (int, bool) {
+	if len(r.errorQueue) == 0 {
+		return 0, false
+	}
+	status := r.errorQueue[0]
+	r.errorQueue = r.errorQueue[1:]
+	return status, true
+}
+
+func (r *EnvGroupResource) byID(id string) (*client.EnvGroup, bool) {
+	for _, g := range r.Instances {
+		if g.Id == id {
+			return g, true
+		}
+	}
+	return nil, false
+}
+
+// NewEnvGroup returns an EnvGroup with sensible defaults for any zero-value
+// fields.
+func NewEnvGroup(g client.EnvGroup) *client.EnvGroup {
+	if g.Id == "" {
+		g.Id = testids.RandomEnvGroupID()
+	}
+	if g.Name == "" {
+		g.Name = "shared-config"
+	}
+	if g.EnvVars == nil {
+		g.EnvVars = []client.EnvVar{}
+	}
+	if g.SecretFiles == nil {
+		g.SecretFiles = []client.SecretFile{}
+	}
+	if g.ServiceLinks == nil {
+		g.ServiceLinks = []client.EnvGroupLink{}
+	}
+	now := time.Now()
+	if g.CreatedAt.IsZero() {
+		g.CreatedAt = now
+	}
+	if g.UpdatedAt.IsZero() {
+		g.UpdatedAt = now
+	}
+	return &g
+}
+
+func envGroupMeta(g *client.EnvGroup) client.EnvGroupMeta {
+	return client.EnvGroupMeta{
+		CreatedAt:     g.CreatedAt,
+		EnvironmentId: g.EnvironmentId,
+		Id:            g.Id,
+		Name:          g.Name,
+		OwnerId:       g.OwnerId,
+		ServiceLinks:  g.ServiceLinks,
+		UpdatedAt:     g.UpdatedAt,
+	}
+}
+
+func (s *Server) envGroupLink(serviceID string) client.EnvGroupLink {
+	for _, svc := range s.Services.Instances {
+		if svc.Id == serviceID {
+			return client.EnvGroupLink{Id: svc.Id, Name: svc.Name, Type: client.ServiceTypeShort(svc.Type)}
+		}
+	}
+	return client.EnvGroupLink{Id: serviceID}
+}
+
+func registerEnvGroupRoutes(mux *http.ServeMux, s *Server, record func(*http.Request)) {
+	// GET /env-groups - list env groups (supports ?name=, ?ownerId=, and ?environmentId= filters)
+	mux.HandleFunc("GET /env-groups", func(w http.ResponseWriter, r *http.Request) {
+		record(r)
+		if status, hasError := s.EnvGroups.nextError(); hasError {
+			w.WriteHeader(status)
+			return
+		}
+		names := queryListValues(r, "name")
+		ownerIDs := queryListValues(r, "ownerId")
+		envIDs := queryListValues(r, "environmentId")
+		result := make([]client.EnvGroupMeta, 0, len(s.EnvGroups.Instances))
+		for _, g := range s.EnvGroups.Instances {
+			if len(names) > 0 && !slices.Contains(names, g.Name) {
+				continue
+			}
+			if len(ownerIDs) > 0 && !slices.Contains(ownerIDs, g.OwnerId) {
+				continue
+			}
+			if len(envIDs) > 0 && (g.EnvironmentId == nil || !slices.Contains(envIDs, *g.EnvironmentId)) {
+				continue
+			}
+			result = append(result, envGroupMeta(g))
+		}
+		writeJSON(w, http.StatusOK, result)
+	})
+
+	// POST /env-groups - create an env group
+	mux.HandleFunc("POST /env-groups", func(w http.ResponseWriter, r *http.Request) {
+		record(r)
+		if status, hasError := s.EnvGroups.nextError(); hasError {
+			w.WriteHeader(status)
+			return
+		}
+		var body struct {
+			Name          string                    `json:"name"`
+			OwnerId       string                    `json:"ownerId"`
+			EnvironmentId *string                   `json:"environmentId"`
+			EnvVars       []client.EnvVar           `json:"envVars"`
+			SecretFiles   *[]envvar.SecretFileInput `json:"secretFiles"`
+			ServiceIds    *[]string                 `json:"serviceIds"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		g := NewEnvGroup(client.EnvGroup{
+			Name:          body.Name,
+			OwnerId:       body.OwnerId,
+			EnvironmentId: body.EnvironmentId,
+			EnvVars:       body.EnvVars,
+		})
+		if body.SecretFiles != nil {
+			for _, f := range *body.SecretFiles {
+				g.SecretFiles = append(g.SecretFiles, client.SecretFile{Name: f.Name, Content: f.Content})
+			}
+		}
+		if body.ServiceIds != nil {
+			for _, id := range *body.ServiceIds {
+				g.ServiceLinks = append(g.ServiceLinks, s.envGroupLink(id))
+			}
+		}
+		s.EnvGroups.Add(g)
+		writeJSON(w, http.StatusCreated, g)
+	})
+
+	// GET /env-groups/{id} - retrieve an env group
+	mux.HandleFunc("GET /env-groups/{id}", func(w http.ResponseWriter, r *http.Request) {
+		record(r)
+		if status, hasError := s.EnvGroups.nextError(); hasError {
+			w.WriteHeader(status)
+			return
+		}
+		g, ok := s.EnvGroups.byID(r.PathValue("id"))
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		writeJSON(w, http.StatusOK, g)
+	})
+
+	// DELETE /env-groups/{id} - delete an env group
+	mux.HandleFunc("DELETE /env-groups/{id}", func(w http.ResponseWriter, r *http.Request) {
+		record(r)
+		if status, hasError := s.EnvGroups.nextError(); hasError {
+			w.WriteHeader(status)
+			return
+		}
+		i := slices.IndexFunc(s.EnvGroups.Instances, func(g *client.EnvGroup) bool {
+			return g.Id == r.PathValue("id")
+		})
+		if i == -1 {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		s.EnvGroups.Instances = slices.Delete(s.EnvGroups.Instances, i, i+1)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	// PUT /env-groups/{id}/env-vars/{key} - add or update one variable
+	mux.HandleFunc("PUT /env-groups/{id}/env-vars/{key}", func(w http.ResponseWriter, r *http.Request) {
+		record(r)
+		if status, hasError := s.EnvGroups.nextError(); hasError {
+			w.WriteHeader(status)
+			return
+		}
+		var body client.EnvVarValue
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		g, ok := s.EnvGroups.byID(r.PathValue("id"))
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		key := r.PathValue("key")
+		updated := client.EnvVar{Key: key, Value: body.Value}
+		if i := slices.IndexFunc(g.EnvVars, func(ev client.EnvVar) bool { return ev.Key == key }); i >= 0 {
+			g.EnvVars[i] = updated
+		} else {
+			g.EnvVars = append(g.EnvVars, updated)
+		}
+		g.UpdatedAt = time.Now()
+		writeJSON(w, http.StatusOK, g)
+	})
+
+	// DELETE /env-groups/{id}/env-vars/{key} - remove one variable
+	mux.HandleFunc("DELETE /env-groups/{id}/env-vars/{key}", func(w http.ResponseWriter, r *http.Request) {
+		record(r)
+		if status, hasError := s.EnvGroups.nextError(); hasError {
+			w.WriteHeader(status)
+			return
+		}
+		g, ok := s.EnvGroups.byID(r.PathValue("id"))
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		i := slices.IndexFunc(g.EnvVars, func(ev client.EnvVar) bool { return ev.Key == r.PathValue("key") })
+		if i == -1 {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		g.EnvVars = slices.Delete(g.EnvVars, i, i+1)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	// PUT /env-groups/{id}/secret-files/{name} - add or replace one secret file
+	mux.HandleFunc("PUT /env-groups/{id}/secret-files/{name}", func(w http.ResponseWriter, r *http.Request) {
+		record(r)
+		if status, hasError := s.EnvGroups.nextError(); hasError {
+			w.WriteHeader(status)
+			return
+		}
+		var body client.UpdateEnvGroupSecretFileJSONRequestBody
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		g, ok := s.EnvGroups.byID(r.PathValue("id"))
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		file := client.SecretFile{Name: r.PathValue("name")}
+		if body.Content != nil {
+			file.Content = *body.Content
+		}
+		if i := slices.IndexFunc(g.SecretFiles, func(f client.SecretFile) bool { return f.Name == file.Name }); i >= 0 {
+			g.SecretFiles[i] = file
+		} else {
+			g.SecretFiles = append(g.SecretFiles, file)
+		}
+		writeJSON(w, http.StatusOK, g)
+	})
+
+	// POST /env-groups/{id}/services/{serviceId} - link a service
+	mux.HandleFunc("POST /env-groups/{id}/services/{serviceId}", func(w http.ResponseWriter, r *http.Request) {
+		record(r)
+		if status, hasError := s.EnvGroups.nextError(); hasError {
+			w.WriteHeader(status)
+			return
+		}
+		g, ok := s.EnvGroups.byID(r.PathValue("id"))
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		serviceID := r.PathValue("serviceId")
+		if !slices.ContainsFunc(g.ServiceLinks, func(l client.EnvGroupLink) bool { return l.Id == serviceID }) {
+			g.ServiceLinks = append(g.ServiceLinks, s.envGroupLink(serviceID))
+		}
+		writeJSON(w, http.StatusOK, g)
+	})
+
+	// DELETE /env-groups/{id}/services/{serviceId} - unlink a service
+	mux.HandleFunc("DELETE /env-groups/{id}/services/{serviceId}", func(w http.ResponseWriter, r *http.Request) {
+		record(r)
+		if status, hasError := s.EnvGroups.nextError(); hasError {
+			w.WriteHeader(status)
+			return
+		}
+		g, ok := s.EnvGroups.byID(r.PathValue("id"))
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		i := slices.IndexFunc(g.ServiceLinks, func(l client.EnvGroupLink) bool { return l.Id == r.PathValue("serviceId") })
+		if i == -1 {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		g.ServiceLinks = slices.Delete(g.ServiceLinks, i, i+1)
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
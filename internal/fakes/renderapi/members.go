@@ -0,0 +1,118 @@
+package renderapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"slices"
+
+	"github.com/render-oss/cli/pkg/client"
+)
+
+// MemberResource holds workspace members for the fake server, keyed by
+// workspace ID.
+type MemberResource struct {
+	Members    map[string][]*client.TeamMember
+	errorQueue []int
+}
+
+// RespondWith queues an HTTP status code to return on the next member
+// operation handled by the fake server. The queue is drained in FIFO order.
+func (r *MemberResource) RespondWith(status int) {
+	r.errorQueue = append(r.errorQueue, status)
+}
+
+func (r *MemberResource) nextError() (int, bool) {
+	if len(r.errorQueue) == 0 {
+		return 0, false
+	}
+	status := r.errorQueue[0]
+	r.errorQueue = r.errorQueue[1:]
+	return status, true
+}
+
+// Add stores a member of the workspace with the given ID and returns it.
+func (r *MemberResource) Add(workspaceID string, m *client.TeamMember) *client.TeamMember {
+	if r.Members == nil {
+		r.Members = map[string][]*client.TeamMember{}
+	}
+	r.Members[workspaceID] = append(r.Members[workspaceID], m)
+	return m
+}
+
+func (r *MemberResource) index(workspaceID, userID string) int {
+	return slices.IndexFunc(r.Members[workspaceID], func(m *client.TeamMember) bool {
+		return m.UserId == userID
+	})
+}
+
+// NewMember returns a TeamMember with sensible defaults for any zero-value
+// fields.
+func NewMember(m client.TeamMember) *client.TeamMember {
+	if m.UserId == "" {
+		m.UserId = "usr-" + m.Email
+	}
+	if m.Role == "" {
+		m.Role = client.DEVELOPER
+	}
+	if m.Status == "" {
+		m.Status = client.Active
+	}
+	return &m
+}
+
+func registerMemberRoutes(mux *http.ServeMux, s *Server, record func(*http.Request)) {
+	// GET /owners/{ownerId}/members - list workspace members
+	mux.HandleFunc("GET /owners/{ownerId}/members", func(w http.ResponseWriter, r *http.Request) {
+		record(r)
+		if status, hasError := s.Members.nextError(); hasError {
+			w.WriteHeader(status)
+			return
+		}
+		members := s.Members.Members[r.PathValue("ownerId")]
+		result := make([]client.TeamMember, 0, len(members))
+		for _, m := range members {
+			result = append(result, *m)
+		}
+		writeJSON(w, http.StatusOK, result)
+	})
+
+	// PATCH /owners/{ownerId}/members/{userId} - change a member's role
+	mux.HandleFunc("PATCH /owners/{ownerId}/members/{userId}", func(w http.ResponseWriter, r *http.Request) {
+		record(r)
+		if status, hasError := s.Members.nextError(); hasError {
+			w.WriteHeader(status)
+			return
+		}
+		workspaceID := r.PathValue("ownerId")
+		i := s.Members.index(workspaceID, r.PathValue("userId"))
+		if i == -1 {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		var body client.UpdateWorkspaceMemberJSONRequestBody
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		m := s.Members.Members[workspaceID][i]
+		m.Role = body.Role
+		writeJSON(w, http.StatusOK, m)
+	})
+
+	// DELETE /owners/{ownerId}/members/{userId} - remove a member
+	mux.HandleFunc("DELETE /owners/{ownerId}/members/{userId}", func(w http.ResponseWriter, r *http.Request) {
+		record(r)
+		if status, hasError := s.Members.nextError(); hasError {
+			w.WriteHeader(status)
+			return
+		}
+		workspaceID := r.PathValue("ownerId")
+		i := s.Members.index(workspaceID, r.PathValue("userId"))
+		if i == -1 {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		s.Members.Members[workspaceID] = slices.Delete(s.Members.Members[workspaceID], i, i+1)
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
@@ -0,0 +1,56 @@
+package renderapi
+
+import (
+	"net/http"
+
+	metricsclient "github.com/render-oss/cli/pkg/client/metrics"
+)
+
+// MetricsResource holds seeded time series for the fake server, keyed by
+// metric path segment (for example "cpu" or "http-latency") and resource ID.
+type MetricsResource struct {
+	series     map[string]map[string][]metricsclient.TimeSeries
+	errorQueue []int
+}
+
+// Set replaces the time series returned for metric on resourceID.
+func (r *MetricsResource) Set(metric, resourceID string, series ...metricsclient.TimeSeries) {
+	if r.series == nil {
+		r.series = map[string]map[string][]metricsclient.TimeSeries{}
+	}
+	if r.series[metric] == nil {
+		r.series[metric] = map[string][]metricsclient.TimeSeries{}
+	}
+	r.series[metric][resourceID] = series
+}
+
+// RespondWith queues an HTTP status code to return on the next metrics
+// request handled by the fake server. The queue is drained in FIFO order.
+func (r *MetricsResource) RespondWith(status int) {
+	r.errorQueue = append(r.errorQueue, status)
+}
+
+func (r *MetricsResource) nextError() (int, bool) {
+	if len(r.errorQueue) == 0 {
+		return 0, false
+	}
+	status := r.errorQueue[0]
+	r.errorQueue = r.errorQueue[1:]
+	return status, true
+}
+
+func registerMetricsRoutes(mux *http.ServeMux, s *Server, record func(*http.Request)) {
+	// GET /metrics/{metric} - time series for the resources in ?resource=
+	mux.HandleFunc("GET /metrics/{metric}", func(w http.ResponseWriter, r *http.Request) {
+		record(r)
+		if status, hasError := s.Metrics.nextError(); hasError {
+			w.WriteHeader(status)
+			return
+		}
+		result := []metricsclient.TimeSeries{}
+		for _, id := range queryListValues(r, "resource") {
+			result = append(result, s.Metrics.series[r.PathValue("metric")][id]...)
+		}
+		writeJSON(w, http.StatusOK, result)
+	})
+}
@@ -0,0 +1,159 @@
+package renderapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/render-oss/cli/internal/testids"
+	"github.com/render-oss/cli/pkg/client"
+	pgclient "github.com/render-oss/cli/pkg/client/postgres"
+	"github.com/render-oss/cli/pkg/pointers"
+)
+
+// PostgresRecovery is one recorded POST /postgres/{id}/recovery request.
+type PostgresRecovery struct {
+	PostgresID string
+	Body       client.RecoverPostgresJSONRequestBody
+}
+
+// AddExport registers an export for the database with the given ID. Exports
+// with a URL are downloadable; pass ExportURL to get one served by the fake.
+func (pg *PostgresResource) AddExport(postgresID string, export pgclient.PostgresExport) pgclient.PostgresExport {
+	if export.Id == "" {
+		export.Id = fmt.Sprintf("exp-%d", len(pg.Exports[postgresID])+1)
+	}
+	if export.CreatedAt.IsZero() {
+		export.CreatedAt = time.Now().UTC()
+	}
+	if pg.Exports == nil {
+		pg.Exports = map[string][]pgclient.PostgresExport{}
+	}
+	pg.Exports[postgresID] = append(pg.Exports[postgresID], export)
+	return export
+}
+
+// ExportURL returns a download URL for an export that the fake server serves
+// with ExportContents(exportID) as its body.
+func (s *Server) ExportURL(exportID string) *string {
+	return pointers.From(fmt.Sprintf("%s/fake-exports/%s/export.dir.tar.gz", s.URL(), exportID))
+}
+
+// ExportContents is the body the fake server serves for an export's URL.
+func ExportContents(exportID string) string {
+	return "fake export " + exportID
+}
+
+// SetRecoveryInfo overrides the recovery info reported for a database. By
+// default recovery is available for the last seven days.
+func (pg *PostgresResource) SetRecoveryInfo(postgresID string, info pgclient.RecoveryInfo) {
+	if pg.RecoveryInfo == nil {
+		pg.RecoveryInfo = map[string]pgclient.RecoveryInfo{}
+	}
+	pg.RecoveryInfo[postgresID] = info
+}
+
+func registerPostgresBackupRoutes(mux *http.ServeMux, s *Server, record func(*http.Request)) {
+	// GET /postgres/{id}/export - list a database's exports
+	mux.HandleFunc("GET /postgres/{id}/export", func(w http.ResponseWriter, r *http.Request) {
+		record(r)
+		if status, hasError := s.Postgres.nextError(); hasError {
+			w.WriteHeader(status)
+			return
+		}
+		pg, ok := s.Postgres.byID(r.PathValue("id"))
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		exports := s.Postgres.Exports[pg.Id]
+		if exports == nil {
+			exports = []pgclient.PostgresExport{}
+		}
+		writeJSON(w, http.StatusOK, exports)
+	})
+
+	// POST /postgres/{id}/export - start an export, which the fake finishes
+	// immediately
+	mux.HandleFunc("POST /postgres/{id}/export", func(w http.ResponseWriter, r *http.Request) {
+		record(r)
+		if status, hasError := s.Postgres.nextError(); hasError {
+			w.WriteHeader(status)
+			return
+		}
+		pg, ok := s.Postgres.byID(r.PathValue("id"))
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		id := fmt.Sprintf("exp-%d", len(s.Postgres.Exports[pg.Id])+1)
+		s.Postgres.AddExport(pg.Id, pgclient.PostgresExport{Id: id, Url: s.ExportURL(id)})
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	// GET /fake-exports/{exportID}/{file} - serve an export's contents
+	mux.HandleFunc("GET /fake-exports/{exportID}/{file}", func(w http.ResponseWriter, r *http.Request) {
+		record(r)
+		_, _ = w.Write([]byte(ExportContents(r.PathValue("exportID"))))
+	})
+
+	// GET /postgres/{id}/recovery - report the point-in-time recovery window
+	mux.HandleFunc("GET /postgres/{id}/recovery", func(w http.ResponseWriter, r *http.Request) {
+		record(r)
+		if status, hasError := s.Postgres.nextError(); hasError {
+			w.WriteHeader(status)
+			return
+		}
+		pg, ok := s.Postgres.byID(r.PathValue("id"))
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		info, ok := s.Postgres.RecoveryInfo[pg.Id]
+		if !ok {
+			info = pgclient.RecoveryInfo{
+				RecoveryStatus: pgclient.AVAILABLE,
+				StartsAt:       pointers.From(time.Now().Add(-7 * 24 * time.Hour).UTC()),
+			}
+		}
+		writeJSON(w, http.StatusOK, info)
+	})
+
+	// POST /postgres/{id}/recovery - restore a database to a point in time as
+	// a new database
+	mux.HandleFunc("POST /postgres/{id}/recovery", func(w http.ResponseWriter, r *http.Request) {
+		record(r)
+		if status, hasError := s.Postgres.nextError(); hasError {
+			w.WriteHeader(status)
+			return
+		}
+		pg, ok := s.Postgres.byID(r.PathValue("id"))
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		var body client.RecoverPostgresJSONRequestBody
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		s.Postgres.Recoveries = append(s.Postgres.Recoveries, PostgresRecovery{PostgresID: pg.Id, Body: body})
+
+		restored := NewPostgres(client.PostgresDetail{
+			Id:            testids.RandomPostgresID(),
+			Name:          pointers.StringValue(body.RestoreName),
+			Owner:         pg.Owner,
+			EnvironmentId: pg.EnvironmentId,
+			Plan:          pg.Plan,
+			Region:        pg.Region,
+			Version:       pg.Version,
+			Status:        client.DatabaseStatusCreating,
+		})
+		if body.Plan != nil {
+			restored.Plan = pgclient.PostgresPlans(*body.Plan)
+		}
+		s.Postgres.Add(restored)
+		writeJSON(w, http.StatusOK, s.postgresDetailResponse(restored))
+	})
+}
@@ -0,0 +1,112 @@
+package renderapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/render-oss/cli/pkg/client"
+	pgclient "github.com/render-oss/cli/pkg/client/postgres"
+	"github.com/render-oss/cli/pkg/pointers"
+)
+
+// PostgresHAOperation is one recorded failover, promote, or replication
+// setup request. Replication is only set for replication setups.
+type PostgresHAOperation struct {
+	PostgresID  string
+	Operation   string
+	Replication *pgclient.PostgresReplicationSetupInput
+}
+
+// startHAOperation puts db into StatusDuringHAOperation until its next read,
+// after which it reports StatusAfterHAOperation.
+func (pg *PostgresResource) startHAOperation(db *client.PostgresDetail) {
+	db.Status = client.DatabaseStatusUpdatingInstance
+	if pg.StatusDuringHAOperation != "" {
+		db.Status = pg.StatusDuringHAOperation
+	}
+	after := client.DatabaseStatusAvailable
+	if pg.StatusAfterHAOperation != "" {
+		after = pg.StatusAfterHAOperation
+	}
+	if pg.settlingStatus == nil {
+		pg.settlingStatus = map[string]client.DatabaseStatus{}
+	}
+	pg.settlingStatus[db.Id] = after
+	db.UpdatedAt = time.Now()
+}
+
+// settleHAOperation moves db to the status it settles on after an HA
+// operation, once the status it reports during the operation has been read.
+func (pg *PostgresResource) settleHAOperation(db *client.PostgresDetail) {
+	if status, ok := pg.settlingStatus[db.Id]; ok {
+		db.Status = status
+		delete(pg.settlingStatus, db.Id)
+	}
+}
+
+func registerPostgresHARoutes(mux *http.ServeMux, s *Server, record func(*http.Request)) {
+	// POST /postgres/{id}/failover - fail over to the standby
+	mux.HandleFunc("POST /postgres/{id}/failover", func(w http.ResponseWriter, r *http.Request) {
+		record(r)
+		if status, hasError := s.Postgres.nextError(); hasError {
+			w.WriteHeader(status)
+			return
+		}
+		pg, ok := s.Postgres.byID(r.PathValue("id"))
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		s.Postgres.HAOperations = append(s.Postgres.HAOperations, PostgresHAOperation{PostgresID: pg.Id, Operation: "failover"})
+		s.Postgres.startHAOperation(pg)
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	// POST /postgres/{id}/promote - promote a read replica to a primary
+	mux.HandleFunc("POST /postgres/{id}/promote", func(w http.ResponseWriter, r *http.Request) {
+		record(r)
+		if status, hasError := s.Postgres.nextError(); hasError {
+			w.WriteHeader(status)
+			return
+		}
+		pg, ok := s.Postgres.byID(r.PathValue("id"))
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if pg.Role != client.Replica {
+			writeJSON(w, http.StatusBadRequest, map[string]string{"message": "only read replicas can be promoted"})
+			return
+		}
+		s.Postgres.HAOperations = append(s.Postgres.HAOperations, PostgresHAOperation{PostgresID: pg.Id, Operation: "promote"})
+		pg.Role = client.Primary
+		pg.PrimaryPostgresID = nil
+		s.Postgres.startHAOperation(pg)
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	// POST /postgres/{id}/replication - set up logical replication
+	mux.HandleFunc("POST /postgres/{id}/replication", func(w http.ResponseWriter, r *http.Request) {
+		record(r)
+		if status, hasError := s.Postgres.nextError(); hasError {
+			w.WriteHeader(status)
+			return
+		}
+		pg, ok := s.Postgres.byID(r.PathValue("id"))
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		var body pgclient.PostgresReplicationSetupInput
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil || body.DatabaseName == "" || body.SchemaName == "" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		s.Postgres.HAOperations = append(s.Postgres.HAOperations, PostgresHAOperation{PostgresID: pg.Id, Operation: "replicate", Replication: &body})
+		if pointers.ValueOrDefault(body.RestartNow, false) {
+			s.Postgres.startHAOperation(pg)
+		}
+		writeJSON(w, http.StatusCreated, map[string]any{})
+	})
+}
@@ -0,0 +1,67 @@
+package renderapi
+
+import (
+	"net/http"
+
+	pgclient "github.com/render-oss/cli/pkg/client/postgres"
+)
+
+// PostgresInsights is the statistics the fake server reports for a database's
+// /query endpoints.
+type PostgresInsights struct {
+	TopQueries []pgclient.PostgresQueryStatistic
+	TableScans []pgclient.PostgresTableScan
+	Processes  []pgclient.PostgresProcess
+	Sizes      []pgclient.PostgresSize
+}
+
+// SetInsights sets the statistics reported for a database. Databases without
+// insights report empty lists.
+func (pg *PostgresResource) SetInsights(postgresID string, insights PostgresInsights) {
+	if pg.Insights == nil {
+		pg.Insights = map[string]PostgresInsights{}
+	}
+	pg.Insights[postgresID] = insights
+}
+
+func registerPostgresInsightsRoutes(mux *http.ServeMux, s *Server, record func(*http.Request)) {
+	handle := func(pattern string, result func(PostgresInsights) any) {
+		mux.HandleFunc(pattern, func(w http.ResponseWriter, r *http.Request) {
+			record(r)
+			if status, hasError := s.Postgres.nextError(); hasError {
+				w.WriteHeader(status)
+				return
+			}
+			pg, ok := s.Postgres.byID(r.PathValue("id"))
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			writeJSON(w, http.StatusOK, result(s.Postgres.Insights[pg.Id]))
+		})
+	}
+
+	// GET /postgres/{id}/query/top-queries - queries by total execution time
+	handle("GET /postgres/{id}/query/top-queries", func(in PostgresInsights) any {
+		return pgclient.PostgresTopQueriesResult{TopQueries: nonNil(in.TopQueries)}
+	})
+	// GET /postgres/{id}/query/table-scans - tables read by sequential scans
+	handle("GET /postgres/{id}/query/table-scans", func(in PostgresInsights) any {
+		return pgclient.PostgresTableScansResult{TableScans: nonNil(in.TableScans)}
+	})
+	// GET /postgres/{id}/query/processes - active backend processes
+	handle("GET /postgres/{id}/query/processes", func(in PostgresInsights) any {
+		return pgclient.PostgresProcessesResult{Processes: nonNil(in.Processes)}
+	})
+	// GET /postgres/{id}/query/sizes - table and index sizes
+	handle("GET /postgres/{id}/query/sizes", func(in PostgresInsights) any {
+		return pgclient.PostgresSizesResult{Sizes: nonNil(in.Sizes)}
+	})
+}
+
+func nonNil[T any](items []T) []T {
+	if items == nil {
+		return []T{}
+	}
+	return items
+}
@@ -0,0 +1,144 @@
+package renderapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+
+	"github.com/render-oss/cli/pkg/client"
+	pgclient "github.com/render-oss/cli/pkg/client/postgres"
+	"github.com/render-oss/cli/pkg/pointers"
+)
+
+// DefaultAvailableParameters is the parameters the fake server accepts
+// overrides for unless PostgresResource.AvailableParameters is set.
+var DefaultAvailableParameters = []pgclient.PostgresAvailableParameterOverride{
+	{
+		Name:        "work_mem",
+		Description: "Memory used by internal sort operations and hash tables.",
+		Examples:    &[]string{"4MB", "64MB"},
+		MinValue:    pointers.From(float32(64)),
+		MaxValue:    pointers.From(float32(2147483647)),
+	},
+	{
+		Name:            "max_connections",
+		Description:     "Maximum number of concurrent connections.",
+		MinValue:        pointers.From(float32(1)),
+		MaxValue:        pointers.From(float32(1000)),
+		RequiresRestart: true,
+	},
+	{
+		Name:        "log_min_duration_statement",
+		Description: "Logs statements that run for at least this long.",
+		Examples:    &[]string{"-1", "250ms"},
+		MinValue:    pointers.From(float32(-1)),
+	},
+	{
+		Name:        "default_transaction_isolation",
+		Description: "Isolation level of each new transaction.",
+		Examples:    &[]string{"read committed", "serializable"},
+	},
+}
+
+// SetParameterOverrides sets the parameter overrides of a database.
+func (pg *PostgresResource) SetParameterOverrides(postgresID string, overrides client.PostgresParameterOverrides) {
+	if pg.ParameterOverrides == nil {
+		pg.ParameterOverrides = map[string]client.PostgresParameterOverrides{}
+	}
+	pg.ParameterOverrides[postgresID] = overrides
+}
+
+func (pg *PostgresResource) availableParameters() []pgclient.PostgresAvailableParameterOverride {
+	if pg.AvailableParameters != nil {
+		return pg.AvailableParameters
+	}
+	return DefaultAvailableParameters
+}
+
+func registerPostgresParamsRoutes(mux *http.ServeMux, s *Server, record func(*http.Request)) {
+	// GET /postgres/{id}/parameter-overrides - list a database's overrides
+	mux.HandleFunc("GET /postgres/{id}/parameter-overrides", func(w http.ResponseWriter, r *http.Request) {
+		record(r)
+		if status, hasError := s.Postgres.nextError(); hasError {
+			w.WriteHeader(status)
+			return
+		}
+		pg, ok := s.Postgres.byID(r.PathValue("id"))
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		overrides := s.Postgres.ParameterOverrides[pg.Id]
+		if overrides == nil {
+			overrides = client.PostgresParameterOverrides{}
+		}
+		writeJSON(w, http.StatusOK, client.PostgresParameterOverridesWrapper{ParameterOverrides: overrides})
+	})
+
+	// PUT /postgres/{id}/parameter-overrides - replace a database's overrides
+	mux.HandleFunc("PUT /postgres/{id}/parameter-overrides", func(w http.ResponseWriter, r *http.Request) {
+		record(r)
+		if status, hasError := s.Postgres.nextError(); hasError {
+			w.WriteHeader(status)
+			return
+		}
+		pg, ok := s.Postgres.byID(r.PathValue("id"))
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		var body client.PostgresParameterOverridesWrapper
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		for name := range body.ParameterOverrides {
+			if !slices.ContainsFunc(s.Postgres.availableParameters(), func(p pgclient.PostgresAvailableParameterOverride) bool {
+				return p.Name == name
+			}) {
+				writeJSON(w, http.StatusBadRequest, map[string]string{"message": fmt.Sprintf("parameter %s can't be overridden", name)})
+				return
+			}
+		}
+
+		before := s.Postgres.ParameterOverrides[pg.Id]
+		requiresRestart := false
+		for _, param := range s.Postgres.availableParameters() {
+			changed := before[param.Name] != body.ParameterOverrides[param.Name]
+			if changed && param.RequiresRestart {
+				requiresRestart = true
+			}
+		}
+
+		s.Postgres.SetParameterOverrides(pg.Id, maps.Clone(body.ParameterOverrides))
+		affected := []string{pg.Id}
+		for _, replica := range pg.ReadReplicas {
+			affected = append(affected, replica.Id)
+		}
+		writeJSON(w, http.StatusOK, client.PostgresPutParameterOverridesResult{
+			AffectedDatabases: affected,
+			AppliedOverrides:  body.ParameterOverrides,
+			RequiresRestart:   requiresRestart,
+		})
+	})
+
+	// GET /postgres/{id}/parameter-overrides/available - list overridable
+	// parameters
+	mux.HandleFunc("GET /postgres/{id}/parameter-overrides/available", func(w http.ResponseWriter, r *http.Request) {
+		record(r)
+		if status, hasError := s.Postgres.nextError(); hasError {
+			w.WriteHeader(status)
+			return
+		}
+		if _, ok := s.Postgres.byID(r.PathValue("id")); !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		writeJSON(w, http.StatusOK, pgclient.PostgresAvailableParameterOverridesResult{
+			AvailableParameterOverrides: s.Postgres.availableParameters(),
+		})
+	})
+}
@@ -0,0 +1,109 @@
+package renderapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"slices"
+
+	"github.com/render-oss/cli/pkg/client"
+)
+
+// postgresUser is the wire shape of one item in GET /postgres/{id}/credentials.
+type postgresUser struct {
+	Username        string `json:"username"`
+	Default         bool   `json:"default"`
+	OpenConnections int    `json:"openConnections"`
+	CreatedAt       string `json:"createdAt"`
+}
+
+// AddUser registers a non-default user on the database with the given ID.
+func (pg *PostgresResource) AddUser(postgresID, username string) {
+	if pg.Users == nil {
+		pg.Users = map[string][]string{}
+	}
+	pg.Users[postgresID] = append(pg.Users[postgresID], username)
+}
+
+func (pg *PostgresResource) byID(id string) (*client.PostgresDetail, bool) {
+	for _, p := range pg.Instances {
+		if p.Id == id {
+			return p, true
+		}
+	}
+	return nil, false
+}
+
+func registerPostgresUserRoutes(mux *http.ServeMux, s *Server, record func(*http.Request)) {
+	// GET /postgres/{id}/credentials - list users, default user first
+	mux.HandleFunc("GET /postgres/{id}/credentials", func(w http.ResponseWriter, r *http.Request) {
+		record(r)
+		if status, hasError := s.Postgres.nextError(); hasError {
+			w.WriteHeader(status)
+			return
+		}
+		pg, ok := s.Postgres.byID(r.PathValue("id"))
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		createdAt := pg.CreatedAt.UTC().Format("2006-01-02T15:04:05Z")
+		users := []postgresUser{{Username: pg.DatabaseUser, Default: true, CreatedAt: createdAt}}
+		for _, username := range s.Postgres.Users[pg.Id] {
+			users = append(users, postgresUser{Username: username, CreatedAt: createdAt})
+		}
+		writeJSON(w, http.StatusOK, users)
+	})
+
+	// POST /postgres/{id}/credentials - create a user, which becomes the
+	// database's default user
+	mux.HandleFunc("POST /postgres/{id}/credentials", func(w http.ResponseWriter, r *http.Request) {
+		record(r)
+		if status, hasError := s.Postgres.nextError(); hasError {
+			w.WriteHeader(status)
+			return
+		}
+		pg, ok := s.Postgres.byID(r.PathValue("id"))
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		var body client.CreatePostgresUserJSONRequestBody
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil || body.Username == "" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if body.Username == pg.DatabaseUser || slices.Contains(s.Postgres.Users[pg.Id], body.Username) {
+			writeJSON(w, http.StatusConflict, map[string]string{"message": "user already exists"})
+			return
+		}
+		s.Postgres.AddUser(pg.Id, pg.DatabaseUser)
+		pg.DatabaseUser = body.Username
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	// DELETE /postgres/{id}/credentials/{username} - delete a non-default user
+	mux.HandleFunc("DELETE /postgres/{id}/credentials/{username}", func(w http.ResponseWriter, r *http.Request) {
+		record(r)
+		if status, hasError := s.Postgres.nextError(); hasError {
+			w.WriteHeader(status)
+			return
+		}
+		pg, ok := s.Postgres.byID(r.PathValue("id"))
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		username := r.PathValue("username")
+		if username == pg.DatabaseUser {
+			writeJSON(w, http.StatusBadRequest, map[string]string{"message": "cannot delete the default user"})
+			return
+		}
+		i := slices.Index(s.Postgres.Users[pg.Id], username)
+		if i < 0 {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		s.Postgres.Users[pg.Id] = slices.Delete(s.Postgres.Users[pg.Id], i, i+1)
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
@@ -5,10 +5,12 @@ import (
 	"fmt"
 	"net/http"
 	"slices"
+	"strings"
 	"time"
 
 	"github.com/render-oss/cli/internal/testids"
 	"github.com/render-oss/cli/pkg/client"
+	autoscaling "github.com/render-oss/cli/pkg/client/autoscaling"
 	"github.com/render-oss/cli/pkg/pointers"
 )
 
@@ -19,7 +21,83 @@ import (
 // and image-backed services.
 type ServiceResource struct {
 	Resource[*client.Service]
-	errorQueue []int
+	// EnvVars holds each service's environment variables keyed by service ID.
+	// Seed it with SetEnvVars.
+	EnvVars map[string][]client.EnvVar
+	// CustomDomains holds each service's custom domains keyed by service ID.
+	// Seed it with AddCustomDomain.
+	CustomDomains map[string][]client.CustomDomain
+	// Deploys holds each service's deploys keyed by service ID, newest last.
+	// Seed it with AddDeploy.
+	Deploys map[string][]*client.Deploy
+	// OnCustomDomainRefresh, when set, runs on each POST
+	// /services/{id}/custom-domains/{idOrName}/verify so tests can flip a
+	// domain to verified after some number of refreshes.
+	OnCustomDomainRefresh func(serviceID string, domain *client.CustomDomain)
+	errorQueue            []int
+}
+
+// SetEnvVars replaces the environment variables stored for serviceID.
+func (s *ServiceResource) SetEnvVars(serviceID string, envVars ...client.EnvVar) {
+	if s.EnvVars == nil {
+		s.EnvVars = map[string][]client.EnvVar{}
+	}
+	s.EnvVars[serviceID] = slices.Clone(envVars)
+}
+
+// AddCustomDomain attaches domain to serviceID and returns it.
+func (s *ServiceResource) AddCustomDomain(serviceID string, domain client.CustomDomain) client.CustomDomain {
+	if s.CustomDomains == nil {
+		s.CustomDomains = map[string][]client.CustomDomain{}
+	}
+	s.CustomDomains[serviceID] = append(s.CustomDomains[serviceID], domain)
+	return domain
+}
+
+func (s *ServiceResource) customDomainIndex(serviceID, idOrName string) int {
+	return slices.IndexFunc(s.CustomDomains[serviceID], func(d client.CustomDomain) bool {
+		return d.Id == idOrName || d.Name == idOrName
+	})
+}
+
+// AddDeploy appends dep to serviceID's deploys and returns the stored copy.
+func (s *ServiceResource) AddDeploy(serviceID string, dep client.Deploy) *client.Deploy {
+	if s.Deploys == nil {
+		s.Deploys = map[string][]*client.Deploy{}
+	}
+	if dep.Id == "" {
+		dep.Id = testids.RandomDeployID()
+	}
+	if dep.CreatedAt == nil {
+		dep.CreatedAt = pointers.From(time.Now())
+	}
+	s.Deploys[serviceID] = append(s.Deploys[serviceID], &dep)
+	return &dep
+}
+
+func (s *ServiceResource) deployByID(serviceID, deployID string) (*client.Deploy, bool) {
+	for _, d := range s.Deploys[serviceID] {
+		if d.Id == deployID {
+			return d, true
+		}
+	}
+	return nil, false
+}
+
+// NewCustomDomain returns an unverified custom domain with defaults filled in.
+// Names with a single dot are treated as apex domains.
+func NewCustomDomain(name string) client.CustomDomain {
+	domainType := client.CustomDomainDomainTypeSubdomain
+	if strings.Count(name, ".") == 1 {
+		domainType = client.CustomDomainDomainTypeApex
+	}
+	return client.CustomDomain{
+		Id:                 testids.RandomCustomDomainID(),
+		Name:               name,
+		DomainType:         domainType,
+		VerificationStatus: client.CustomDomainVerificationStatusUnverified,
+		CreatedAt:          time.Now(),
+	}
 }
 
 // RespondWith queues an HTTP status code to return on the next service
@@ -71,6 +149,7 @@ type WebServiceDetailsAttrs struct {
 	Plan            client.Plan
 	HealthCheckPath string
 	NumInstances    int
+	Autoscaling     *autoscaling.AutoscalingConfig
 	URL             string
 }
 
@@ -495,6 +574,7 @@ func webServiceDetails(attrs WebServiceDetailsAttrs, runtime runtimeDetails) cli
 		url = "https://example.onrender.com"
 	}
 	return client.WebServiceDetails{
+		Autoscaling:        attrs.Autoscaling,
 		BuildPlan:          client.BuildPlanStarter,
 		Env:                serviceEnv(runtime.runtime),
 		EnvSpecificDetails: runtime.envSpecificDetails,
@@ -581,6 +661,81 @@ func (s *Server) serviceInstances() []*client.Service {
 	return services
 }
 
+func (s *Server) serviceByID(id string) (*client.Service, bool) {
+	for _, svc := range s.Services.Instances {
+		if svc.Id == id {
+			return svc, true
+		}
+	}
+	return nil, false
+}
+
+func setServiceSuspended(w http.ResponseWriter, r *http.Request, s *Server, suspended client.ServiceSuspended) {
+	if status, hasError := s.Services.nextError(); hasError {
+		w.WriteHeader(status)
+		return
+	}
+	svc, ok := s.serviceByID(r.PathValue("id"))
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	svc.Suspended = suspended
+	svc.Suspenders = nil
+	if suspended == client.ServiceSuspendedSuspended {
+		svc.Suspenders = []client.SuspenderType{client.SuspenderTypeUser}
+	}
+	svc.UpdatedAt = time.Now()
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// updateServiceDetails lets update edit svc's details, which are stored as a
+// JSON union, as a generic map.
+func updateServiceDetails(svc *client.Service, update func(details map[string]any) error) error {
+	raw, err := svc.ServiceDetails.MarshalJSON()
+	if err != nil {
+		return err
+	}
+	var details map[string]any
+	if err := json.Unmarshal(raw, &details); err != nil {
+		return err
+	}
+	if err := update(details); err != nil {
+		return err
+	}
+	raw, err = json.Marshal(details)
+	if err != nil {
+		return err
+	}
+	return svc.ServiceDetails.UnmarshalJSON(raw)
+}
+
+// setNumInstances rewrites numInstances in svc's details.
+func setNumInstances(svc *client.Service, numInstances int) error {
+	return updateServiceDetails(svc, func(details map[string]any) error {
+		if _, ok := details["numInstances"]; !ok {
+			return fmt.Errorf("service %s cannot be scaled", svc.Id)
+		}
+		details["numInstances"] = numInstances
+		return nil
+	})
+}
+
+// setAutoscaling rewrites autoscaling in svc's details. A nil cfg removes it.
+func setAutoscaling(svc *client.Service, cfg *autoscaling.AutoscalingConfig) error {
+	return updateServiceDetails(svc, func(details map[string]any) error {
+		if _, ok := details["numInstances"]; !ok {
+			return fmt.Errorf("service %s cannot be autoscaled", svc.Id)
+		}
+		if cfg == nil {
+			delete(details, "autoscaling")
+		} else {
+			details["autoscaling"] = cfg
+		}
+		return nil
+	})
+}
+
 func registerServiceRoutes(mux *http.ServeMux, s *Server, record func(*http.Request)) {
 	// GET /services - list services (supports ?name=, ?type=, ?ownerId=, and ?environmentId= filters)
 	mux.HandleFunc("GET /services", func(w http.ResponseWriter, r *http.Request) {
@@ -685,4 +840,327 @@ func registerServiceRoutes(mux *http.ServeMux, s *Server, record func(*http.Requ
 		}
 		w.WriteHeader(http.StatusNotFound)
 	})
+
+	// POST /services/{id}/suspend - suspend a service
+	mux.HandleFunc("POST /services/{id}/suspend", func(w http.ResponseWriter, r *http.Request) {
+		record(r)
+		setServiceSuspended(w, r, s, client.ServiceSuspendedSuspended)
+	})
+
+	// POST /services/{id}/resume - resume a suspended service
+	mux.HandleFunc("POST /services/{id}/resume", func(w http.ResponseWriter, r *http.Request) {
+		record(r)
+		setServiceSuspended(w, r, s, client.ServiceSuspendedNotSuspended)
+	})
+
+	// POST /services/{id}/scale - set a service's manual instance count
+	mux.HandleFunc("POST /services/{id}/scale", func(w http.ResponseWriter, r *http.Request) {
+		record(r)
+		if status, hasError := s.Services.nextError(); hasError {
+			w.WriteHeader(status)
+			return
+		}
+		var body client.ScaleServiceJSONRequestBody
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		svc, ok := s.serviceByID(r.PathValue("id"))
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if err := setNumInstances(svc, body.NumInstances); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		svc.UpdatedAt = time.Now()
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	// PUT /services/{id}/autoscaling - replace a service's autoscaling config
+	mux.HandleFunc("PUT /services/{id}/autoscaling", func(w http.ResponseWriter, r *http.Request) {
+		record(r)
+		if status, hasError := s.Services.nextError(); hasError {
+			w.WriteHeader(status)
+			return
+		}
+		var body client.AutoscaleServiceJSONRequestBody
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		svc, ok := s.serviceByID(r.PathValue("id"))
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if err := setAutoscaling(svc, &body); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		svc.UpdatedAt = time.Now()
+		writeJSON(w, http.StatusOK, body)
+	})
+
+	// DELETE /services/{id}/autoscaling - remove a service's autoscaling config
+	mux.HandleFunc("DELETE /services/{id}/autoscaling", func(w http.ResponseWriter, r *http.Request) {
+		record(r)
+		if status, hasError := s.Services.nextError(); hasError {
+			w.WriteHeader(status)
+			return
+		}
+		svc, ok := s.serviceByID(r.PathValue("id"))
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if err := setAutoscaling(svc, nil); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		svc.UpdatedAt = time.Now()
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	// GET /services/{id}/deploys/{deployId} - retrieve a deploy
+	mux.HandleFunc("GET /services/{id}/deploys/{deployId}", func(w http.ResponseWriter, r *http.Request) {
+		record(r)
+		if status, hasError := s.Services.nextError(); hasError {
+			w.WriteHeader(status)
+			return
+		}
+		dep, ok := s.Services.deployByID(r.PathValue("id"), r.PathValue("deployId"))
+		if !ok {
+			message := "deploy not found"
+			writeJSON(w, http.StatusNotFound, client.Error{Message: &message})
+			return
+		}
+		writeJSON(w, http.StatusOK, dep)
+	})
+
+	// POST /services/{id}/rollback - start a deploy from an earlier deploy's build
+	mux.HandleFunc("POST /services/{id}/rollback", func(w http.ResponseWriter, r *http.Request) {
+		record(r)
+		if status, hasError := s.Services.nextError(); hasError {
+			w.WriteHeader(status)
+			return
+		}
+		var body client.RollbackDeployJSONRequestBody
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		serviceID := r.PathValue("id")
+		target, ok := s.Services.deployByID(serviceID, body.DeployId)
+		if !ok {
+			message := "deploy not found"
+			writeJSON(w, http.StatusNotFound, client.Error{Message: &message})
+			return
+		}
+		dep := s.Services.AddDeploy(serviceID, client.Deploy{
+			Commit:  target.Commit,
+			Image:   target.Image,
+			Status:  pointers.From(client.DeployStatusCreated),
+			Trigger: pointers.From(client.DeployTriggerRollback),
+		})
+		writeJSON(w, http.StatusCreated, dep)
+	})
+
+	// GET /services/{id}/env-vars - list environment variables
+	mux.HandleFunc("GET /services/{id}/env-vars", func(w http.ResponseWriter, r *http.Request) {
+		record(r)
+		if status, hasError := s.Services.nextError(); hasError {
+			w.WriteHeader(status)
+			return
+		}
+		envVars := s.Services.EnvVars[r.PathValue("id")]
+		result := make([]client.EnvVarWithCursor, 0, len(envVars))
+		for i, ev := range envVars {
+			result = append(result, client.EnvVarWithCursor{
+				Cursor: client.Cursor(fmt.Sprintf("c%d", i)),
+				EnvVar: ev,
+			})
+		}
+		writeJSON(w, http.StatusOK, result)
+	})
+
+	// PUT /services/{id}/env-vars - replace all environment variables
+	mux.HandleFunc("PUT /services/{id}/env-vars", func(w http.ResponseWriter, r *http.Request) {
+		record(r)
+		if status, hasError := s.Services.nextError(); hasError {
+			w.WriteHeader(status)
+			return
+		}
+		var body []client.EnvVar
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		id := r.PathValue("id")
+		s.Services.SetEnvVars(id, body...)
+		result := make([]client.EnvVarWithCursor, 0, len(body))
+		for i, ev := range body {
+			result = append(result, client.EnvVarWithCursor{
+				Cursor: client.Cursor(fmt.Sprintf("c%d", i)),
+				EnvVar: ev,
+			})
+		}
+		writeJSON(w, http.StatusOK, result)
+	})
+
+	// GET /services/{id}/env-vars/{key} - retrieve one environment variable
+	mux.HandleFunc("GET /services/{id}/env-vars/{key}", func(w http.ResponseWriter, r *http.Request) {
+		record(r)
+		if status, hasError := s.Services.nextError(); hasError {
+			w.WriteHeader(status)
+			return
+		}
+		for _, ev := range s.Services.EnvVars[r.PathValue("id")] {
+			if ev.Key == r.PathValue("key") {
+				writeJSON(w, http.StatusOK, ev)
+				return
+			}
+		}
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	// PUT /services/{id}/env-vars/{key} - add or update one environment variable
+	mux.HandleFunc("PUT /services/{id}/env-vars/{key}", func(w http.ResponseWriter, r *http.Request) {
+		record(r)
+		if status, hasError := s.Services.nextError(); hasError {
+			w.WriteHeader(status)
+			return
+		}
+		var body client.EnvVarValue
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		id, key := r.PathValue("id"), r.PathValue("key")
+		envVars := slices.Clone(s.Services.EnvVars[id])
+		updated := client.EnvVar{Key: key, Value: body.Value}
+		if i := slices.IndexFunc(envVars, func(ev client.EnvVar) bool { return ev.Key == key }); i >= 0 {
+			envVars[i] = updated
+		} else {
+			envVars = append(envVars, updated)
+		}
+		s.Services.SetEnvVars(id, envVars...)
+		writeJSON(w, http.StatusOK, updated)
+	})
+
+	// DELETE /services/{id}/env-vars/{key} - remove one environment variable
+	mux.HandleFunc("DELETE /services/{id}/env-vars/{key}", func(w http.ResponseWriter, r *http.Request) {
+		record(r)
+		if status, hasError := s.Services.nextError(); hasError {
+			w.WriteHeader(status)
+			return
+		}
+		id, key := r.PathValue("id"), r.PathValue("key")
+		envVars := s.Services.EnvVars[id]
+		i := slices.IndexFunc(envVars, func(ev client.EnvVar) bool { return ev.Key == key })
+		if i == -1 {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		s.Services.SetEnvVars(id, slices.Delete(slices.Clone(envVars), i, i+1)...)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	// GET /services/{id}/custom-domains - list custom domains
+	mux.HandleFunc("GET /services/{id}/custom-domains", func(w http.ResponseWriter, r *http.Request) {
+		record(r)
+		if status, hasError := s.Services.nextError(); hasError {
+			w.WriteHeader(status)
+			return
+		}
+		domains := s.Services.CustomDomains[r.PathValue("id")]
+		result := make([]client.CustomDomainWithCursor, 0, len(domains))
+		for i, d := range domains {
+			result = append(result, client.CustomDomainWithCursor{
+				Cursor:       client.Cursor(fmt.Sprintf("c%d", i)),
+				CustomDomain: d,
+			})
+		}
+		writeJSON(w, http.StatusOK, result)
+	})
+
+	// POST /services/{id}/custom-domains - add a custom domain. Like the real
+	// API, an apex domain also gets a www redirect.
+	mux.HandleFunc("POST /services/{id}/custom-domains", func(w http.ResponseWriter, r *http.Request) {
+		record(r)
+		if status, hasError := s.Services.nextError(); hasError {
+			w.WriteHeader(status)
+			return
+		}
+		var body client.CreateCustomDomainJSONRequestBody
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		id := r.PathValue("id")
+		if s.Services.customDomainIndex(id, body.Name) >= 0 {
+			w.WriteHeader(http.StatusConflict)
+			return
+		}
+		created := []client.CustomDomain{s.Services.AddCustomDomain(id, NewCustomDomain(body.Name))}
+		if created[0].DomainType == client.CustomDomainDomainTypeApex {
+			www := NewCustomDomain("www." + body.Name)
+			www.RedirectForName = body.Name
+			created = append(created, s.Services.AddCustomDomain(id, www))
+		}
+		writeJSON(w, http.StatusCreated, created)
+	})
+
+	// GET /services/{id}/custom-domains/{idOrName} - retrieve a custom domain
+	mux.HandleFunc("GET /services/{id}/custom-domains/{idOrName}", func(w http.ResponseWriter, r *http.Request) {
+		record(r)
+		if status, hasError := s.Services.nextError(); hasError {
+			w.WriteHeader(status)
+			return
+		}
+		id := r.PathValue("id")
+		i := s.Services.customDomainIndex(id, r.PathValue("idOrName"))
+		if i == -1 {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		writeJSON(w, http.StatusOK, s.Services.CustomDomains[id][i])
+	})
+
+	// DELETE /services/{id}/custom-domains/{idOrName} - remove a custom domain
+	mux.HandleFunc("DELETE /services/{id}/custom-domains/{idOrName}", func(w http.ResponseWriter, r *http.Request) {
+		record(r)
+		if status, hasError := s.Services.nextError(); hasError {
+			w.WriteHeader(status)
+			return
+		}
+		id := r.PathValue("id")
+		i := s.Services.customDomainIndex(id, r.PathValue("idOrName"))
+		if i == -1 {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		s.Services.CustomDomains[id] = slices.Delete(slices.Clone(s.Services.CustomDomains[id]), i, i+1)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	// POST /services/{id}/custom-domains/{idOrName}/verify - refresh DNS verification
+	mux.HandleFunc("POST /services/{id}/custom-domains/{idOrName}/verify", func(w http.ResponseWriter, r *http.Request) {
+		record(r)
+		if status, hasError := s.Services.nextError(); hasError {
+			w.WriteHeader(status)
+			return
+		}
+		id := r.PathValue("id")
+		i := s.Services.customDomainIndex(id, r.PathValue("idOrName"))
+		if i == -1 {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if s.Services.OnCustomDomainRefresh != nil {
+			s.Services.OnCustomDomainRefresh(id, &s.Services.CustomDomains[id][i])
+		}
+		w.WriteHeader(http.StatusAccepted)
+	})
 }
@@ -0,0 +1,203 @@
+package renderapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/render-oss/cli/internal/testids"
+	"github.com/render-oss/cli/pkg/client"
+	webhooks "github.com/render-oss/cli/pkg/client/webhooks"
+)
+
+// WebhookResource holds webhook state and error injection for the fake server.
+// Tests can assert against Instances.
+type WebhookResource struct {
+	Resource[*webhooks.Webhook]
+	// Events holds the deliveries returned for each webhook ID.
+	Events map[string][]webhooks.WebhookEvent
+	// owners maps webhook IDs to their owner, which the API does not return.
+	owners     map[string]string
+	errorQueue []int
+}
+
+// RespondWith queues an HTTP status code to return on the next webhook
+// operation handled by the fake server. The queue is drained in FIFO order.
+func (r *WebhookResource) RespondWith(status int) {
+	r.errorQueue = append(r.errorQueue, status)
+}
+
+func (r *WebhookResource) nextError() (int, bool) {
+	if len(r.errorQueue) == 0 {
+		return 0, false
+	}
+	status := r.errorQueue[0]
+	r.errorQueue = r.errorQueue[1:]
+	return status, true
+}
+
+// AddOwned stores a webhook belonging to ownerID and returns it.
+func (r *WebhookResource) AddOwned(ownerID string, w *webhooks.Webhook) *webhooks.Webhook {
+	if r.owners == nil {
+		r.owners = map[string]string{}
+	}
+	r.owners[w.Id] = ownerID
+	return r.Add(w)
+}
+
+// AddEvent registers a delivery for the webhook with the given ID.
+func (r *WebhookResource) AddEvent(webhookID string, event webhooks.WebhookEvent) {
+	if r.Events == nil {
+		r.Events = map[string][]webhooks.WebhookEvent{}
+	}
+	r.Events[webhookID] = append(r.Events[webhookID], event)
+}
+
+func (r *WebhookResource) byID(id string) (*webhooks.Webhook, bool) {
+	for _, w := range r.Instances {
+		if w.Id == id {
+			return w, true
+		}
+	}
+	return nil, false
+}
+
+// NewWebhook returns a Webhook with sensible defaults for any zero-value
+// fields.
+func NewWebhook(w webhooks.Webhook) *webhooks.Webhook {
+	if w.Id == "" {
+		w.Id = testids.RandomWebhookID()
+	}
+	if w.Name == "" {
+		w.Name = "deploys"
+	}
+	if w.Url == "" {
+		w.Url = "https://example.com/render"
+	}
+	if w.Secret == "" {
+		w.Secret = "whsec_" + w.Id
+	}
+	if w.EventFilter == nil {
+		w.EventFilter = webhooks.EventFilter{}
+	}
+	return &w
+}
+
+func registerWebhookRoutes(mux *http.ServeMux, s *Server, record func(*http.Request)) {
+	// GET /webhooks - list webhooks (supports ?ownerId= filter)
+	mux.HandleFunc("GET /webhooks", func(w http.ResponseWriter, r *http.Request) {
+		record(r)
+		if status, hasError := s.Webhooks.nextError(); hasError {
+			w.WriteHeader(status)
+			return
+		}
+		ownerIDs := queryListValues(r, "ownerId")
+		result := make([]client.WebhookWithCursor, 0, len(s.Webhooks.Instances))
+		for i, hook := range s.Webhooks.Instances {
+			if len(ownerIDs) > 0 && !slices.Contains(ownerIDs, s.Webhooks.owners[hook.Id]) {
+				continue
+			}
+			result = append(result, client.WebhookWithCursor{
+				Cursor:  client.Cursor(fmt.Sprintf("c%d", i)),
+				Webhook: *hook,
+			})
+		}
+		writeJSON(w, http.StatusOK, result)
+	})
+
+	// POST /webhooks - create a webhook
+	mux.HandleFunc("POST /webhooks", func(w http.ResponseWriter, r *http.Request) {
+		record(r)
+		if status, hasError := s.Webhooks.nextError(); hasError {
+			w.WriteHeader(status)
+			return
+		}
+		var body webhooks.WebhookPOSTInput
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		hook := s.Webhooks.AddOwned(body.OwnerId, NewWebhook(webhooks.Webhook{
+			Name:        body.Name,
+			Url:         body.Url,
+			Enabled:     body.Enabled,
+			EventFilter: body.EventFilter,
+		}))
+		writeJSON(w, http.StatusCreated, hook)
+	})
+
+	// PATCH /webhooks/{id} - update a webhook
+	mux.HandleFunc("PATCH /webhooks/{id}", func(w http.ResponseWriter, r *http.Request) {
+		record(r)
+		if status, hasError := s.Webhooks.nextError(); hasError {
+			w.WriteHeader(status)
+			return
+		}
+		hook, ok := s.Webhooks.byID(r.PathValue("id"))
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		var body webhooks.WebhookPATCHInput
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if body.Name != nil {
+			hook.Name = *body.Name
+		}
+		if body.Url != nil {
+			hook.Url = *body.Url
+		}
+		if body.Enabled != nil {
+			hook.Enabled = *body.Enabled
+		}
+		if body.EventFilter != nil {
+			hook.EventFilter = *body.EventFilter
+		}
+		writeJSON(w, http.StatusOK, hook)
+	})
+
+	// DELETE /webhooks/{id} - delete a webhook
+	mux.HandleFunc("DELETE /webhooks/{id}", func(w http.ResponseWriter, r *http.Request) {
+		record(r)
+		if status, hasError := s.Webhooks.nextError(); hasError {
+			w.WriteHeader(status)
+			return
+		}
+		id := r.PathValue("id")
+		i := slices.IndexFunc(s.Webhooks.Instances, func(hook *webhooks.Webhook) bool {
+			return hook.Id == id
+		})
+		if i == -1 {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		s.Webhooks.Instances = slices.Delete(s.Webhooks.Instances, i, i+1)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	// GET /webhooks/{id}/events - list a webhook's deliveries
+	mux.HandleFunc("GET /webhooks/{id}/events", func(w http.ResponseWriter, r *http.Request) {
+		record(r)
+		if status, hasError := s.Webhooks.nextError(); hasError {
+			w.WriteHeader(status)
+			return
+		}
+		id := r.PathValue("id")
+		if _, ok := s.Webhooks.byID(id); !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		events := s.Webhooks.Events[id]
+		result := make([]client.WebhookEventWithCursor, 0, len(events))
+		for i, e := range events {
+			result = append(result, client.WebhookEventWithCursor{
+				Cursor:       client.Cursor(fmt.Sprintf("c%d", i)),
+				WebhookEvent: e,
+			})
+		}
+		writeJSON(w, http.StatusOK, result)
+	})
+}
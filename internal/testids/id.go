@@ -99,6 +99,56 @@ func RandomSandboxGroupID() string {
 	return SandboxGroupID(xid.New().String())
 }
 
+// EnvGroupID returns a syntactically valid evg- env group ID for tests.
+func EnvGroupID(label string) string {
+	return objectID("evg", label)
+}
+
+// RandomEnvGroupID returns a syntactically valid evg- env group ID for tests.
+func RandomEnvGroupID() string {
+	return EnvGroupID(xid.New().String())
+}
+
+// CustomDomainID returns a syntactically valid cdm- custom domain ID for tests.
+func CustomDomainID(label string) string {
+	return objectID("cdm", label)
+}
+
+// RandomCustomDomainID returns a syntactically valid cdm- custom domain ID for tests.
+func RandomCustomDomainID() string {
+	return CustomDomainID(xid.New().String())
+}
+
+// DeployID returns a syntactically valid dep- deploy ID for tests.
+func DeployID(label string) string {
+	return objectID("dep", label)
+}
+
+// RandomDeployID returns a syntactically valid dep- deploy ID for tests.
+func RandomDeployID() string {
+	return DeployID(xid.New().String())
+}
+
+// DiskID returns a syntactically valid dsk- disk ID for tests.
+func DiskID(label string) string {
+	return objectID("dsk", label)
+}
+
+// RandomDiskID returns a syntactically valid dsk- disk ID for tests.
+func RandomDiskID() string {
+	return DiskID(xid.New().String())
+}
+
+// WebhookID returns a syntactically valid whk- webhook ID for tests.
+func WebhookID(label string) string {
+	return objectID("whk", label)
+}
+
+// RandomWebhookID returns a syntactically valid whk- webhook ID for tests.
+func RandomWebhookID() string {
+	return WebhookID(xid.New().String())
+}
+
 // objectID returns a deterministic test ID in Render object ID form:
 //
 //	objectID("prj", "Project A!") == "prj-projecta000000000000"
@@ -0,0 +1,74 @@
+package auditlog
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"time"
+
+	"github.com/render-oss/cli/pkg/client"
+	"github.com/render-oss/cli/pkg/pointers"
+)
+
+const (
+	ExportJSONL = "jsonl"
+	ExportCSV   = "csv"
+)
+
+// ExportFormats lists the values accepted by --export.
+func ExportFormats() []string {
+	return []string{ExportJSONL, ExportCSV}
+}
+
+var csvHeader = []string{"timestamp", "id", "event", "status", "actor_type", "actor_id", "actor_email", "metadata"}
+
+// Export writes entries to w in the given format. JSONL writes one API
+// object per line. CSV flattens the actor and encodes metadata as a JSON
+// object so every row has the same columns.
+func Export(w io.Writer, format string, entries []*client.AuditLog) error {
+	if format == ExportCSV {
+		return writeCSV(w, entries)
+	}
+	return writeJSONL(w, entries)
+}
+
+func writeJSONL(w io.Writer, entries []*client.AuditLog) error {
+	enc := json.NewEncoder(w)
+	for _, entry := range entries {
+		if err := enc.Encode(entry); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeCSV(w io.Writer, entries []*client.AuditLog) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(csvHeader); err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		fields := entry.Metadata
+		if fields == nil {
+			fields = map[string]string{}
+		}
+		metadata, err := json.Marshal(fields)
+		if err != nil {
+			return err
+		}
+		if err := cw.Write([]string{
+			entry.Timestamp.UTC().Format(time.RFC3339),
+			entry.Id,
+			string(entry.Event),
+			string(entry.Status),
+			string(entry.Actor.Type),
+			pointers.StringValue(entry.Actor.Id),
+			pointers.StringValue(entry.Actor.Email),
+			string(metadata),
+		}); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
@@ -0,0 +1,60 @@
+package auditlog_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/render-oss/cli/pkg/auditlog"
+	"github.com/render-oss/cli/pkg/client"
+	"github.com/render-oss/cli/pkg/pointers"
+)
+
+func testEntries() []*client.AuditLog {
+	return []*client.AuditLog{
+		{
+			Id:        "aud-1",
+			Event:     client.UpdateEnvVarsEvent,
+			Status:    client.AuditLogStatusSuccess,
+			Timestamp: time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC),
+			Actor:     client.AuditLogActor{Type: client.AuditLogActorTypeUser, Id: pointers.From("usr-1"), Email: pointers.From("alice@example.com")},
+			Metadata:  map[string]string{"service": "srv-1", "field": "env_vars"},
+		},
+		{
+			Id:        "aud-2",
+			Event:     client.SuspendServiceEvent,
+			Status:    client.AuditLogStatusError,
+			Timestamp: time.Date(2026, 10, 1, 11, 0, 0, 0, time.UTC),
+			Actor:     client.AuditLogActor{Type: client.AuditLogActorTypeSystem},
+		},
+	}
+}
+
+func TestExport(t *testing.T) {
+	t.Run("jsonl", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, auditlog.Export(&buf, auditlog.ExportJSONL, testEntries()))
+
+		lines := bytes.Split(bytes.TrimSpace(buf.Bytes()), []byte("\n"))
+		require.Len(t, lines, 2)
+		var first client.AuditLog
+		require.NoError(t, json.Unmarshal(lines[0], &first))
+		assert.Equal(t, "aud-1", first.Id)
+		assert.Equal(t, "env_vars", first.Metadata["field"])
+	})
+
+	t.Run("csv", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, auditlog.Export(&buf, auditlog.ExportCSV, testEntries()))
+
+		assert.Equal(t,
+			"timestamp,id,event,status,actor_type,actor_id,actor_email,metadata\n"+
+				`2026-10-01T12:00:00Z,aud-1,UpdateEnvVarsEvent,success,user,usr-1,alice@example.com,"{""field"":""env_vars"",""service"":""srv-1""}"`+"\n"+
+				"2026-10-01T11:00:00Z,aud-2,SuspendServiceEvent,error,system,,,{}\n",
+			buf.String())
+	})
+}
@@ -0,0 +1,39 @@
+package auditlog
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/render-oss/cli/pkg/client"
+)
+
+// Filter narrows audit log entries client-side. Empty fields match
+// everything; within a field, any value may match.
+type Filter struct {
+	// Actors match an actor's email (case-insensitive) or ID.
+	Actors []string
+	Events []client.AuditLogEvent
+	Status *client.AuditLogStatus
+}
+
+func (f Filter) Matches(entry *client.AuditLog) bool {
+	if len(f.Events) > 0 && !slices.Contains(f.Events, entry.Event) {
+		return false
+	}
+	if f.Status != nil && entry.Status != *f.Status {
+		return false
+	}
+	if len(f.Actors) > 0 && !slices.ContainsFunc(f.Actors, func(actor string) bool {
+		return matchesActor(entry.Actor, actor)
+	}) {
+		return false
+	}
+	return true
+}
+
+func matchesActor(actor client.AuditLogActor, value string) bool {
+	if actor.Id != nil && *actor.Id == value {
+		return true
+	}
+	return actor.Email != nil && strings.EqualFold(*actor.Email, value)
+}
@@ -0,0 +1,22 @@
+package auditlog_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/render-oss/cli/pkg/auditlog"
+	"github.com/render-oss/cli/pkg/client"
+	"github.com/render-oss/cli/pkg/pointers"
+)
+
+func TestFilter(t *testing.T) {
+	entries := testEntries()
+
+	assert.True(t, auditlog.Filter{}.Matches(entries[1]))
+	assert.True(t, auditlog.Filter{Actors: []string{"ALICE@example.com"}}.Matches(entries[0]))
+	assert.True(t, auditlog.Filter{Actors: []string{"bob@example.com", "usr-1"}}.Matches(entries[0]))
+	assert.False(t, auditlog.Filter{Actors: []string{"usr-1"}}.Matches(entries[1]))
+	assert.False(t, auditlog.Filter{Events: []client.AuditLogEvent{client.SuspendServiceEvent}}.Matches(entries[0]))
+	assert.False(t, auditlog.Filter{Status: pointers.From(client.AuditLogStatusSuccess)}.Matches(entries[1]))
+}
@@ -0,0 +1,9 @@
+package auditlog
+
+import (
+	"github.com/render-oss/cli/pkg/client"
+)
+
+type ListOut struct {
+	Data []*client.AuditLog `json:"data"`
+}
@@ -0,0 +1,137 @@
+package auditlog
+
+import (
+	"context"
+	"time"
+
+	"github.com/render-oss/cli/pkg/client"
+	logsclient "github.com/render-oss/cli/pkg/client/logs"
+	"github.com/render-oss/cli/pkg/config"
+)
+
+// pageSize is the number of entries requested per page. It matches the
+// API's maximum so large exports take as few requests as possible.
+const pageSize = 100
+
+// Repo wraps the generated OpenAPI client for audit log endpoints.
+type Repo struct {
+	client *client.ClientWithResponses
+}
+
+func NewRepo(c *client.ClientWithResponses) *Repo {
+	return &Repo{client: c}
+}
+
+// Query selects which audit log entries to list.
+type Query struct {
+	// OrganizationID lists the organization's audit log instead of the
+	// active workspace's.
+	OrganizationID string
+	Start          *time.Time
+	End            *time.Time
+	Filter         Filter
+	// Limit caps the number of matching entries returned. Zero means no cap.
+	Limit int
+}
+
+// ListAuditLogs returns matching entries, newest first. The API has no actor
+// or event filters, so pages are fetched until Limit entries match or the
+// time range is exhausted.
+func (r *Repo) ListAuditLogs(ctx context.Context, q Query) ([]*client.AuditLog, error) {
+	listPage, err := r.pageLister(q)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []*client.AuditLog
+	var cursor *client.Cursor
+	for {
+		page, next, err := listPage(ctx, cursor)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, entry := range page {
+			if !q.Filter.Matches(entry) {
+				continue
+			}
+			res = append(res, entry)
+			if q.Limit > 0 && len(res) == q.Limit {
+				return res, nil
+			}
+		}
+
+		if len(page) < pageSize {
+			return res, nil
+		}
+		cursor = next
+	}
+}
+
+type pageLister func(ctx context.Context, cursor *client.Cursor) ([]*client.AuditLog, *client.Cursor, error)
+
+func (r *Repo) pageLister(q Query) (pageLister, error) {
+	direction := logsclient.Backward
+
+	if q.OrganizationID != "" {
+		params := &client.ListOrganizationAuditLogsParams{StartTime: q.Start, EndTime: q.End, Direction: &direction}
+		params.SetLimit(pageSize)
+		return func(ctx context.Context, cursor *client.Cursor) ([]*client.AuditLog, *client.Cursor, error) {
+			params.SetCursor(cursor)
+			return r.listOrganizationPage(ctx, q.OrganizationID, params)
+		}, nil
+	}
+
+	workspace, err := config.WorkspaceID()
+	if err != nil {
+		return nil, err
+	}
+	params := &client.ListOwnerAuditLogsParams{StartTime: q.Start, EndTime: q.End, Direction: &direction}
+	params.SetLimit(pageSize)
+	return func(ctx context.Context, cursor *client.Cursor) ([]*client.AuditLog, *client.Cursor, error) {
+		params.SetCursor(cursor)
+		return r.listOwnerPage(ctx, workspace, params)
+	}, nil
+}
+
+func (r *Repo) listOwnerPage(ctx context.Context, ownerID string, params *client.ListOwnerAuditLogsParams) ([]*client.AuditLog, *client.Cursor, error) {
+	resp, err := r.client.ListOwnerAuditLogsWithResponse(ctx, ownerID, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, nil, nil
+	}
+	return unwrapPage(*resp.JSON200)
+}
+
+func (r *Repo) listOrganizationPage(ctx context.Context, orgID string, params *client.ListOrganizationAuditLogsParams) ([]*client.AuditLog, *client.Cursor, error) {
+	resp, err := r.client.ListOrganizationAuditLogsWithResponse(ctx, orgID, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, nil, nil
+	}
+	return unwrapPage(*resp.JSON200)
+}
+
+func unwrapPage(res []client.AuditLogWithCursor) ([]*client.AuditLog, *client.Cursor, error) {
+	if len(res) == 0 {
+		return nil, nil, nil
+	}
+
+	out := make([]*client.AuditLog, 0, len(res))
+	for _, entry := range res {
+		out = append(out, &entry.AuditLog)
+	}
+	return out, &res[len(res)-1].Cursor, nil
+}
@@ -90,3 +90,31 @@ func (p *ListSecretFilesForServiceParams) SetCursor(c *Cursor) {
 func (p *ListSecretFilesForServiceParams) SetLimit(l int) {
 	p.Limit = &l
 }
+
+func (p *ListDisksParams) SetCursor(c *Cursor) {
+	p.Cursor = c
+}
+func (p *ListDisksParams) SetLimit(l int) {
+	p.Limit = &l
+}
+
+func (p *ListWebhooksParams) SetCursor(c *Cursor) {
+	p.Cursor = c
+}
+func (p *ListWebhooksParams) SetLimit(l int) {
+	p.Limit = &l
+}
+
+func (p *ListOwnerAuditLogsParams) SetCursor(c *Cursor) {
+	p.Cursor = c
+}
+func (p *ListOwnerAuditLogsParams) SetLimit(l int) {
+	p.Limit = &l
+}
+
+func (p *ListOrganizationAuditLogsParams) SetCursor(c *Cursor) {
+	p.Cursor = c
+}
+func (p *ListOrganizationAuditLogsParams) SetLimit(l int) {
+	p.Limit = &l
+}
@@ -6,6 +6,8 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"strings"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/spf13/cobra"
@@ -37,6 +39,10 @@ type LoadDataFunc[T any] func() (T, error)
 type FormatTextFunc[T any] func(T) string
 type ConfirmFunc func() (string, error)
 
+// TypedConfirmFunc returns the confirmation message and the text the user must
+// type to confirm, usually the name of the resource being destroyed.
+type TypedConfirmFunc func() (message string, confirmText string, err error)
+
 func NonInteractive[T any](cmd *cobra.Command, loadData LoadDataFunc[T], formatText FormatTextFunc[T]) (bool, error) {
 	return NonInteractiveWithConfirm(cmd, loadData, formatText, nil)
 }
@@ -79,6 +85,48 @@ func NonInteractiveWithConfirm[T any](cmd *cobra.Command, loadData LoadDataFunc[
 	return PrintData(cmd, data, formatText)
 }
 
+// NonInteractiveWithTypedConfirm is like NonInteractiveWithConfirm, but asks
+// the user to type confirmText instead of answering y/n. Passing --confirm
+// skips the prompt. The prompt is written to stderr so that stdout holds only
+// the command's output, which keeps JSON and YAML output parseable.
+func NonInteractiveWithTypedConfirm[T any](cmd *cobra.Command, loadData LoadDataFunc[T], formatText FormatTextFunc[T], confirmFunc TypedConfirmFunc) (bool, error) {
+	outputFormat := GetFormatFromContext(cmd.Context())
+
+	if outputFormat == nil || (*outputFormat == Interactive) {
+		return false, nil
+	}
+
+	if confirm := GetConfirmFromContext(cmd.Context()); !confirm {
+		message, confirmText, err := confirmFunc()
+		if err != nil {
+			return false, convertToUserFacingErr(err)
+		}
+		_, err = fmt.Fprintf(cmd.ErrOrStderr(), "%s\nType %s to confirm: ", message, confirmText)
+		if err != nil {
+			return false, err
+		}
+
+		reader := bufio.NewReader(cmd.InOrStdin())
+		str, err := reader.ReadString('\n')
+		if errors.Is(err, io.EOF) && str == "" {
+			return false, tui.UserFacingError{Message: "no confirmation received; pass --confirm to run without a prompt"}
+		} else if err != nil && !errors.Is(err, io.EOF) {
+			return false, err
+		}
+		if strings.TrimSpace(str) != confirmText {
+			_, err := cmd.ErrOrStderr().Write([]byte("Aborted\n"))
+			return true, err
+		}
+	}
+
+	data, err := loadData()
+	if err != nil {
+		return false, convertToUserFacingErr(err)
+	}
+
+	return PrintData(cmd, data, formatText)
+}
+
 type TextTable interface {
 	Header() []string
 	Row() []string
@@ -229,6 +277,23 @@ func WrapInConfirm[D any](cmd tui.TypedCmd[D], msgFunc func() (string, error)) t
 	}
 }
 
+// WrapInTypedConfirm shows a confirmation dialog that requires the user to
+// type the confirmation text before cmd runs.
+func WrapInTypedConfirm[D any](cmd tui.TypedCmd[D], confirmFunc TypedConfirmFunc) tui.TypedCmd[D] {
+	return func() tea.Msg {
+		message, confirmText, err := confirmFunc()
+		if err != nil {
+			return tui.ErrorMsg{Err: convertToUserFacingErr(err)}
+		}
+
+		return tui.ShowConfirmMsg{
+			Message:     message,
+			ConfirmText: confirmText,
+			OnConfirm:   func() tea.Cmd { return cmd.Unwrap() },
+		}
+	}
+}
+
 func convertToUserFacingErr(err error) error {
 	if errors.Is(err, client.ErrUnauthorized) {
 		return ErrTokenExpired
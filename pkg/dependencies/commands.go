@@ -11,6 +11,8 @@ type WorkflowCommands struct {
 	RunListCmd         *cobra.Command
 	RunDetailsCmd      *cobra.Command
 	RunCancelCmd       *cobra.Command
+	RunRerunCmd        *cobra.Command
+	RunTreeCmd         *cobra.Command
 	WorkflowListCmd    *cobra.Command
 	WorkflowCreateCmd  *cobra.Command
 }
@@ -19,6 +21,10 @@ type LogsCommands struct {
 	LogsCmd *cobra.Command
 }
 
+type MetricsCommands struct {
+	MetricsCmd *cobra.Command
+}
+
 type WorkspaceCommands struct {
 	WorkspaceSetCmd *cobra.Command
 }
@@ -26,6 +32,7 @@ type WorkspaceCommands struct {
 type Commands struct {
 	Workflow  *WorkflowCommands
 	Logs      *LogsCommands
+	Metrics   *MetricsCommands
 	Workspace *WorkspaceCommands
 }
 
@@ -65,6 +72,10 @@ func (c *Commands) LogsCmd() *cobra.Command {
 	return c.Logs.LogsCmd
 }
 
+func (c *Commands) MetricsCmd() *cobra.Command {
+	return c.Metrics.MetricsCmd
+}
+
 func (c *Commands) WorkspaceSetCmd() *cobra.Command {
 	return c.Workspace.WorkspaceSetCmd
 }
@@ -76,3 +87,11 @@ func (c *Commands) RunDetailsCmd() *cobra.Command {
 func (c *Commands) RunCancelCmd() *cobra.Command {
 	return c.Workflow.RunCancelCmd
 }
+
+func (c *Commands) RunRerunCmd() *cobra.Command {
+	return c.Workflow.RunRerunCmd
+}
+
+func (c *Commands) RunTreeCmd() *cobra.Command {
+	return c.Workflow.RunTreeCmd
+}
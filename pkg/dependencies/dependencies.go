@@ -4,13 +4,17 @@ import (
 	"sync"
 
 	"github.com/render-oss/cli/pkg/analytics"
+	"github.com/render-oss/cli/pkg/auditlog"
 	"github.com/render-oss/cli/pkg/client"
 	"github.com/render-oss/cli/pkg/command"
 	"github.com/render-oss/cli/pkg/config"
 	"github.com/render-oss/cli/pkg/deploy"
+	"github.com/render-oss/cli/pkg/disk"
+	"github.com/render-oss/cli/pkg/envgroup"
 	"github.com/render-oss/cli/pkg/environment"
 	"github.com/render-oss/cli/pkg/keyvalue"
 	"github.com/render-oss/cli/pkg/logs"
+	"github.com/render-oss/cli/pkg/metrics"
 	"github.com/render-oss/cli/pkg/owner"
 	"github.com/render-oss/cli/pkg/postgres"
 	"github.com/render-oss/cli/pkg/project"
@@ -26,6 +30,7 @@ import (
 	workflowviews "github.com/render-oss/cli/pkg/tui/views/workflows"
 	"github.com/render-oss/cli/pkg/user"
 	"github.com/render-oss/cli/pkg/version"
+	"github.com/render-oss/cli/pkg/webhook"
 	"github.com/render-oss/cli/pkg/workflow"
 )
 
@@ -49,6 +54,7 @@ type cachedDependencies struct {
 	taskRepo            cache[*tasks.Repo]
 	projectRepo         cache[*project.Repo]
 	environmentRepo     cache[*environment.Repo]
+	envGroupRepo        cache[*envgroup.Repo]
 	serviceRepo         cache[*service.Repo]
 	registryRepo        cache[*registry.Repo]
 	registryService     cache[*registry.Service]
@@ -59,8 +65,12 @@ type cachedDependencies struct {
 	userRepo            cache[*user.Repo]
 	ownerRepo           cache[*owner.Repo]
 	deployRepo          cache[*deploy.Repo]
+	diskRepo            cache[*disk.Repo]
+	webhookRepo         cache[*webhook.Repo]
+	auditLogRepo        cache[*auditlog.Repo]
 	resolver            cache[*resolve.Resolver]
 	serviceService      cache[*service.Service]
+	envGroupService     cache[*envgroup.Service]
 	postgresService     cache[*postgres.Service]
 	sandboxService      cache[*sandbox.Service]
 	sandboxGroupService cache[*sandboxgroup.Service]
@@ -68,6 +78,8 @@ type cachedDependencies struct {
 	resourceService     cache[*resource.Service]
 	logRepo             cache[*logs.LogRepo]
 	logLoader           cache[*views.LogLoader]
+	metricsRepo         cache[*metrics.Repo]
+	metricsLoader       cache[*views.MetricsLoader]
 	resourceLoader      cache[*views.ResourceLoader]
 	apiConfig           cache[*config.APIConfig]
 }
@@ -88,6 +100,7 @@ func New(c *client.ClientWithResponses) *Dependencies {
 		Commands: &Commands{
 			Workflow:  &WorkflowCommands{},
 			Logs:      &LogsCommands{},
+			Metrics:   &MetricsCommands{},
 			Workspace: &WorkspaceCommands{},
 		},
 		cache:                &cachedDependencies{},
@@ -138,6 +151,30 @@ func (d *Dependencies) EnvironmentRepo() *environment.Repo {
 	})
 }
 
+func (d *Dependencies) EnvGroupRepo() *envgroup.Repo {
+	return d.cache.envGroupRepo.Get(func() *envgroup.Repo {
+		return envgroup.NewRepo(d.client)
+	})
+}
+
+func (d *Dependencies) DiskRepo() *disk.Repo {
+	return d.cache.diskRepo.Get(func() *disk.Repo {
+		return disk.NewRepo(d.client)
+	})
+}
+
+func (d *Dependencies) WebhookRepo() *webhook.Repo {
+	return d.cache.webhookRepo.Get(func() *webhook.Repo {
+		return webhook.NewRepo(d.client)
+	})
+}
+
+func (d *Dependencies) AuditLogRepo() *auditlog.Repo {
+	return d.cache.auditLogRepo.Get(func() *auditlog.Repo {
+		return auditlog.NewRepo(d.client)
+	})
+}
+
 func (d *Dependencies) ServiceRepo() *service.Repo {
 	return d.cache.serviceRepo.Get(func() *service.Repo {
 		return service.NewRepo(d.client)
@@ -204,6 +241,12 @@ func (d *Dependencies) ServiceService() *service.Service {
 	})
 }
 
+func (d *Dependencies) EnvGroupService() *envgroup.Service {
+	return d.cache.envGroupService.Get(func() *envgroup.Service {
+		return envgroup.NewService(d.EnvGroupRepo(), d.ServiceRepo(), d.Resolver())
+	})
+}
+
 func (d *Dependencies) PostgresService() *postgres.Service {
 	return d.cache.postgresService.Get(func() *postgres.Service {
 		return postgres.NewService(d.PostgresRepo(), d.EnvironmentRepo(), d.ProjectRepo(), d.Resolver())
@@ -281,6 +324,18 @@ func (d *Dependencies) LogLoader() *views.LogLoader {
 	})
 }
 
+func (d *Dependencies) MetricsRepo() *metrics.Repo {
+	return d.cache.metricsRepo.Get(func() *metrics.Repo {
+		return metrics.NewRepo(d.client)
+	})
+}
+
+func (d *Dependencies) MetricsLoader() *views.MetricsLoader {
+	return d.cache.metricsLoader.Get(func() *views.MetricsLoader {
+		return views.NewMetricsLoader(d.MetricsRepo(), d.ServiceRepo(), d.KeyValueRepo(), d.PostgresRepo(), d.WorkflowRepo())
+	})
+}
+
 func (d *Dependencies) ResourceLoader() *views.ResourceLoader {
 	return d.cache.resourceLoader.Get(func() *views.ResourceLoader {
 		return views.NewResourceLoader(d.ResourceService())
@@ -0,0 +1,23 @@
+package deploy
+
+import "github.com/render-oss/cli/pkg/client"
+
+// RollbackOut is the JSON/YAML contract for `render deploys rollback`.
+type RollbackOut struct {
+	Data RollbackOutData `json:"data"`
+	Meta RollbackOutMeta `json:"meta"`
+}
+
+type RollbackOutData struct {
+	ServiceID string `json:"serviceId"`
+	// Target is the earlier deploy whose build is redeployed.
+	Target *client.Deploy `json:"target"`
+	// Deploy is the new deploy created by the rollback. It is omitted when
+	// the rollback was only previewed.
+	Deploy *client.Deploy `json:"deploy,omitempty"`
+}
+
+type RollbackOutMeta struct {
+	RolledBack bool   `json:"rolledBack"`
+	Message    string `json:"message,omitempty"`
+}
@@ -84,3 +84,20 @@ func (d *Repo) CancelDeploy(ctx context.Context, serviceID, deployID string) (*c
 
 	return resp.JSON200, nil
 }
+
+// RollbackDeploy starts a new deploy of serviceID using the build from
+// deployID.
+func (d *Repo) RollbackDeploy(ctx context.Context, serviceID, deployID string) (*client.Deploy, error) {
+	resp, err := d.client.RollbackDeployWithResponse(ctx, serviceID, client.RollbackDeployJSONRequestBody{
+		DeployId: deployID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return resp.JSON201, nil
+}
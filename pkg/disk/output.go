@@ -0,0 +1,62 @@
+package disk
+
+import (
+	"github.com/render-oss/cli/pkg/client"
+	disks "github.com/render-oss/cli/pkg/client/disks"
+)
+
+type ListOut struct {
+	Data []*disks.DiskDetails `json:"data"`
+}
+
+type GetOut struct {
+	Data *disks.DiskDetails `json:"data"`
+}
+
+type AddOut struct {
+	Data *disks.DiskDetails `json:"data"`
+}
+
+// ResizeOut is the result of resizing a disk. When Resized is false the
+// command only previewed the change.
+type ResizeOut struct {
+	Data *disks.DiskDetails `json:"data"`
+	Meta ResizeOutMeta      `json:"meta"`
+}
+
+type ResizeOutMeta struct {
+	BeforeGB int    `json:"beforeGB"`
+	AfterGB  int    `json:"afterGB"`
+	Resized  bool   `json:"resized"`
+	Message  string `json:"message,omitempty"`
+}
+
+type DeleteOut struct {
+	Data *disks.DiskDetails `json:"data"`
+	Meta DeleteOutMeta      `json:"meta"`
+}
+
+type DeleteOutMeta struct {
+	Deleted bool `json:"deleted"`
+}
+
+type SnapshotListOut struct {
+	Data []client.DiskSnapshot `json:"data"`
+	Meta SnapshotListOutMeta   `json:"meta"`
+}
+
+type SnapshotListOutMeta struct {
+	DiskID   string `json:"diskId"`
+	DiskName string `json:"diskName"`
+}
+
+type SnapshotRestoreOut struct {
+	Data *disks.DiskDetails     `json:"data"`
+	Meta SnapshotRestoreOutMeta `json:"meta"`
+}
+
+type SnapshotRestoreOutMeta struct {
+	SnapshotKey string  `json:"snapshotKey"`
+	InstanceID  *string `json:"instanceId,omitempty"`
+	Restored    bool    `json:"restored"`
+}
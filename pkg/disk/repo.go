@@ -0,0 +1,165 @@
+package disk
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/render-oss/cli/pkg/client"
+	disks "github.com/render-oss/cli/pkg/client/disks"
+	"github.com/render-oss/cli/pkg/config"
+	"github.com/render-oss/cli/pkg/pointers"
+	"github.com/render-oss/cli/pkg/tui"
+	"github.com/render-oss/cli/pkg/validate"
+)
+
+// Repo wraps the generated OpenAPI client for persistent disk endpoints.
+type Repo struct {
+	client *client.ClientWithResponses
+}
+
+func NewRepo(c *client.ClientWithResponses) *Repo {
+	return &Repo{client: c}
+}
+
+// ListDisks returns the disks in the active workspace.
+func (r *Repo) ListDisks(ctx context.Context, params *client.ListDisksParams) ([]*disks.DiskDetails, error) {
+	workspace, err := config.WorkspaceID()
+	if err != nil {
+		return nil, err
+	}
+
+	params.OwnerId = &client.OwnerIdParam{workspace}
+
+	return client.ListAll(ctx, params, r.listPage)
+}
+
+func (r *Repo) listPage(ctx context.Context, params *client.ListDisksParams) ([]*disks.DiskDetails, *client.Cursor, error) {
+	resp, err := r.client.ListDisksWithResponse(ctx, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, nil, err
+	}
+	if resp.JSON200 == nil || len(*resp.JSON200) == 0 {
+		return nil, nil, nil
+	}
+
+	res := *resp.JSON200
+	out := make([]*disks.DiskDetails, 0, len(res))
+	for _, d := range res {
+		out = append(out, &d.Disk)
+	}
+
+	return out, &res[len(res)-1].Cursor, nil
+}
+
+// ResolveDisk looks up a disk by ID (dsk-...) or exact name in the active
+// workspace. Disk names are only unique per service, so a name shared by
+// several disks is rejected.
+func (r *Repo) ResolveDisk(ctx context.Context, idOrName string) (*disks.DiskDetails, error) {
+	params := &client.ListDisksParams{}
+	if validate.IsDiskID(idOrName) {
+		params.DiskId = &disks.DiskIdQuery{idOrName}
+	} else {
+		params.Name = &client.NameParam{idOrName}
+	}
+
+	found, err := r.ListDisks(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []*disks.DiskDetails
+	for _, d := range found {
+		if d.Id == idOrName || d.Name == idOrName {
+			matches = append(matches, d)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, tui.UserFacingError{Message: fmt.Sprintf("disk '%s' not found in the active workspace", idOrName)}
+	case 1:
+		return matches[0], nil
+	default:
+		return nil, tui.UserFacingError{Message: fmt.Sprintf(
+			"Multiple disks found with name '%s'. Pass the disk ID to disambiguate.", idOrName,
+		)}
+	}
+}
+
+// The methods below do not validate workspace ownership, so callers must pass
+// an ID already resolved against the active workspace (see ResolveDisk).
+
+func (r *Repo) GetDisk(ctx context.Context, id string) (*disks.DiskDetails, error) {
+	resp, err := r.client.RetrieveDiskWithResponse(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+	return resp.JSON200, nil
+}
+
+func (r *Repo) AddDisk(ctx context.Context, body disks.DiskPOST) (*disks.DiskDetails, error) {
+	resp, err := r.client.AddDiskWithResponse(ctx, body)
+	if err != nil {
+		return nil, err
+	}
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+	return resp.JSON201, nil
+}
+
+// ResizeDisk sets the size of a disk in GB. Disks can only grow.
+func (r *Repo) ResizeDisk(ctx context.Context, id string, sizeGB int) (*disks.DiskDetails, error) {
+	resp, err := r.client.UpdateDiskWithResponse(ctx, id, disks.DiskPATCH{SizeGB: pointers.From(sizeGB)})
+	if err != nil {
+		return nil, err
+	}
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+	return resp.JSON200, nil
+}
+
+func (r *Repo) DeleteDisk(ctx context.Context, id string) error {
+	resp, err := r.client.DeleteDiskWithResponse(ctx, id)
+	if err != nil {
+		return err
+	}
+	return client.ErrorFromResponse(resp)
+}
+
+// ListSnapshots returns the snapshots available for a disk. The returned slice
+// is always non-nil.
+func (r *Repo) ListSnapshots(ctx context.Context, id string) ([]client.DiskSnapshot, error) {
+	resp, err := r.client.ListSnapshotsWithResponse(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+	if resp.JSON201 == nil {
+		return []client.DiskSnapshot{}, nil
+	}
+	return *resp.JSON201, nil
+}
+
+// RestoreSnapshot replaces the contents of a disk with a snapshot. The
+// service the disk is attached to is redeployed with the restored data.
+func (r *Repo) RestoreSnapshot(ctx context.Context, id string, body client.SnapshotRestorePOST) (*disks.DiskDetails, error) {
+	resp, err := r.client.RestoreSnapshotWithResponse(ctx, id, body)
+	if err != nil {
+		return nil, err
+	}
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+	return resp.JSON200, nil
+}
@@ -0,0 +1,59 @@
+package envgroup
+
+import (
+	"github.com/render-oss/cli/pkg/client"
+	"github.com/render-oss/cli/pkg/service"
+)
+
+type ListOut struct {
+	Data []*client.EnvGroupMeta `json:"data"`
+}
+
+// GetOut is the result of reading one env group. ShowValues only affects text
+// output; JSON and YAML always include values.
+type GetOut struct {
+	Data       *client.EnvGroup `json:"data"`
+	ShowValues bool             `json:"-"`
+}
+
+type CreateOut struct {
+	Data *client.EnvGroup `json:"data"`
+}
+
+type DeleteOut struct {
+	Data *client.EnvGroup `json:"data"`
+	Meta DeleteOutMeta    `json:"meta"`
+}
+
+type DeleteOutMeta struct {
+	Deleted bool   `json:"deleted"`
+	Message string `json:"message,omitempty"`
+}
+
+// EnvVarChangeOut is the result of set or unset. When Applied is false the
+// command only previewed the change.
+type EnvVarChangeOut struct {
+	Data *client.EnvGroup    `json:"data"`
+	Meta EnvVarChangeOutMeta `json:"meta"`
+}
+
+type EnvVarChangeOutMeta struct {
+	Applied     bool                  `json:"applied"`
+	Changes     service.EnvVarChanges `json:"changes"`
+	SecretFiles []string              `json:"secretFiles"`
+	Message     string                `json:"message,omitempty"`
+}
+
+// LinkOut is the result of link or unlink. Linked reports whether the service
+// is linked to the env group once the command finishes.
+type LinkOut struct {
+	Data *client.EnvGroup `json:"data"`
+	Meta LinkOutMeta      `json:"meta"`
+}
+
+type LinkOutMeta struct {
+	ServiceID string `json:"serviceId"`
+	Linked    bool   `json:"linked"`
+	Applied   bool   `json:"applied"`
+	Message   string `json:"message,omitempty"`
+}
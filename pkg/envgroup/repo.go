@@ -0,0 +1,165 @@
+package envgroup
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/render-oss/cli/pkg/client"
+	envvar "github.com/render-oss/cli/pkg/client/envvar"
+	"github.com/render-oss/cli/pkg/config"
+	"github.com/render-oss/cli/pkg/pointers"
+	"github.com/render-oss/cli/pkg/validate"
+)
+
+// listLimit is the page size requested from GET /env-groups. The endpoint
+// does not return cursors, so the CLI cannot page past the first response.
+const listLimit = 100
+
+// Repo wraps the generated OpenAPI client for env-group endpoints.
+type Repo struct {
+	client *client.ClientWithResponses
+}
+
+func NewRepo(c *client.ClientWithResponses) *Repo {
+	return &Repo{client: c}
+}
+
+// ListEnvGroups returns the env groups in the active workspace. The returned
+// slice is always non-nil.
+func (r *Repo) ListEnvGroups(ctx context.Context, params *client.ListEnvGroupsParams) ([]*client.EnvGroupMeta, error) {
+	workspace, err := config.WorkspaceID()
+	if err != nil {
+		return nil, err
+	}
+	if workspace != "" {
+		params.OwnerId = pointers.From([]string{workspace})
+	}
+	if params.Limit == nil {
+		params.Limit = pointers.From(listLimit)
+	}
+
+	resp, err := r.client.ListEnvGroupsWithResponse(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+
+	if resp.JSON200 == nil {
+		return []*client.EnvGroupMeta{}, nil
+	}
+	out := make([]*client.EnvGroupMeta, 0, len(*resp.JSON200))
+	for i := range *resp.JSON200 {
+		out = append(out, &(*resp.JSON200)[i])
+	}
+	return out, nil
+}
+
+// GetEnvGroup retrieves an env group and validates that it belongs to the
+// active workspace.
+func (r *Repo) GetEnvGroup(ctx context.Context, id string) (*client.EnvGroup, error) {
+	resp, err := r.client.RetrieveEnvGroupWithResponse(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, fmt.Errorf("env group lookup failed for %q: empty response", id)
+	}
+	if err := validate.WorkspaceMatches(resp.JSON200.OwnerId); err != nil {
+		return nil, err
+	}
+	return resp.JSON200, nil
+}
+
+func (r *Repo) CreateEnvGroup(ctx context.Context, body client.CreateEnvGroupJSONRequestBody) (*client.EnvGroup, error) {
+	if err := validate.WorkspaceMatches(body.OwnerId); err != nil {
+		return nil, err
+	}
+
+	resp, err := r.client.CreateEnvGroupWithResponse(ctx, body)
+	if err != nil {
+		return nil, err
+	}
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+	return resp.JSON201, nil
+}
+
+// The methods below do not re-fetch the env group to validate workspace
+// ownership, so callers must pass an id already resolved against the active
+// workspace (see Service.Resolve).
+
+func (r *Repo) DeleteEnvGroup(ctx context.Context, id string) error {
+	resp, err := r.client.DeleteEnvGroupWithResponse(ctx, id)
+	if err != nil {
+		return err
+	}
+	return client.ErrorFromResponse(resp)
+}
+
+// SetEnvVar adds or updates a single environment variable on the env group.
+func (r *Repo) SetEnvVar(ctx context.Context, id, key, value string) (*client.EnvGroup, error) {
+	var body client.UpdateEnvGroupEnvVarJSONRequestBody
+	if err := body.FromEnvVarValue(client.EnvVarValue{Value: value}); err != nil {
+		return nil, err
+	}
+
+	resp, err := r.client.UpdateEnvGroupEnvVarWithResponse(ctx, id, key, body)
+	if err != nil {
+		return nil, err
+	}
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+	return resp.JSON200, nil
+}
+
+func (r *Repo) DeleteEnvVar(ctx context.Context, id, key string) error {
+	resp, err := r.client.DeleteEnvGroupEnvVarWithResponse(ctx, id, key)
+	if err != nil {
+		return err
+	}
+	return client.ErrorFromResponse(resp)
+}
+
+// SetSecretFile adds or replaces a secret file on the env group.
+func (r *Repo) SetSecretFile(ctx context.Context, id string, file envvar.SecretFileInput) (*client.EnvGroup, error) {
+	resp, err := r.client.UpdateEnvGroupSecretFileWithResponse(ctx, id, file.Name, client.UpdateEnvGroupSecretFileJSONRequestBody{
+		Content: pointers.From(file.Content),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+	return resp.JSON200, nil
+}
+
+func (r *Repo) LinkService(ctx context.Context, id, serviceID string) (*client.EnvGroup, error) {
+	resp, err := r.client.LinkServiceToEnvGroupWithResponse(ctx, id, serviceID)
+	if err != nil {
+		return nil, err
+	}
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+	return resp.JSON200, nil
+}
+
+func (r *Repo) UnlinkService(ctx context.Context, id, serviceID string) error {
+	resp, err := r.client.UnlinkServiceFromEnvGroupWithResponse(ctx, id, serviceID)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode() == http.StatusNotFound {
+		return notLinkedError(id, serviceID)
+	}
+	return client.ErrorFromResponse(resp)
+}
@@ -0,0 +1,205 @@
+package envgroup
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/render-oss/cli/pkg/client"
+	envvar "github.com/render-oss/cli/pkg/client/envvar"
+	"github.com/render-oss/cli/pkg/config"
+	"github.com/render-oss/cli/pkg/resolve"
+	"github.com/render-oss/cli/pkg/service"
+	rstrings "github.com/render-oss/cli/pkg/strings"
+	"github.com/render-oss/cli/pkg/tui"
+	"github.com/render-oss/cli/pkg/validate"
+)
+
+// Service holds business logic for env groups: resolving names against the
+// active workspace and resolving related services and environments before
+// delegating to the Repo.
+type Service struct {
+	repo        *Repo
+	serviceRepo *service.Repo
+	resolver    *resolve.Resolver
+}
+
+func NewService(repo *Repo, serviceRepo *service.Repo, resolver *resolve.Resolver) *Service {
+	return &Service{
+		repo:        repo,
+		serviceRepo: serviceRepo,
+		resolver:    resolver,
+	}
+}
+
+// List returns the env groups in the active workspace, optionally narrowed to
+// a single environment.
+func (s *Service) List(ctx context.Context, input ListInput) ([]*client.EnvGroupMeta, error) {
+	params := &client.ListEnvGroupsParams{}
+	if input.ProjectIDOrName != nil || input.EnvironmentIDOrName != nil {
+		scope, err := s.resolver.ResolveScopeInActiveWorkspace(ctx, resolve.ActiveWorkspaceScopeInput{
+			ProjectIDOrName:     input.ProjectIDOrName,
+			EnvironmentIDOrName: input.EnvironmentIDOrName,
+		})
+		if err != nil {
+			return nil, err
+		}
+		envIDs := scope.Project.EnvironmentIds
+		if scope.Environment != nil {
+			envIDs = []string{scope.Environment.Id}
+		}
+		if len(envIDs) == 0 {
+			return []*client.EnvGroupMeta{}, nil
+		}
+		envParam := client.EnvironmentIdParam(envIDs)
+		params.EnvironmentId = &envParam
+	}
+	return s.repo.ListEnvGroups(ctx, params)
+}
+
+// Resolve looks up an env group by ID (evg-...) or exact name in the active
+// workspace and returns its full detail, including variables and links.
+func (s *Service) Resolve(ctx context.Context, idOrName string) (*client.EnvGroup, error) {
+	query := strings.TrimSpace(idOrName)
+	if query == "" {
+		return nil, fmt.Errorf("env group ID or name is required")
+	}
+
+	if validate.IsEnvGroupID(query) {
+		resp, err := s.repo.client.RetrieveEnvGroupWithResponse(ctx, query)
+		if err != nil {
+			return nil, err
+		}
+		// On 404, fall through to name search in case a name looks like an ID.
+		if resp.StatusCode() != http.StatusNotFound {
+			if err := client.ErrorFromResponse(resp); err != nil {
+				return nil, err
+			}
+			if resp.JSON200 == nil {
+				return nil, fmt.Errorf("env group lookup failed for %q: empty response", query)
+			}
+			if err := validate.WorkspaceMatches(resp.JSON200.OwnerId); err != nil {
+				return nil, err
+			}
+			return resp.JSON200, nil
+		}
+	}
+
+	groups, err := s.repo.ListEnvGroups(ctx, &client.ListEnvGroupsParams{
+		Name: &client.NameParam{query},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []*client.EnvGroupMeta
+	for _, g := range groups {
+		if g.Name == query {
+			matches = append(matches, g)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, notFoundError(query)
+	case 1:
+		return s.repo.GetEnvGroup(ctx, matches[0].Id)
+	default:
+		return nil, tui.UserFacingError{Message: fmt.Sprintf(
+			"Multiple env groups found with name '%s'. Pass the env group ID to disambiguate.", query,
+		)}
+	}
+}
+
+// ResolveServiceID resolves a service ID or name in the active workspace.
+func (s *Service) ResolveServiceID(ctx context.Context, idOrName string) (string, error) {
+	return s.serviceRepo.ResolveServiceIDFromNameOrID(ctx, idOrName)
+}
+
+// Create creates an env group in the active workspace. Environment and
+// service selectors are resolved before the request is sent.
+func (s *Service) Create(ctx context.Context, input CreateInput) (*client.EnvGroup, error) {
+	workspaceID, err := config.WorkspaceID()
+	if err != nil {
+		return nil, err
+	}
+
+	body := client.CreateEnvGroupJSONRequestBody{
+		Name:    input.Name,
+		OwnerId: workspaceID,
+		EnvVars: make(envvar.EnvVarInputArray, 0, len(input.EnvVars)),
+	}
+
+	if input.EnvironmentIDOrName != nil {
+		scope, err := s.resolver.ResolveScopeInActiveWorkspace(ctx, resolve.ActiveWorkspaceScopeInput{
+			ProjectIDOrName:     input.ProjectIDOrName,
+			EnvironmentIDOrName: input.EnvironmentIDOrName,
+		})
+		if err != nil {
+			return nil, err
+		}
+		body.EnvironmentId = &scope.Environment.Id
+	}
+
+	for _, ev := range input.EnvVars {
+		var in envvar.EnvVarInput
+		if err := in.FromEnvVarKeyValue(envvar.EnvVarKeyValue{Key: ev.Key, Value: ev.Value}); err != nil {
+			return nil, err
+		}
+		body.EnvVars = append(body.EnvVars, in)
+	}
+	if len(input.SecretFiles) > 0 {
+		body.SecretFiles = &input.SecretFiles
+	}
+
+	if len(input.ServiceIDsOrNames) > 0 {
+		serviceIDs := make([]string, 0, len(input.ServiceIDsOrNames))
+		for _, idOrName := range input.ServiceIDsOrNames {
+			id, err := s.ResolveServiceID(ctx, idOrName)
+			if err != nil {
+				return nil, err
+			}
+			serviceIDs = append(serviceIDs, id)
+		}
+		body.ServiceIds = &serviceIDs
+	}
+
+	return s.repo.CreateEnvGroup(ctx, body)
+}
+
+// ListInput narrows List to a project or environment.
+type ListInput struct {
+	ProjectIDOrName     *string
+	EnvironmentIDOrName *string
+}
+
+// CreateInput is the parsed input for Create.
+type CreateInput struct {
+	Name                string
+	EnvVars             []client.EnvVar
+	SecretFiles         []envvar.SecretFileInput
+	ProjectIDOrName     *string
+	EnvironmentIDOrName *string
+	ServiceIDsOrNames   []string
+}
+
+func notFoundError(idOrName string) tui.UserFacingError {
+	if validate.IsEnvGroupID(idOrName) {
+		return tui.UserFacingError{Message: fmt.Sprintf("No env group with ID '%s'.", idOrName)}
+	}
+	id, _ := config.WorkspaceID()
+	name, _ := config.WorkspaceName()
+	workspace := rstrings.ResourceLabel(name, id)
+	if workspace == "" {
+		return tui.UserFacingError{Message: fmt.Sprintf("No env group named '%s'.", idOrName)}
+	}
+	return tui.UserFacingError{Message: fmt.Sprintf(
+		"No env group named '%s' in workspace %s. To search another workspace, run `render workspace set <name|ID>`, or pass the env group ID instead.",
+		idOrName, workspace,
+	)}
+}
+
+func notLinkedError(envGroupID, serviceID string) tui.UserFacingError {
+	return tui.UserFacingError{Message: fmt.Sprintf("Service %s is not linked to env group %s.", serviceID, envGroupID)}
+}
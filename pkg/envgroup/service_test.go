@@ -0,0 +1,74 @@
+package envgroup_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	renderapi "github.com/render-oss/cli/internal/fakes/renderapi"
+	"github.com/render-oss/cli/internal/testids"
+	"github.com/render-oss/cli/pkg/client"
+	"github.com/render-oss/cli/pkg/config"
+	"github.com/render-oss/cli/pkg/envgroup"
+	"github.com/render-oss/cli/pkg/environment"
+	"github.com/render-oss/cli/pkg/owner"
+	"github.com/render-oss/cli/pkg/project"
+	"github.com/render-oss/cli/pkg/resolve"
+	"github.com/render-oss/cli/pkg/service"
+)
+
+func newTestService(t *testing.T, server *renderapi.Server) *envgroup.Service {
+	t.Helper()
+	c, err := client.NewClientWithResponses(server.URL())
+	require.NoError(t, err)
+	resolver := resolve.New(owner.NewRepo(c), project.NewRepo(c), environment.NewRepo(c))
+	return envgroup.NewService(envgroup.NewRepo(c), service.NewRepo(c), resolver)
+}
+
+func TestServiceResolve(t *testing.T) {
+	activeWorkspace := testids.WorkspaceID("active")
+
+	t.Run("resolves by ID", func(t *testing.T) {
+		server := renderapi.NewServer(t)
+		g := server.EnvGroups.Add(renderapi.NewEnvGroup(client.EnvGroup{OwnerId: activeWorkspace}))
+		t.Setenv("RENDER_WORKSPACE", activeWorkspace)
+
+		got, err := newTestService(t, server).Resolve(context.Background(), g.Id)
+		require.NoError(t, err)
+		assert.Equal(t, g.Id, got.Id)
+	})
+
+	t.Run("resolves by exact name in the active workspace", func(t *testing.T) {
+		server := renderapi.NewServer(t)
+		g := server.EnvGroups.Add(renderapi.NewEnvGroup(client.EnvGroup{Name: "shared", OwnerId: activeWorkspace}))
+		server.EnvGroups.Add(renderapi.NewEnvGroup(client.EnvGroup{Name: "shared", OwnerId: testids.WorkspaceID("other")}))
+		t.Setenv("RENDER_WORKSPACE", activeWorkspace)
+
+		got, err := newTestService(t, server).Resolve(context.Background(), " shared ")
+		require.NoError(t, err)
+		assert.Equal(t, g.Id, got.Id)
+	})
+
+	t.Run("errors on duplicate names", func(t *testing.T) {
+		server := renderapi.NewServer(t)
+		server.EnvGroups.Add(renderapi.NewEnvGroup(client.EnvGroup{Name: "shared", OwnerId: activeWorkspace}))
+		server.EnvGroups.Add(renderapi.NewEnvGroup(client.EnvGroup{Name: "shared", OwnerId: activeWorkspace}))
+		t.Setenv("RENDER_WORKSPACE", activeWorkspace)
+
+		_, err := newTestService(t, server).Resolve(context.Background(), "shared")
+		require.ErrorContains(t, err, "Multiple env groups")
+	})
+
+	t.Run("rejects an ID from another workspace", func(t *testing.T) {
+		server := renderapi.NewServer(t)
+		g := server.EnvGroups.Add(renderapi.NewEnvGroup(client.EnvGroup{OwnerId: testids.WorkspaceID("other")}))
+		t.Setenv("RENDER_WORKSPACE", activeWorkspace)
+		t.Setenv("RENDER_CLI_CONFIG_PATH", t.TempDir()+"/config.yaml")
+		require.NoError(t, (&config.Config{Workspace: activeWorkspace}).Persist())
+
+		_, err := newTestService(t, server).Resolve(context.Background(), g.Id)
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,139 @@
+package metrics
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/render-oss/cli/pkg/validate"
+)
+
+// Metric is a metric name accepted by `render metrics --metric`.
+type Metric string
+
+const (
+	CPU               Metric = "cpu"
+	Memory            Metric = "memory"
+	HTTPLatency       Metric = "http-latency"
+	HTTPRequests      Metric = "http-requests"
+	Bandwidth         Metric = "bandwidth"
+	InstanceCount     Metric = "instance-count"
+	ActiveConnections Metric = "active-connections"
+	ReplicationLag    Metric = "replication-lag"
+)
+
+// All lists every supported metric in display order.
+var All = []Metric{
+	CPU,
+	Memory,
+	HTTPLatency,
+	HTTPRequests,
+	Bandwidth,
+	InstanceCount,
+	ActiveConnections,
+	ReplicationLag,
+}
+
+// Default is the metric set shown when --metric is not passed.
+var Default = []Metric{CPU, Memory}
+
+// ResourceKind groups resource IDs by which metrics the API serves for them.
+type ResourceKind string
+
+const (
+	KindService  ResourceKind = "service"
+	KindPostgres ResourceKind = "postgres"
+	KindKeyValue ResourceKind = "keyvalue"
+)
+
+var supportedKinds = map[Metric][]ResourceKind{
+	CPU:               {KindService, KindPostgres, KindKeyValue},
+	Memory:            {KindService, KindPostgres, KindKeyValue},
+	HTTPLatency:       {KindService},
+	HTTPRequests:      {KindService},
+	Bandwidth:         {KindService},
+	InstanceCount:     {KindService},
+	ActiveConnections: {KindPostgres, KindKeyValue},
+	ReplicationLag:    {KindPostgres},
+}
+
+// Names returns the metric names, for help text and enum flags.
+func Names() []string {
+	names := make([]string, 0, len(All))
+	for _, m := range All {
+		names = append(names, string(m))
+	}
+	return names
+}
+
+// Title is the human-readable metric name used in chart headers.
+func (m Metric) Title() string {
+	switch m {
+	case CPU:
+		return "CPU"
+	case Memory:
+		return "Memory"
+	case HTTPLatency:
+		return "HTTP latency (p95)"
+	case HTTPRequests:
+		return "HTTP requests"
+	case Bandwidth:
+		return "Bandwidth"
+	case InstanceCount:
+		return "Instance count"
+	case ActiveConnections:
+		return "Active connections"
+	case ReplicationLag:
+		return "Replication lag"
+	default:
+		return string(m)
+	}
+}
+
+// ParseMetrics validates metric names, de-duplicating while preserving
+// order. An empty list yields Default.
+func ParseMetrics(raw []string) ([]Metric, error) {
+	if len(raw) == 0 {
+		return slices.Clone(Default), nil
+	}
+
+	parsed := make([]Metric, 0, len(raw))
+	for _, r := range raw {
+		m := Metric(strings.ToLower(strings.TrimSpace(r)))
+		if !slices.Contains(All, m) {
+			return nil, fmt.Errorf("unknown metric %q: expected one of %s", r, strings.Join(Names(), ", "))
+		}
+		if !slices.Contains(parsed, m) {
+			parsed = append(parsed, m)
+		}
+	}
+	return parsed, nil
+}
+
+// KindForResourceID infers the resource kind from an ID prefix.
+func KindForResourceID(id string) (ResourceKind, error) {
+	switch {
+	case validate.IsServiceID(id), validate.IsCronJobID(id):
+		return KindService, nil
+	case validate.IsPostgresID(id):
+		return KindPostgres, nil
+	case validate.IsKeyValueID(id):
+		return KindKeyValue, nil
+	default:
+		return "", fmt.Errorf("metrics are not available for resource %s", id)
+	}
+}
+
+// CheckSupported errors if any metric is not served for resources of kind.
+func CheckSupported(kind ResourceKind, ms []Metric) error {
+	var unsupported []string
+	for _, m := range ms {
+		if !slices.Contains(supportedKinds[m], kind) {
+			unsupported = append(unsupported, string(m))
+		}
+	}
+	if len(unsupported) == 0 {
+		return nil
+	}
+	return fmt.Errorf("metrics not available for %s resources: %s", kind, strings.Join(unsupported, ", "))
+}
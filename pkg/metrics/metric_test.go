@@ -0,0 +1,63 @@
+package metrics_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/render-oss/cli/internal/testids"
+	metricsclient "github.com/render-oss/cli/pkg/client/metrics"
+	"github.com/render-oss/cli/pkg/metrics"
+)
+
+func TestParseMetrics(t *testing.T) {
+	t.Run("defaults when empty", func(t *testing.T) {
+		got, err := metrics.ParseMetrics(nil)
+		require.NoError(t, err)
+		assert.Equal(t, metrics.Default, got)
+	})
+
+	t.Run("normalizes and de-duplicates", func(t *testing.T) {
+		got, err := metrics.ParseMetrics([]string{"HTTP-Latency", "cpu", "http-latency"})
+		require.NoError(t, err)
+		assert.Equal(t, []metrics.Metric{metrics.HTTPLatency, metrics.CPU}, got)
+	})
+
+	t.Run("rejects unknown names", func(t *testing.T) {
+		_, err := metrics.ParseMetrics([]string{"disk"})
+		require.ErrorContains(t, err, `unknown metric "disk"`)
+	})
+}
+
+func TestCheckSupported(t *testing.T) {
+	kind, err := metrics.KindForResourceID(testids.PostgresID("db"))
+	require.NoError(t, err)
+	assert.Equal(t, metrics.KindPostgres, kind)
+
+	require.NoError(t, metrics.CheckSupported(kind, []metrics.Metric{metrics.CPU, metrics.ReplicationLag}))
+	require.ErrorContains(t, metrics.CheckSupported(kind, []metrics.Metric{metrics.HTTPRequests, metrics.Bandwidth}), "http-requests, bandwidth")
+
+	kind, err = metrics.KindForResourceID(testids.KeyValueID("cache"))
+	require.NoError(t, err)
+	require.Error(t, metrics.CheckSupported(kind, []metrics.Metric{metrics.ReplicationLag}))
+}
+
+func TestSeriesLabel(t *testing.T) {
+	assert.Equal(t, "srv-1-abc", metrics.SeriesLabel(metricsclient.TimeSeries{Labels: []metricsclient.Label{
+		{Field: "resource", Value: "srv-1"},
+		{Field: "instance", Value: "srv-1-abc"},
+	}}))
+	assert.Equal(t, "method=GET statusCode=200", metrics.SeriesLabel(metricsclient.TimeSeries{Labels: []metricsclient.Label{
+		{Field: "statusCode", Value: "200"},
+		{Field: "service", Value: "srv-1"},
+		{Field: "method", Value: "GET"},
+	}}))
+	assert.Equal(t, "all", metrics.SeriesLabel(metricsclient.TimeSeries{}))
+}
+
+func TestFormatValue(t *testing.T) {
+	assert.Equal(t, "0.123 cpu", metrics.FormatValue(0.12345, "cpu"))
+	assert.Equal(t, "52428800 bytes", metrics.FormatValue(52428800, "bytes"))
+	assert.Equal(t, "12.5", metrics.FormatValue(12.5, ""))
+}
@@ -0,0 +1,79 @@
+package metrics
+
+import (
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	metricsclient "github.com/render-oss/cli/pkg/client/metrics"
+)
+
+// Out is the JSON/YAML contract for `render metrics`.
+type Out struct {
+	Data []Result `json:"data"`
+	Meta OutMeta  `json:"meta"`
+}
+
+type OutMeta struct {
+	ResourceID        string     `json:"resourceId"`
+	StartTime         *time.Time `json:"startTime,omitempty"`
+	EndTime           *time.Time `json:"endTime,omitempty"`
+	ResolutionSeconds int        `json:"resolutionSeconds,omitempty"`
+}
+
+// Result holds every time series returned for one metric, usually one per
+// instance.
+type Result struct {
+	Metric Metric                     `json:"metric"`
+	Series []metricsclient.TimeSeries `json:"series"`
+}
+
+// SeriesLabel names a time series for display. It prefers the instance label
+// and falls back to the remaining labels, excluding the resource itself.
+func SeriesLabel(ts metricsclient.TimeSeries) string {
+	var parts []string
+	for _, l := range ts.Labels {
+		if l.Field == "instance" {
+			return l.Value
+		}
+		if l.Field == "resource" || l.Field == "service" {
+			continue
+		}
+		parts = append(parts, l.Field+"="+l.Value)
+	}
+	if len(parts) == 0 {
+		return "all"
+	}
+	sort.Strings(parts)
+	return strings.Join(parts, " ")
+}
+
+// SeriesValues extracts the datapoint values of ts in timestamp order.
+func SeriesValues(ts metricsclient.TimeSeries) []float64 {
+	points := make([]metricsclient.TimeSeriesValue, len(ts.Values))
+	copy(points, ts.Values)
+	sort.Slice(points, func(i, j int) bool {
+		return points[i].Timestamp.Before(points[j].Timestamp)
+	})
+
+	values := make([]float64, 0, len(points))
+	for _, p := range points {
+		values = append(values, float64(p.Value))
+	}
+	return values
+}
+
+// FormatValue renders v followed by unit. Values of 100 or more are rounded to
+// whole numbers; smaller values keep three significant digits.
+func FormatValue(v float64, unit string) string {
+	s := strconv.FormatFloat(v, 'g', 3, 64)
+	if math.Abs(v) >= 100 {
+		s = strconv.FormatFloat(v, 'f', 0, 64)
+	}
+	if unit == "" {
+		return s
+	}
+	return s + " " + unit
+}
@@ -0,0 +1,101 @@
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/render-oss/cli/pkg/client"
+	metricsclient "github.com/render-oss/cli/pkg/client/metrics"
+	"github.com/render-oss/cli/pkg/pointers"
+)
+
+// latencyQuantile is the percentile requested for HTTPLatency.
+const latencyQuantile metricsclient.Quantile = 0.95
+
+type Repo struct {
+	client *client.ClientWithResponses
+}
+
+func NewRepo(c *client.ClientWithResponses) *Repo {
+	return &Repo{client: c}
+}
+
+// QueryParams bounds a metrics query. Nil times use the API defaults of the
+// last hour; a zero resolution lets the API pick one.
+type QueryParams struct {
+	ResourceID        string
+	StartTime         *time.Time
+	EndTime           *time.Time
+	ResolutionSeconds int
+}
+
+func (p QueryParams) resolution() *metricsclient.ResolutionParam {
+	if p.ResolutionSeconds <= 0 {
+		return nil
+	}
+	return pointers.From(metricsclient.ResolutionParam(p.ResolutionSeconds))
+}
+
+// Query fetches the time series for one metric. Bandwidth does not accept a
+// resolution, so ResolutionSeconds is ignored for it.
+func (r *Repo) Query(ctx context.Context, metric Metric, params QueryParams) ([]metricsclient.TimeSeries, error) {
+	resource := pointers.From(params.ResourceID)
+	resolution := params.resolution()
+
+	var (
+		resp interface {
+			StatusCode() int
+			GetJSON200() *metricsclient.Metrics200Response
+		}
+		err error
+	)
+	switch metric {
+	case CPU:
+		resp, err = r.client.GetCpuWithResponse(ctx, &client.GetCpuParams{
+			StartTime: params.StartTime, EndTime: params.EndTime, ResolutionSeconds: resolution, Resource: resource,
+		})
+	case Memory:
+		resp, err = r.client.GetMemoryWithResponse(ctx, &client.GetMemoryParams{
+			StartTime: params.StartTime, EndTime: params.EndTime, ResolutionSeconds: resolution, Resource: resource,
+		})
+	case HTTPLatency:
+		resp, err = r.client.GetHttpLatencyWithResponse(ctx, &client.GetHttpLatencyParams{
+			StartTime: params.StartTime, EndTime: params.EndTime, ResolutionSeconds: resolution, Resource: resource,
+			Quantile: pointers.From(latencyQuantile),
+		})
+	case HTTPRequests:
+		resp, err = r.client.GetHttpRequestsWithResponse(ctx, &client.GetHttpRequestsParams{
+			StartTime: params.StartTime, EndTime: params.EndTime, ResolutionSeconds: resolution, Resource: resource,
+		})
+	case Bandwidth:
+		resp, err = r.client.GetBandwidthWithResponse(ctx, &client.GetBandwidthParams{
+			StartTime: params.StartTime, EndTime: params.EndTime, Resource: resource,
+		})
+	case InstanceCount:
+		resp, err = r.client.GetInstanceCountWithResponse(ctx, &client.GetInstanceCountParams{
+			StartTime: params.StartTime, EndTime: params.EndTime, ResolutionSeconds: resolution, Resource: resource,
+		})
+	case ActiveConnections:
+		resp, err = r.client.GetActiveConnectionsWithResponse(ctx, &client.GetActiveConnectionsParams{
+			StartTime: params.StartTime, EndTime: params.EndTime, ResolutionSeconds: resolution, Resource: resource,
+		})
+	case ReplicationLag:
+		resp, err = r.client.GetReplicationLagWithResponse(ctx, &client.GetReplicationLagParams{
+			StartTime: params.StartTime, EndTime: params.EndTime, ResolutionSeconds: resolution, Resource: resource,
+		})
+	default:
+		return nil, fmt.Errorf("unknown metric %q", metric)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+	if resp.GetJSON200() == nil {
+		return []metricsclient.TimeSeries{}, nil
+	}
+	return *resp.GetJSON200(), nil
+}
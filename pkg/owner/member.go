@@ -0,0 +1,73 @@
+package owner
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/render-oss/cli/pkg/client"
+	"github.com/render-oss/cli/pkg/tui"
+)
+
+const userIDPrefix = "usr-"
+
+// ListMembers returns the members of the workspace with the given ID.
+func (r *Repo) ListMembers(ctx context.Context, ownerID string) ([]*client.TeamMember, error) {
+	resp, err := r.client.RetrieveOwnerMembersWithResponse(ctx, ownerID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return []*client.TeamMember{}, nil
+	}
+
+	members := make([]*client.TeamMember, 0, len(*resp.JSON200))
+	for _, m := range *resp.JSON200 {
+		members = append(members, &m)
+	}
+	return members, nil
+}
+
+// ResolveMember finds a workspace member by user ID (usr-...) or email.
+// Emails are matched case-insensitively.
+func (r *Repo) ResolveMember(ctx context.Context, ownerID, idOrEmail string) (*client.TeamMember, error) {
+	members, err := r.ListMembers(ctx, ownerID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, m := range members {
+		if strings.HasPrefix(idOrEmail, userIDPrefix) && m.UserId == idOrEmail {
+			return m, nil
+		}
+		if strings.EqualFold(m.Email, idOrEmail) {
+			return m, nil
+		}
+	}
+	return nil, tui.UserFacingError{Message: fmt.Sprintf("member '%s' not found in the active workspace", idOrEmail)}
+}
+
+func (r *Repo) UpdateMemberRole(ctx context.Context, ownerID, userID string, role client.TeamMemberRole) (*client.TeamMember, error) {
+	resp, err := r.client.UpdateWorkspaceMemberWithResponse(ctx, ownerID, userID, client.UpdateWorkspaceMemberJSONRequestBody{Role: role})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+	return resp.JSON200, nil
+}
+
+func (r *Repo) RemoveMember(ctx context.Context, ownerID, userID string) error {
+	resp, err := r.client.RemoveWorkspaceMemberWithResponse(ctx, ownerID, userID)
+	if err != nil {
+		return err
+	}
+
+	return client.ErrorFromResponse(resp)
+}
@@ -0,0 +1,28 @@
+package owner
+
+import (
+	"github.com/render-oss/cli/pkg/client"
+)
+
+type MembersOut struct {
+	Data []*client.TeamMember `json:"data"`
+}
+
+type MemberRoleOut struct {
+	Data *client.TeamMember `json:"data"`
+	Meta MemberRoleOutMeta  `json:"meta"`
+}
+
+type MemberRoleOutMeta struct {
+	PreviousRole client.TeamMemberRole `json:"previousRole"`
+}
+
+type MemberRemoveOut struct {
+	Data *client.TeamMember  `json:"data"`
+	Meta MemberRemoveOutMeta `json:"meta"`
+}
+
+type MemberRemoveOutMeta struct {
+	Removed bool   `json:"removed"`
+	Message string `json:"message,omitempty"`
+}
@@ -0,0 +1,85 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"path"
+	"slices"
+
+	pgclient "github.com/render-oss/cli/pkg/client/postgres"
+)
+
+// ExportDownload describes an export written to a local file.
+type ExportDownload struct {
+	ExportID  string `json:"exportId"`
+	LocalPath string `json:"localPath"`
+	SizeBytes int64  `json:"sizeBytes"`
+}
+
+// ListExports returns a database's exports, newest first.
+func (s *Service) ListExports(ctx context.Context, id string) ([]pgclient.PostgresExport, error) {
+	exports, err := s.repo.ListPostgresExports(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	slices.SortFunc(exports, func(a, b pgclient.PostgresExport) int {
+		return b.CreatedAt.Compare(a.CreatedAt)
+	})
+	return exports, nil
+}
+
+// CreateExport starts an export of a database. Exports run asynchronously and
+// show up in ListExports with a download URL once they finish.
+func (s *Service) CreateExport(ctx context.Context, id string) error {
+	return s.repo.CreatePostgresExport(ctx, id)
+}
+
+// FindExport returns the export with the given ID, or the newest export that
+// can be downloaded when exportID is empty.
+func (s *Service) FindExport(ctx context.Context, id, exportID string) (*pgclient.PostgresExport, error) {
+	exports, err := s.ListExports(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	for _, export := range exports {
+		if exportID == "" && export.Url != nil {
+			return &export, nil
+		}
+		if exportID != "" && export.Id == exportID {
+			if export.Url == nil {
+				return nil, fmt.Errorf("export %s is not ready to download yet", exportID)
+			}
+			return &export, nil
+		}
+	}
+	if exportID != "" {
+		return nil, fmt.Errorf("export %s not found", exportID)
+	}
+	return nil, errors.New("no exports are ready to download; create one with 'render pg export create'")
+}
+
+// OpenExport starts downloading an export. It returns the export's contents
+// and their size in bytes, or -1 if the size is unknown.
+func (s *Service) OpenExport(ctx context.Context, export *pgclient.PostgresExport) (io.ReadCloser, int64, error) {
+	if export.Url == nil {
+		return nil, 0, fmt.Errorf("export %s is not ready to download yet", export.Id)
+	}
+	return s.repo.OpenExport(ctx, *export.Url)
+}
+
+// ExportFileName returns the file name an export is saved under by default:
+// the last element of its download URL, or the export's ID if the URL has no
+// usable name.
+func ExportFileName(export *pgclient.PostgresExport) string {
+	if export.Url != nil {
+		if u, err := url.Parse(*export.Url); err == nil {
+			if name := path.Base(u.Path); name != "." && name != "/" {
+				return name
+			}
+		}
+	}
+	return export.Id
+}
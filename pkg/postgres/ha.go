@@ -0,0 +1,196 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/render-oss/cli/pkg/client"
+	metricsclient "github.com/render-oss/cli/pkg/client/metrics"
+	"github.com/render-oss/cli/pkg/pointers"
+)
+
+// ErrHAWaitTimeout is returned by Service.WaitForHealthy when the database
+// doesn't report healthy before the timeout.
+var ErrHAWaitTimeout = errors.New("timed out waiting for the database to become healthy")
+
+// HAOperation is a high-availability operation on a database.
+type HAOperation string
+
+const (
+	// HAFailover switches a highly available primary over to its standby.
+	HAFailover HAOperation = "failover"
+	// HAPromote turns a read replica into a standalone primary.
+	HAPromote HAOperation = "promote"
+	// HAReplicate sets up logical replication from a primary.
+	HAReplicate HAOperation = "replicate"
+)
+
+// Check reports why op can't run on pg in its current state. It returns nil
+// when op can run.
+func (op HAOperation) Check(pg *client.PostgresDetail) error {
+	switch op {
+	case HAFailover:
+		if pg.Role == client.Replica {
+			return fmt.Errorf("%s is a read replica; fail over its primary %s instead", pg.Name, pointers.StringValue(pg.PrimaryPostgresID))
+		}
+		if !IsHAEligible(string(pg.Plan)) {
+			return fmt.Errorf("%s is on the %s plan, which doesn't support high availability; failover requires a Pro or Accelerated plan", pg.Name, PlanLabel(string(pg.Plan)))
+		}
+		if !pg.HighAvailabilityEnabled {
+			return fmt.Errorf("%s doesn't have high availability enabled, so it has no standby to fail over to; enable it with 'render pg update %s --high-availability'", pg.Name, pg.Id)
+		}
+	case HAPromote:
+		if pg.Role != client.Replica {
+			return fmt.Errorf("%s is not a read replica; only read replicas can be promoted", pg.Name)
+		}
+	case HAReplicate:
+		if pg.Role == client.Replica {
+			return fmt.Errorf("%s is a read replica; set up replication on its primary %s instead", pg.Name, pointers.StringValue(pg.PrimaryPostgresID))
+		}
+	default:
+		return fmt.Errorf("unknown operation %q", op)
+	}
+
+	if pg.Status != client.DatabaseStatusAvailable {
+		return fmt.Errorf("%s is %s; %s needs it to be %s", pg.Name, pg.Status, op, client.DatabaseStatusAvailable)
+	}
+	return nil
+}
+
+// StandbyStatusWindow is how recently replication lag must have been reported
+// for CheckStandby to consider a standby or read replica to be streaming.
+const StandbyStatusWindow = 5 * time.Minute
+
+// CheckStandby reports why the database that takes over from pg in op isn't
+// ready: pg's standby for a failover, or the read replica pg itself for a
+// promotion. lag is pg's replication lag time series; a database that hasn't
+// reported any in the last StandbyStatusWindow before now isn't streaming
+// changes and could lose writes when it takes over. A standby can't be
+// queried on its own, so for a failover the lag the primary reports for it
+// is only a proxy: it shows the standby was streaming recently, not that it
+// is healthy now. It returns nil for operations that don't hand over to
+// another database.
+func (op HAOperation) CheckStandby(pg *client.PostgresDetail, lag []metricsclient.TimeSeries, now time.Time) error {
+	var problem string
+	switch op {
+	case HAFailover:
+		problem = fmt.Sprintf("%s hasn't reported replication lag for its standby in the last %d minutes, so the standby may not be streaming changes",
+			pg.Name, int(StandbyStatusWindow.Minutes()))
+	case HAPromote:
+		problem = fmt.Sprintf("%s hasn't reported replication lag in the last %d minutes, so it may not be streaming changes",
+			pg.Name, int(StandbyStatusWindow.Minutes()))
+	default:
+		return nil
+	}
+
+	since := now.Add(-StandbyStatusWindow)
+	for _, series := range lag {
+		for _, value := range series.Values {
+			if !value.Timestamp.Before(since) {
+				return nil
+			}
+		}
+	}
+	return fmt.Errorf("%s; check it in the Render Dashboard, or pass --skip-standby-check to %s anyway", problem, op.verb())
+}
+
+func (op HAOperation) verb() string {
+	if op == HAFailover {
+		return "fail over"
+	}
+	return string(op)
+}
+
+// Healthy reports whether pg has finished op and is accepting connections.
+func (op HAOperation) Healthy(pg *client.PostgresDetail) bool {
+	if op == HAPromote && pg.Role != client.Primary {
+		return false
+	}
+	return pg.Status == client.DatabaseStatusAvailable
+}
+
+// Failover starts a failover to the database's standby and returns the
+// database's state once the API has accepted it.
+func (s *Service) Failover(ctx context.Context, id string) (*client.PostgresDetail, error) {
+	if err := s.repo.FailoverPostgres(ctx, id); err != nil {
+		return nil, err
+	}
+	return s.repo.GetPostgres(ctx, id)
+}
+
+// Promote starts promoting a read replica to a standalone primary and returns
+// the database's state once the API has accepted it.
+func (s *Service) Promote(ctx context.Context, id string) (*client.PostgresDetail, error) {
+	if err := s.repo.PromotePostgres(ctx, id); err != nil {
+		return nil, err
+	}
+	return s.repo.GetPostgres(ctx, id)
+}
+
+// SetupReplication creates a publication, and optionally a replication slot,
+// for logical replication and returns the database's state once the API has
+// accepted it.
+func (s *Service) SetupReplication(ctx context.Context, id string, body client.SetupPostgresReplicationJSONRequestBody) (*client.PostgresDetail, error) {
+	if err := s.repo.SetupPostgresReplication(ctx, id, body); err != nil {
+		return nil, err
+	}
+	return s.repo.GetPostgres(ctx, id)
+}
+
+// WaitForHealthy polls a database every interval until it is healthy after
+// op or timeout passes, and returns its latest state either way. started is
+// the database's state when the API accepted op. The first check is made
+// after one interval, so the API has had time to report the operation as
+// under way. A failover or restart leaves a database available until it
+// begins, so the database only counts as healthy once it has been seen in
+// another state since op started. A failed check, such as a server error
+// while the API is busy with the operation, is retried on the next interval;
+// only a database that no longer exists or credentials that no longer work
+// end the wait early.
+func (s *Service) WaitForHealthy(ctx context.Context, started *client.PostgresDetail, op HAOperation, timeout, interval time.Duration) (*client.PostgresDetail, error) {
+	timeoutTimer := time.NewTimer(timeout)
+	defer timeoutTimer.Stop()
+
+	var (
+		pg       = started
+		underway = !op.Healthy(started)
+		lastErr  error
+	)
+	for {
+		select {
+		case <-ctx.Done():
+			return pg, ctx.Err()
+		case <-timeoutTimer.C:
+			if lastErr != nil {
+				return pg, fmt.Errorf("%w; the last check failed: %w", ErrHAWaitTimeout, lastErr)
+			}
+			return pg, ErrHAWaitTimeout
+		case <-time.After(interval):
+		}
+
+		latest, err := s.repo.GetPostgres(ctx, started.Id)
+		if err != nil {
+			if isPermanentGetError(err) || ctx.Err() != nil {
+				return nil, err
+			}
+			lastErr = err
+			continue
+		}
+		pg, lastErr = latest, nil
+		if !op.Healthy(pg) {
+			underway = true
+		} else if underway {
+			return pg, nil
+		}
+	}
+}
+
+// isPermanentGetError reports whether an error fetching a database will
+// happen again on the next try.
+func isPermanentGetError(err error) bool {
+	return errors.Is(err, ErrPostgresNotFound) ||
+		errors.Is(err, client.ErrUnauthorized) ||
+		errors.Is(err, client.ErrForbidden)
+}
@@ -0,0 +1,193 @@
+package postgres_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/render-oss/cli/pkg/client"
+	metricsclient "github.com/render-oss/cli/pkg/client/metrics"
+	pgclient "github.com/render-oss/cli/pkg/client/postgres"
+	"github.com/render-oss/cli/pkg/pointers"
+	"github.com/render-oss/cli/pkg/postgres"
+)
+
+func TestHAOperationCheck(t *testing.T) {
+	primary := client.PostgresDetail{
+		Id:                      "dpg-primary",
+		Name:                    "my-db",
+		Plan:                    pgclient.Pro4gb,
+		Role:                    client.Primary,
+		Status:                  client.DatabaseStatusAvailable,
+		HighAvailabilityEnabled: true,
+	}
+	replica := client.PostgresDetail{
+		Id:                "dpg-replica",
+		Name:              "my-replica",
+		Plan:              pgclient.Pro4gb,
+		Role:              client.Replica,
+		Status:            client.DatabaseStatusAvailable,
+		PrimaryPostgresID: pointers.From("dpg-primary"),
+	}
+	with := func(pg client.PostgresDetail, change func(*client.PostgresDetail)) client.PostgresDetail {
+		change(&pg)
+		return pg
+	}
+
+	tests := []struct {
+		name    string
+		op      postgres.HAOperation
+		pg      client.PostgresDetail
+		wantErr string
+	}{
+		{name: "failover", op: postgres.HAFailover, pg: primary},
+		{
+			name:    "failover of a replica",
+			op:      postgres.HAFailover,
+			pg:      replica,
+			wantErr: "my-replica is a read replica; fail over its primary dpg-primary instead",
+		},
+		{
+			name:    "failover on an ineligible plan",
+			op:      postgres.HAFailover,
+			pg:      with(primary, func(pg *client.PostgresDetail) { pg.Plan = pgclient.Basic1gb }),
+			wantErr: "my-db is on the Basic 1GB plan, which doesn't support high availability; failover requires a Pro or Accelerated plan",
+		},
+		{
+			name:    "failover without high availability",
+			op:      postgres.HAFailover,
+			pg:      with(primary, func(pg *client.PostgresDetail) { pg.HighAvailabilityEnabled = false }),
+			wantErr: "my-db doesn't have high availability enabled, so it has no standby to fail over to; enable it with 'render pg update dpg-primary --high-availability'",
+		},
+		{
+			name:    "failover while unavailable",
+			op:      postgres.HAFailover,
+			pg:      with(primary, func(pg *client.PostgresDetail) { pg.Status = client.DatabaseStatusUpdatingInstance }),
+			wantErr: "my-db is updating_instance; failover needs it to be available",
+		},
+		{name: "promote", op: postgres.HAPromote, pg: replica},
+		{
+			name:    "promote a primary",
+			op:      postgres.HAPromote,
+			pg:      primary,
+			wantErr: "my-db is not a read replica; only read replicas can be promoted",
+		},
+		{
+			name:    "promote while suspended",
+			op:      postgres.HAPromote,
+			pg:      with(replica, func(pg *client.PostgresDetail) { pg.Status = client.DatabaseStatusSuspended }),
+			wantErr: "my-replica is suspended; promote needs it to be available",
+		},
+		{name: "replicate", op: postgres.HAReplicate, pg: with(primary, func(pg *client.PostgresDetail) { pg.Plan = pgclient.Basic1gb })},
+		{
+			name:    "replicate from a replica",
+			op:      postgres.HAReplicate,
+			pg:      replica,
+			wantErr: "my-replica is a read replica; set up replication on its primary dpg-primary instead",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.op.Check(&tt.pg)
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestHAOperationHealthy(t *testing.T) {
+	available := &client.PostgresDetail{Role: client.Primary, Status: client.DatabaseStatusAvailable}
+	updating := &client.PostgresDetail{Role: client.Primary, Status: client.DatabaseStatusUpdatingInstance}
+	stillReplica := &client.PostgresDetail{Role: client.Replica, Status: client.DatabaseStatusAvailable}
+
+	assert.True(t, postgres.HAFailover.Healthy(available))
+	assert.False(t, postgres.HAFailover.Healthy(updating))
+	assert.True(t, postgres.HAPromote.Healthy(available))
+	assert.False(t, postgres.HAPromote.Healthy(stillReplica))
+	assert.True(t, postgres.HAReplicate.Healthy(available))
+}
+
+func TestHAOperationCheckStandby(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	pg := &client.PostgresDetail{Name: "my-db"}
+	lagAt := func(ago time.Duration) []metricsclient.TimeSeries {
+		return []metricsclient.TimeSeries{{Unit: "seconds", Values: []metricsclient.TimeSeriesValue{{Timestamp: now.Add(-ago), Value: 0.2}}}}
+	}
+
+	require.NoError(t, postgres.HAFailover.CheckStandby(pg, lagAt(time.Minute), now))
+	require.NoError(t, postgres.HAPromote.CheckStandby(pg, lagAt(postgres.StandbyStatusWindow), now))
+	require.NoError(t, postgres.HAReplicate.CheckStandby(pg, nil, now))
+
+	require.EqualError(t, postgres.HAFailover.CheckStandby(pg, nil, now),
+		"my-db hasn't reported replication lag for its standby in the last 5 minutes, so the standby may not be streaming changes; check it in the Render Dashboard, or pass --skip-standby-check to fail over anyway")
+	require.EqualError(t, postgres.HAPromote.CheckStandby(pg, lagAt(10*time.Minute), now),
+		"my-db hasn't reported replication lag in the last 5 minutes, so it may not be streaming changes; check it in the Render Dashboard, or pass --skip-standby-check to promote anyway")
+}
+
+func TestWaitForHealthy(t *testing.T) {
+	t.Run("keeps polling through server errors", func(t *testing.T) {
+		h := newHarness(t)
+		pg := h.addPostgres("my-db")
+		pg.Status = client.DatabaseStatusAvailable
+		started := *pg
+		started.Status = client.DatabaseStatusUpdatingInstance
+		h.server.Postgres.RespondWith(http.StatusServiceUnavailable)
+		h.server.Postgres.RespondWith(http.StatusInternalServerError)
+
+		got, err := h.service.WaitForHealthy(context.Background(), &started, postgres.HAFailover, time.Second, time.Millisecond)
+		require.NoError(t, err)
+		assert.Equal(t, pg.Id, got.Id)
+		assert.Equal(t, client.DatabaseStatusAvailable, got.Status)
+	})
+
+	t.Run("waits for a database that was available to go through the operation", func(t *testing.T) {
+		h := newHarness(t)
+		pg := h.addPostgres("my-db")
+		pg.Status = client.DatabaseStatusAvailable
+		started := *pg
+
+		_, err := h.service.WaitForHealthy(context.Background(), &started, postgres.HAFailover, 50*time.Millisecond, 5*time.Millisecond)
+		require.ErrorIs(t, err, postgres.ErrHAWaitTimeout)
+	})
+
+	t.Run("is healthy once a failover has been under way", func(t *testing.T) {
+		h := newHarness(t)
+		pg := h.addPostgres("my-db")
+		pg.Status = client.DatabaseStatusAvailable
+
+		started, err := h.service.Failover(context.Background(), pg.Id)
+		require.NoError(t, err)
+		assert.Equal(t, client.DatabaseStatusUpdatingInstance, started.Status)
+
+		got, err := h.service.WaitForHealthy(context.Background(), started, postgres.HAFailover, time.Second, time.Millisecond)
+		require.NoError(t, err)
+		assert.Equal(t, client.DatabaseStatusAvailable, got.Status)
+	})
+
+	t.Run("times out when every check fails", func(t *testing.T) {
+		h := newHarness(t)
+		pg := h.addPostgres("my-db")
+		for range 1000 {
+			h.server.Postgres.RespondWith(http.StatusBadGateway)
+		}
+
+		_, err := h.service.WaitForHealthy(context.Background(), pg, postgres.HAFailover, 50*time.Millisecond, 5*time.Millisecond)
+		require.True(t, errors.Is(err, postgres.ErrHAWaitTimeout), "got %v", err)
+		assert.Contains(t, err.Error(), "the last check failed")
+	})
+
+	t.Run("stops when the database is gone", func(t *testing.T) {
+		h := newHarness(t)
+
+		_, err := h.service.WaitForHealthy(context.Background(), &client.PostgresDetail{Id: "dpg-missing"}, postgres.HAFailover, time.Second, time.Millisecond)
+		require.ErrorIs(t, err, postgres.ErrPostgresNotFound)
+	})
+}
@@ -0,0 +1,100 @@
+package postgres
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	pgclient "github.com/render-oss/cli/pkg/client/postgres"
+	"github.com/render-oss/cli/pkg/pointers"
+)
+
+// Insights holds the statistics Render collects for diagnosing slow queries.
+// Each list is sorted with the most expensive entries first.
+type Insights struct {
+	TopQueries []pgclient.PostgresQueryStatistic `json:"topQueries"`
+	TableScans []pgclient.PostgresTableScan      `json:"tableScans"`
+	Processes  []pgclient.PostgresProcess        `json:"processes"`
+	Sizes      []pgclient.PostgresSize           `json:"sizes"`
+}
+
+// Insights loads the top queries, sequential table scans, active processes,
+// and table and index sizes of a database.
+func (s *Service) Insights(ctx context.Context, id string) (*Insights, error) {
+	topQueries, err := s.repo.ListPostgresTopQueries(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load top queries: %w", err)
+	}
+	tableScans, err := s.repo.ListPostgresTableScans(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load table scans: %w", err)
+	}
+	processes, err := s.repo.ListPostgresProcesses(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load processes: %w", err)
+	}
+	sizes, err := s.repo.ListPostgresSizes(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load sizes: %w", err)
+	}
+
+	slices.SortStableFunc(topQueries, func(a, b pgclient.PostgresQueryStatistic) int {
+		return cmp.Compare(pointers.ValueOrDefault(b.TotalTimeMs, 0), pointers.ValueOrDefault(a.TotalTimeMs, 0))
+	})
+	slices.SortStableFunc(tableScans, func(a, b pgclient.PostgresTableScan) int {
+		return cmp.Compare(pointers.ValueOrDefault(b.Scans, 0), pointers.ValueOrDefault(a.Scans, 0))
+	})
+	slices.SortStableFunc(processes, func(a, b pgclient.PostgresProcess) int {
+		return cmp.Compare(pointers.ValueOrDefault(b.Duration, 0), pointers.ValueOrDefault(a.Duration, 0))
+	})
+	slices.SortStableFunc(sizes, func(a, b pgclient.PostgresSize) int {
+		return cmp.Compare(pointers.ValueOrDefault(b.Bytes, 0), pointers.ValueOrDefault(a.Bytes, 0))
+	})
+
+	return &Insights{
+		TopQueries: topQueries,
+		TableScans: tableScans,
+		Processes:  processes,
+		Sizes:      sizes,
+	}, nil
+}
+
+// ShortQuery returns query on a single line, cut to at most maxLen characters.
+func ShortQuery(query *string, maxLen int) string {
+	q := []rune(strings.Join(strings.Fields(pointers.StringValue(query)), " "))
+	if len(q) <= maxLen {
+		return string(q)
+	}
+	return string(q[:maxLen-1]) + "…"
+}
+
+// RelationName qualifies a table or index name with its schema.
+func RelationName(schema, name *string) string {
+	if pointers.StringValue(schema) == "" {
+		return pointers.StringValue(name)
+	}
+	return pointers.StringValue(schema) + "." + pointers.StringValue(name)
+}
+
+// FormatMillis formats a query time reported in milliseconds.
+func FormatMillis(ms *float32) string {
+	if ms == nil {
+		return "-"
+	}
+	if *ms >= 1000 {
+		return fmt.Sprintf("%.2f s", *ms/1000)
+	}
+	return fmt.Sprintf("%.1f ms", *ms)
+}
+
+// FormatProcessDuration formats how long a process has been in its current
+// state, which the API reports in seconds.
+func FormatProcessDuration(seconds *float32) string {
+	if seconds == nil {
+		return "-"
+	}
+	return time.Duration(float64(*seconds) * float64(time.Second)).Round(time.Millisecond).String()
+}
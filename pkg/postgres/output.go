@@ -1,6 +1,8 @@
 package postgres
 
 import (
+	"time"
+
 	"github.com/render-oss/cli/internal/ipallowlist"
 	"github.com/render-oss/cli/pkg/client"
 	pgclient "github.com/render-oss/cli/pkg/client/postgres"
@@ -56,6 +58,125 @@ type SuspendOutMeta struct {
 	Message   string `json:"message,omitempty"`
 }
 
+// UsersListOut is the JSON/YAML contract for a Postgres database's users.
+type UsersListOut struct {
+	Data []User     `json:"data"`
+	Meta TargetMeta `json:"meta"`
+}
+
+// TargetMeta identifies the database a command acted on, for outputs whose
+// data is not the database itself.
+type TargetMeta struct {
+	PostgresID   string `json:"postgresId"`
+	PostgresName string `json:"postgresName"`
+}
+
+// UserCreateOut carries a new user's credentials. They are only printed by
+// the command that creates the user.
+type UserCreateOut struct {
+	Data UserCredentials `json:"data"`
+	Meta TargetMeta      `json:"meta"`
+}
+
+type UserDeleteOut struct {
+	Data User              `json:"data"`
+	Meta UserDeleteOutMeta `json:"meta"`
+}
+
+type UserDeleteOutMeta struct {
+	TargetMeta
+	Deleted bool `json:"deleted"`
+}
+
+type ExportListOut struct {
+	Data []pgclient.PostgresExport `json:"data"`
+	Meta TargetMeta                `json:"meta"`
+}
+
+type ExportCreateOut struct {
+	Meta ExportCreateOutMeta `json:"meta"`
+}
+
+type ExportCreateOutMeta struct {
+	TargetMeta
+	Started bool `json:"started"`
+}
+
+type ExportDownloadOut struct {
+	Data ExportDownload `json:"data"`
+	Meta TargetMeta     `json:"meta"`
+}
+
+type InsightsOut struct {
+	Data Insights   `json:"data"`
+	Meta TargetMeta `json:"meta"`
+}
+
+// RecoverOut describes a point-in-time recovery. Data is the new database,
+// and is omitted when the recovery was only previewed.
+type RecoverOut struct {
+	Data *PostgresOut   `json:"data,omitempty"`
+	Meta RecoverOutMeta `json:"meta"`
+}
+
+type RecoverOutMeta struct {
+	Source      TargetMeta `json:"source"`
+	RestoreTime time.Time  `json:"restoreTime"`
+	RestoreName string     `json:"restoreName"`
+	Recovered   bool       `json:"recovered"`
+	Message     string     `json:"message,omitempty"`
+}
+
+type ParametersOut struct {
+	Data client.PostgresParameterOverrides `json:"data"`
+	Meta TargetMeta                        `json:"meta"`
+}
+
+type AvailableParametersOut struct {
+	Data []pgclient.PostgresAvailableParameterOverride `json:"data"`
+	Meta TargetMeta                                    `json:"meta"`
+}
+
+// ParametersUpdateOut describes a change to a database's parameter
+// overrides. Data holds the overrides after the change, whether or not it was
+// applied.
+type ParametersUpdateOut struct {
+	Data client.PostgresParameterOverrides `json:"data"`
+	Diff ParameterOverridesDiff            `json:"diff"`
+	Meta ParametersUpdateOutMeta           `json:"meta"`
+}
+
+type ParametersUpdateOutMeta struct {
+	TargetMeta
+	Applied           bool     `json:"applied"`
+	RequiresRestart   bool     `json:"requiresRestart"`
+	AffectedDatabases []string `json:"affectedDatabases,omitempty"`
+	Message           string   `json:"message,omitempty"`
+}
+
+// ParameterOverridesDiff maps each changed parameter to its override before
+// and after the change. Before is nil for a new override and After is nil for
+// a removed one.
+type ParameterOverridesDiff map[string]*PostgresFieldDiff[*string]
+
+// HAOut describes a failover, promotion, or replication setup. Data is the
+// database once the operation started, or when --wait stopped waiting.
+type HAOut struct {
+	Data PostgresOut `json:"data"`
+	Meta HAOutMeta   `json:"meta"`
+}
+
+type HAOutMeta struct {
+	Operation HAOperation `json:"operation"`
+	Started   bool        `json:"started"`
+	Waited    bool        `json:"waited"`
+	Healthy   bool        `json:"healthy"`
+	TimedOut  bool        `json:"timedOut,omitempty"`
+	// Replication is the setup requested by a replicate operation.
+	Replication *pgclient.PostgresReplicationSetupInput `json:"replication,omitempty"`
+	Message     string                                  `json:"message,omitempty"`
+}
+
 type PostgresUpdateOut struct {
 	Data PostgresOut        `json:"data"`
 	Diff PostgresUpdateDiff `json:"diff"`
@@ -95,6 +216,41 @@ func NewPostgresSuspendOut(resolved *ResolvedPostgres) SuspendOut {
 	return SuspendOut{Data: newPostgresOut(resolved)}
 }
 
+func NewTargetMeta(resolved *ResolvedPostgres) TargetMeta {
+	if resolved == nil || resolved.Postgres == nil {
+		return TargetMeta{}
+	}
+	return TargetMeta{PostgresID: resolved.Postgres.Id, PostgresName: resolved.Postgres.Name}
+}
+
+func NewPostgresRecoverOut(source *ResolvedPostgres, input RecoverInput) RecoverOut {
+	return RecoverOut{
+		Meta: RecoverOutMeta{
+			Source:      NewTargetMeta(source),
+			RestoreTime: input.To,
+			RestoreName: input.Name,
+		},
+	}
+}
+
+// SetRecovered records the database a confirmed recovery created.
+func (out *RecoverOut) SetRecovered(recovered *ResolvedPostgres) {
+	data := newPostgresOut(recovered)
+	out.Data = &data
+	out.Meta.Recovered = true
+}
+
+// NewHAOut describes op after it started on the resolved database, whose
+// latest state is pg.
+func NewHAOut(resolved *ResolvedPostgres, pg *client.PostgresDetail, op HAOperation) HAOut {
+	latest := *resolved
+	latest.Postgres = pg
+	return HAOut{
+		Data: newPostgresOut(&latest),
+		Meta: HAOutMeta{Operation: op, Started: true},
+	}
+}
+
 func newPostgresOut(resolved *ResolvedPostgres) PostgresOut {
 	if resolved == nil || resolved.Postgres == nil {
 		return PostgresOut{}
@@ -160,6 +316,28 @@ func newPostgresUpdateDiff(before *client.PostgresDetail, after *PostgresOut) Po
 	return diff
 }
 
+func newParameterOverridesDiff(before, after client.PostgresParameterOverrides) ParameterOverridesDiff {
+	diff := ParameterOverridesDiff{}
+	for name, value := range after {
+		if prev, ok := before[name]; !ok || prev != value {
+			diff[name] = newPostgresFieldDiff(parameterValue(before, name), pointers.From(value))
+		}
+	}
+	for name := range before {
+		if _, ok := after[name]; !ok {
+			diff[name] = newPostgresFieldDiff(parameterValue(before, name), nil)
+		}
+	}
+	return diff
+}
+
+func parameterValue(overrides client.PostgresParameterOverrides, name string) *string {
+	if value, ok := overrides[name]; ok {
+		return &value
+	}
+	return nil
+}
+
 func newPostgresListItemOutFromPostgres(
 	pg *client.Postgres,
 	project *client.Project,
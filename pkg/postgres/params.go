@@ -0,0 +1,214 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/render-oss/cli/pkg/client"
+	pgclient "github.com/render-oss/cli/pkg/client/postgres"
+)
+
+// parameterUnits are the units Postgres accepts on memory and time settings.
+// Longer units come first so "ms" isn't read as "s".
+var parameterUnits = []string{"kB", "MB", "GB", "TB", "us", "ms", "min", "s", "h", "d"}
+
+// ParameterChange is a change to a database's parameter overrides.
+type ParameterChange struct {
+	// Set adds or updates overrides.
+	Set map[string]string
+	// Unset removes overrides. Each must currently be set.
+	Unset []string
+	// Replace makes Set the database's complete list of overrides, removing
+	// any override it doesn't name.
+	Replace bool
+}
+
+// ParameterPlan is the result of a ParameterChange, before it is applied.
+type ParameterPlan struct {
+	Before client.PostgresParameterOverrides
+	After  client.PostgresParameterOverrides
+	Diff   ParameterOverridesDiff
+	// RequiresRestart reports whether any changed parameter only takes effect
+	// after the database restarts.
+	RequiresRestart bool
+}
+
+// ListParameterOverrides returns the parameters overridden on a database.
+func (s *Service) ListParameterOverrides(ctx context.Context, id string) (client.PostgresParameterOverrides, error) {
+	return s.repo.ListPostgresParameterOverrides(ctx, id)
+}
+
+// ListAvailableParameterOverrides returns the parameters that can be
+// overridden on a database, sorted by name.
+func (s *Service) ListAvailableParameterOverrides(ctx context.Context, id string) ([]pgclient.PostgresAvailableParameterOverride, error) {
+	available, err := s.repo.ListAvailablePostgresParameterOverrides(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	slices.SortFunc(available, func(a, b pgclient.PostgresAvailableParameterOverride) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return available, nil
+}
+
+// PlanParameterChange validates change against the parameters the database
+// accepts and works out the overrides that result from it.
+func (s *Service) PlanParameterChange(ctx context.Context, id string, change ParameterChange) (*ParameterPlan, error) {
+	before, err := s.repo.ListPostgresParameterOverrides(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	available, err := s.repo.ListAvailablePostgresParameterOverrides(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return NewParameterPlan(before, available, change)
+}
+
+// UpdateParameterOverrides replaces a database's parameter overrides.
+func (s *Service) UpdateParameterOverrides(ctx context.Context, id string, overrides client.PostgresParameterOverrides) (*client.PostgresPutParameterOverridesResult, error) {
+	return s.repo.UpdatePostgresParameterOverrides(ctx, id, overrides)
+}
+
+// NewParameterPlan applies change to the overrides in before, checking each
+// parameter it sets against available.
+func NewParameterPlan(before client.PostgresParameterOverrides, available []pgclient.PostgresAvailableParameterOverride, change ParameterChange) (*ParameterPlan, error) {
+	byName := make(map[string]pgclient.PostgresAvailableParameterOverride, len(available))
+	for _, param := range available {
+		byName[param.Name] = param
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(change.Set)) {
+		param, ok := byName[name]
+		if !ok {
+			return nil, fmt.Errorf("parameter %s can't be overridden; run 'render pg params available' to list the parameters you can set", name)
+		}
+		if err := ValidateParameterValue(param, change.Set[name]); err != nil {
+			return nil, err
+		}
+	}
+	for _, name := range change.Unset {
+		if _, ok := before[name]; !ok {
+			return nil, fmt.Errorf("parameter %s has no override to unset", name)
+		}
+	}
+
+	after := client.PostgresParameterOverrides{}
+	if !change.Replace {
+		maps.Copy(after, before)
+	}
+	maps.Copy(after, change.Set)
+	for _, name := range change.Unset {
+		delete(after, name)
+	}
+
+	plan := &ParameterPlan{
+		Before: before,
+		After:  after,
+		Diff:   newParameterOverridesDiff(before, after),
+	}
+	for name := range plan.Diff {
+		if byName[name].RequiresRestart {
+			plan.RequiresRestart = true
+		}
+	}
+	return plan, nil
+}
+
+// ValidateParameterValue checks value against param's allowed range. Numeric
+// parameters may carry a unit, such as 64MB or 30s. The range is in the
+// parameter's base unit, which the API doesn't report, so a value with a unit
+// is only rejected when no value of its sign fits the range; Postgres checks
+// the converted value when the override is applied.
+func ValidateParameterValue(param pgclient.PostgresAvailableParameterOverride, value string) error {
+	if strings.TrimSpace(value) == "" {
+		return fmt.Errorf("parameter %s needs a value", param.Name)
+	}
+	if param.MinValue == nil && param.MaxValue == nil {
+		return nil
+	}
+
+	number, hasUnit := trimParameterUnit(value)
+	n, err := strconv.ParseFloat(number, 64)
+	if err != nil {
+		return fmt.Errorf("parameter %s takes a number, got %q", param.Name, value)
+	}
+	inRange := parameterInRange(param, n)
+	if hasUnit {
+		inRange = parameterSignInRange(param, n)
+	}
+	if !inRange {
+		return fmt.Errorf("parameter %s must be %s, got %s", param.Name, ParameterRange(param), value)
+	}
+	return nil
+}
+
+func parameterInRange(param pgclient.PostgresAvailableParameterOverride, n float64) bool {
+	return (param.MinValue == nil || n >= float64(*param.MinValue)) && (param.MaxValue == nil || n <= float64(*param.MaxValue))
+}
+
+// parameterSignInRange reports whether some value with n's sign fits param's
+// range. Converting between units keeps the sign, so this holds whatever the
+// parameter's base unit is.
+func parameterSignInRange(param pgclient.PostgresAvailableParameterOverride, n float64) bool {
+	switch {
+	case n < 0:
+		return param.MinValue == nil || *param.MinValue < 0
+	case n > 0:
+		return param.MaxValue == nil || *param.MaxValue > 0
+	default:
+		return parameterInRange(param, 0)
+	}
+}
+
+// ParameterRange describes the values a numeric parameter accepts, such as
+// "between 1 and 100". It is empty for parameters without a range.
+func ParameterRange(param pgclient.PostgresAvailableParameterOverride) string {
+	switch {
+	case param.MinValue != nil && param.MaxValue != nil:
+		return fmt.Sprintf("between %s and %s", formatParameterBound(*param.MinValue), formatParameterBound(*param.MaxValue))
+	case param.MinValue != nil:
+		return "at least " + formatParameterBound(*param.MinValue)
+	case param.MaxValue != nil:
+		return "at most " + formatParameterBound(*param.MaxValue)
+	default:
+		return ""
+	}
+}
+
+// ReadParameterOverridesFile reads a YAML file mapping parameter names to
+// values, such as:
+//
+//	work_mem: 64MB
+//	max_connections: 200
+func ReadParameterOverridesFile(path string) (map[string]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read parameter overrides file: %w", err)
+	}
+	overrides := map[string]string{}
+	if err := yaml.Unmarshal(data, &overrides); err != nil {
+		return nil, fmt.Errorf("failed to parse parameter overrides file: %w", err)
+	}
+	return overrides, nil
+}
+
+func trimParameterUnit(value string) (string, bool) {
+	for _, unit := range parameterUnits {
+		if number, ok := strings.CutSuffix(value, unit); ok && number != "" {
+			return strings.TrimSpace(number), true
+		}
+	}
+	return value, false
+}
+
+func formatParameterBound(f float32) string {
+	return strconv.FormatFloat(float64(f), 'f', -1, 32)
+}
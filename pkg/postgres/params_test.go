@@ -0,0 +1,105 @@
+package postgres_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/render-oss/cli/pkg/client"
+	pgclient "github.com/render-oss/cli/pkg/client/postgres"
+	"github.com/render-oss/cli/pkg/pointers"
+	"github.com/render-oss/cli/pkg/postgres"
+)
+
+var testAvailableParameters = []pgclient.PostgresAvailableParameterOverride{
+	{Name: "work_mem", MinValue: pointers.From(float32(64)), MaxValue: pointers.From(float32(1048576))},
+	{Name: "max_connections", MinValue: pointers.From(float32(1)), MaxValue: pointers.From(float32(1000)), RequiresRestart: true},
+	{Name: "log_min_duration_statement", MinValue: pointers.From(float32(-1))},
+	{Name: "default_transaction_isolation"},
+}
+
+func TestValidateParameterValue(t *testing.T) {
+	workMem := testAvailableParameters[0]
+	logDuration := testAvailableParameters[2]
+	isolation := testAvailableParameters[3]
+
+	tests := []struct {
+		name    string
+		param   pgclient.PostgresAvailableParameterOverride
+		value   string
+		wantErr string
+	}{
+		{name: "number in range", param: workMem, value: "4096"},
+		{name: "number with unit", param: workMem, value: "64MB"},
+		{name: "time unit", param: logDuration, value: "250ms"},
+		{name: "no range", param: isolation, value: "serializable"},
+		{name: "empty", param: isolation, value: " ", wantErr: "parameter default_transaction_isolation needs a value"},
+		{name: "not a number", param: workMem, value: "lots", wantErr: `parameter work_mem takes a number, got "lots"`},
+		{name: "unknown unit", param: workMem, value: "64XB", wantErr: `parameter work_mem takes a number, got "64XB"`},
+		{name: "below range", param: workMem, value: "8", wantErr: "parameter work_mem must be between 64 and 1048576, got 8"},
+		{name: "negative with unit", param: workMem, value: "-64MB", wantErr: "parameter work_mem must be between 64 and 1048576, got -64MB"},
+		{name: "zero with unit", param: workMem, value: "0kB", wantErr: "parameter work_mem must be between 64 and 1048576, got 0kB"},
+		{name: "zero with unit in range", param: logDuration, value: "0ms"},
+		{name: "negative with unit in range", param: logDuration, value: "-1ms"},
+		{name: "below minimum", param: logDuration, value: "-2", wantErr: "parameter log_min_duration_statement must be at least -1, got -2"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := postgres.ValidateParameterValue(tt.param, tt.value)
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestNewParameterPlan(t *testing.T) {
+	before := client.PostgresParameterOverrides{"work_mem": "4MB", "log_min_duration_statement": "-1"}
+
+	t.Run("set merges with current overrides", func(t *testing.T) {
+		plan, err := postgres.NewParameterPlan(before, testAvailableParameters, postgres.ParameterChange{
+			Set: map[string]string{"work_mem": "64MB", "max_connections": "200"},
+		})
+		require.NoError(t, err)
+		require.Equal(t, client.PostgresParameterOverrides{"work_mem": "64MB", "max_connections": "200", "log_min_duration_statement": "-1"}, plan.After)
+		require.Equal(t, postgres.ParameterOverridesDiff{
+			"work_mem":        {Before: pointers.From("4MB"), After: pointers.From("64MB")},
+			"max_connections": {Before: nil, After: pointers.From("200")},
+		}, plan.Diff)
+		require.True(t, plan.RequiresRestart)
+	})
+
+	t.Run("replace removes unnamed overrides", func(t *testing.T) {
+		plan, err := postgres.NewParameterPlan(before, testAvailableParameters, postgres.ParameterChange{
+			Set:     map[string]string{"work_mem": "4MB"},
+			Replace: true,
+		})
+		require.NoError(t, err)
+		require.Equal(t, client.PostgresParameterOverrides{"work_mem": "4MB"}, plan.After)
+		require.Equal(t, postgres.ParameterOverridesDiff{
+			"log_min_duration_statement": {Before: pointers.From("-1"), After: nil},
+		}, plan.Diff)
+		require.False(t, plan.RequiresRestart)
+	})
+
+	t.Run("unset", func(t *testing.T) {
+		plan, err := postgres.NewParameterPlan(before, testAvailableParameters, postgres.ParameterChange{Unset: []string{"work_mem"}})
+		require.NoError(t, err)
+		require.Equal(t, client.PostgresParameterOverrides{"log_min_duration_statement": "-1"}, plan.After)
+		require.Len(t, plan.Diff, 1)
+	})
+
+	t.Run("unknown parameter", func(t *testing.T) {
+		_, err := postgres.NewParameterPlan(before, testAvailableParameters, postgres.ParameterChange{
+			Set: map[string]string{"shared_buffers": "1GB"},
+		})
+		require.ErrorContains(t, err, "parameter shared_buffers can't be overridden")
+	})
+
+	t.Run("unset without override", func(t *testing.T) {
+		_, err := postgres.NewParameterPlan(before, testAvailableParameters, postgres.ParameterChange{Unset: []string{"max_connections"}})
+		require.EqualError(t, err, "parameter max_connections has no override to unset")
+	})
+}
@@ -0,0 +1,73 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/render-oss/cli/pkg/client"
+	pgclient "github.com/render-oss/cli/pkg/client/postgres"
+)
+
+// RecoverInput is a point-in-time recovery request for a resolved database.
+type RecoverInput struct {
+	To   time.Time
+	Name string
+	Plan *string
+}
+
+func (s *Service) RecoveryInfo(ctx context.Context, id string) (*pgclient.RecoveryInfo, error) {
+	return s.repo.GetPostgresRecoveryInfo(ctx, id)
+}
+
+// CheckRecovery reports whether a database can be recovered to the time t.
+func (s *Service) CheckRecovery(ctx context.Context, id string, t time.Time) error {
+	info, err := s.repo.GetPostgresRecoveryInfo(ctx, id)
+	if err != nil {
+		return err
+	}
+	return ValidateRecoveryTime(info, t, time.Now())
+}
+
+// Recover restores a database to a point in time as a new database. The time
+// is checked against the database's recovery window before the request is
+// sent, so an out-of-range time fails without creating anything.
+func (s *Service) Recover(ctx context.Context, id string, input RecoverInput) (*client.PostgresDetail, error) {
+	if err := s.CheckRecovery(ctx, id, input.To); err != nil {
+		return nil, err
+	}
+
+	body := client.RecoverPostgresJSONRequestBody{
+		RestoreTime: input.To,
+		Plan:        input.Plan,
+	}
+	if input.Name != "" {
+		body.RestoreName = &input.Name
+	}
+	return s.repo.RecoverPostgres(ctx, id, body)
+}
+
+// ValidateRecoveryTime reports whether t falls in the recovery window
+// described by info, which runs from info.StartsAt until now.
+func ValidateRecoveryTime(info *pgclient.RecoveryInfo, t, now time.Time) error {
+	if info == nil {
+		return errors.New("point-in-time recovery is not available for this database")
+	}
+	switch info.RecoveryStatus {
+	case pgclient.AVAILABLE:
+	case pgclient.BACKUPNOTREADY:
+		return errors.New("point-in-time recovery is not available yet because the database's first backup is not ready")
+	default:
+		return errors.New("point-in-time recovery is not available for this database")
+	}
+
+	if t.After(now) {
+		return fmt.Errorf("recovery time %s is in the future", t.Format(time.RFC3339))
+	}
+	if info.StartsAt != nil && t.Before(*info.StartsAt) {
+		return fmt.Errorf("recovery time %s is before the start of the recovery window; the earliest time you can recover to is %s",
+			t.Format(time.RFC3339), info.StartsAt.Format(time.RFC3339))
+	}
+	return nil
+}
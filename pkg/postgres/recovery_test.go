@@ -0,0 +1,65 @@
+package postgres_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	pgclient "github.com/render-oss/cli/pkg/client/postgres"
+	"github.com/render-oss/cli/pkg/pointers"
+	"github.com/render-oss/cli/pkg/postgres"
+)
+
+func TestValidateRecoveryTime(t *testing.T) {
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	window := &pgclient.RecoveryInfo{
+		RecoveryStatus: pgclient.AVAILABLE,
+		StartsAt:       pointers.From(now.Add(-72 * time.Hour)),
+	}
+
+	tests := []struct {
+		name    string
+		info    *pgclient.RecoveryInfo
+		at      time.Time
+		wantErr string
+	}{
+		{name: "inside the window", info: window, at: now.Add(-time.Hour)},
+		{name: "start of the window", info: window, at: *window.StartsAt},
+		{name: "now", info: window, at: now},
+		{
+			name:    "before the window",
+			info:    window,
+			at:      now.Add(-73 * time.Hour),
+			wantErr: "recovery time 2026-04-28T11:00:00Z is before the start of the recovery window; the earliest time you can recover to is 2026-04-28T12:00:00Z",
+		},
+		{
+			name:    "in the future",
+			info:    window,
+			at:      now.Add(time.Minute),
+			wantErr: "recovery time 2026-05-01T12:01:00Z is in the future",
+		},
+		{
+			name:    "backup not ready",
+			info:    &pgclient.RecoveryInfo{RecoveryStatus: pgclient.BACKUPNOTREADY},
+			at:      now,
+			wantErr: "point-in-time recovery is not available yet because the database's first backup is not ready",
+		},
+		{
+			name:    "not available",
+			info:    &pgclient.RecoveryInfo{RecoveryStatus: pgclient.NOTAVAILABLE},
+			at:      now,
+			wantErr: "point-in-time recovery is not available for this database",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := postgres.ValidateRecoveryTime(tt.info, tt.at, now)
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
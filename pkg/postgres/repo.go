@@ -3,10 +3,14 @@ package postgres
 import (
 	"context"
 	"errors"
+	"fmt"
+	"io"
 	"net/http"
 
 	"github.com/render-oss/cli/pkg/client"
+	pgclient "github.com/render-oss/cli/pkg/client/postgres"
 	"github.com/render-oss/cli/pkg/config"
+	"github.com/render-oss/cli/pkg/pointers"
 )
 
 // ErrPostgresNotFound is returned by Repo.GetPostgres when the API responds
@@ -15,12 +19,14 @@ import (
 var ErrPostgresNotFound = errors.New("postgres not found")
 
 type Repo struct {
-	client *client.ClientWithResponses
+	client     *client.ClientWithResponses
+	httpClient *http.Client
 }
 
 func NewRepo(c *client.ClientWithResponses) *Repo {
 	return &Repo{
-		client: c,
+		client:     c,
+		httpClient: &http.Client{},
 	}
 }
 
@@ -152,3 +158,257 @@ func (r *Repo) ResumePostgres(ctx context.Context, id string) error {
 
 	return client.ErrorFromResponse(resp)
 }
+
+func (r *Repo) ListPostgresUsers(ctx context.Context, id string) ([]User, error) {
+	resp, err := r.client.ListPostgresUsersWithResponse(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return []User{}, nil
+	}
+
+	users := make([]User, 0, len(*resp.JSON200))
+	for _, u := range *resp.JSON200 {
+		users = append(users, User{
+			Username:        pointers.StringValue(u.Username),
+			Default:         pointers.ValueOrDefault(u.Default, false),
+			OpenConnections: pointers.ValueOrDefault(u.OpenConnections, 0),
+			CreatedAt:       u.CreatedAt,
+		})
+	}
+	return users, nil
+}
+
+func (r *Repo) CreatePostgresUser(ctx context.Context, id string, username string) error {
+	resp, err := r.client.CreatePostgresUserWithResponse(ctx, id, client.CreatePostgresUserJSONRequestBody{
+		Username: username,
+	})
+	if err != nil {
+		return err
+	}
+
+	return client.ErrorFromResponse(resp)
+}
+
+func (r *Repo) DeletePostgresUser(ctx context.Context, id string, username string) error {
+	resp, err := r.client.DeletePostgresUserWithResponse(ctx, id, username)
+	if err != nil {
+		return err
+	}
+
+	return client.ErrorFromResponse(resp)
+}
+
+func (r *Repo) ListPostgresExports(ctx context.Context, id string) ([]pgclient.PostgresExport, error) {
+	resp, err := r.client.ListPostgresExportWithResponse(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return []pgclient.PostgresExport{}, nil
+	}
+
+	return *resp.JSON200, nil
+}
+
+func (r *Repo) CreatePostgresExport(ctx context.Context, id string) error {
+	resp, err := r.client.CreatePostgresExportWithResponse(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return client.ErrorFromResponse(resp)
+}
+
+// OpenExport starts downloading an export from its presigned URL. The size is
+// -1 when the response does not declare one. Callers must close the body.
+func (r *Repo) OpenExport(ctx context.Context, url string) (io.ReadCloser, int64, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to create download request: %w", err)
+	}
+
+	resp, err := r.httpClient.Do(req)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to execute download: %w", err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		resp.Body.Close()
+		return nil, 0, fmt.Errorf("failed to download export: received response code %d", resp.StatusCode)
+	}
+
+	return resp.Body, resp.ContentLength, nil
+}
+
+func (r *Repo) GetPostgresRecoveryInfo(ctx context.Context, id string) (*pgclient.RecoveryInfo, error) {
+	resp, err := r.client.RetrievePostgresRecoveryInfoWithResponse(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return resp.JSON200, nil
+}
+
+func (r *Repo) RecoverPostgres(ctx context.Context, id string, body client.RecoverPostgresJSONRequestBody) (*client.PostgresDetail, error) {
+	resp, err := r.client.RecoverPostgresWithResponse(ctx, id, body)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return resp.JSON200, nil
+}
+
+func (r *Repo) ListPostgresTopQueries(ctx context.Context, id string) ([]pgclient.PostgresQueryStatistic, error) {
+	resp, err := r.client.ListPostgresTopQueriesWithResponse(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil || resp.JSON200.TopQueries == nil {
+		return []pgclient.PostgresQueryStatistic{}, nil
+	}
+
+	return resp.JSON200.TopQueries, nil
+}
+
+func (r *Repo) ListPostgresTableScans(ctx context.Context, id string) ([]pgclient.PostgresTableScan, error) {
+	resp, err := r.client.ListPostgresTableScansWithResponse(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil || resp.JSON200.TableScans == nil {
+		return []pgclient.PostgresTableScan{}, nil
+	}
+
+	return resp.JSON200.TableScans, nil
+}
+
+func (r *Repo) ListPostgresProcesses(ctx context.Context, id string) ([]pgclient.PostgresProcess, error) {
+	resp, err := r.client.ListPostgresProcessesWithResponse(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil || resp.JSON200.Processes == nil {
+		return []pgclient.PostgresProcess{}, nil
+	}
+
+	return resp.JSON200.Processes, nil
+}
+
+func (r *Repo) ListPostgresSizes(ctx context.Context, id string) ([]pgclient.PostgresSize, error) {
+	resp, err := r.client.ListPostgresSizesWithResponse(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil || resp.JSON200.Sizes == nil {
+		return []pgclient.PostgresSize{}, nil
+	}
+
+	return resp.JSON200.Sizes, nil
+}
+
+func (r *Repo) ListPostgresParameterOverrides(ctx context.Context, id string) (client.PostgresParameterOverrides, error) {
+	resp, err := r.client.ListPostgresParameterOverridesWithResponse(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil || resp.JSON200.ParameterOverrides == nil {
+		return client.PostgresParameterOverrides{}, nil
+	}
+
+	return resp.JSON200.ParameterOverrides, nil
+}
+
+func (r *Repo) ListAvailablePostgresParameterOverrides(ctx context.Context, id string) ([]pgclient.PostgresAvailableParameterOverride, error) {
+	resp, err := r.client.ListAvailablePostgresParameterOverridesWithResponse(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil || resp.JSON200.AvailableParameterOverrides == nil {
+		return []pgclient.PostgresAvailableParameterOverride{}, nil
+	}
+
+	return resp.JSON200.AvailableParameterOverrides, nil
+}
+
+func (r *Repo) UpdatePostgresParameterOverrides(ctx context.Context, id string, overrides client.PostgresParameterOverrides) (*client.PostgresPutParameterOverridesResult, error) {
+	resp, err := r.client.UpdatePostgresParameterOverridesWithResponse(ctx, id, client.UpdatePostgresParameterOverridesJSONRequestBody{
+		ParameterOverrides: overrides,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return resp.JSON200, nil
+}
+
+func (r *Repo) FailoverPostgres(ctx context.Context, id string) error {
+	resp, err := r.client.FailoverPostgresWithResponse(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return client.ErrorFromResponse(resp)
+}
+
+func (r *Repo) PromotePostgres(ctx context.Context, id string) error {
+	resp, err := r.client.PromotePostgresWithResponse(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return client.ErrorFromResponse(resp)
+}
+
+func (r *Repo) SetupPostgresReplication(ctx context.Context, id string, body client.SetupPostgresReplicationJSONRequestBody) error {
+	resp, err := r.client.SetupPostgresReplicationWithResponse(ctx, id, body)
+	if err != nil {
+		return err
+	}
+
+	return client.ErrorFromResponse(resp)
+}
@@ -0,0 +1,104 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// User is a PostgreSQL user of a Render Postgres database.
+type User struct {
+	Username string `json:"username"`
+	// Default reports whether this is the user shown in the database's
+	// connection info. Creating a user makes it the new default.
+	Default         bool    `json:"default"`
+	OpenConnections int     `json:"openConnections"`
+	CreatedAt       *string `json:"createdAt,omitempty"`
+}
+
+// UserCredentials are the credentials of a newly created user.
+type UserCredentials struct {
+	Username                 string `json:"username"`
+	Password                 string `json:"password"`
+	InternalConnectionString string `json:"internalConnectionString"`
+	ExternalConnectionString string `json:"externalConnectionString"`
+	PsqlCommand              string `json:"psqlCommand"`
+}
+
+// The API creates users asynchronously, so CreateUser polls the connection
+// info until it reports the new user.
+var (
+	userCredentialsPollInterval = 2 * time.Second
+	userCredentialsTimeout      = 2 * time.Minute
+)
+
+func (s *Service) ListUsers(ctx context.Context, id string) ([]User, error) {
+	return s.repo.ListPostgresUsers(ctx, id)
+}
+
+// GetUser returns the user with the given username, or an error if the
+// database has no such user.
+func (s *Service) GetUser(ctx context.Context, id, username string) (*User, error) {
+	users, err := s.repo.ListPostgresUsers(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	for _, u := range users {
+		if u.Username == username {
+			return &u, nil
+		}
+	}
+	return nil, fmt.Errorf("user %q not found", username)
+}
+
+// CreateUser creates a user and returns its credentials. The new user becomes
+// the database's default user, which is how its password is read back.
+func (s *Service) CreateUser(ctx context.Context, id, username string) (*UserCredentials, error) {
+	if err := s.repo.CreatePostgresUser(ctx, id, username); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, userCredentialsTimeout)
+	defer cancel()
+	for {
+		conn, err := s.repo.GetPostgresConnectionInfo(ctx, id)
+		if ctx.Err() != nil {
+			return nil, credentialsNotReadyError(id, username)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if conn != nil && connectionUser(conn.InternalConnectionString) == username {
+			return &UserCredentials{
+				Username:                 username,
+				Password:                 conn.Password,
+				InternalConnectionString: conn.InternalConnectionString,
+				ExternalConnectionString: conn.ExternalConnectionString,
+				PsqlCommand:              conn.PsqlCommand,
+			}, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, credentialsNotReadyError(id, username)
+		case <-time.After(userCredentialsPollInterval):
+		}
+	}
+}
+
+func (s *Service) DeleteUser(ctx context.Context, id, username string) error {
+	return s.repo.DeletePostgresUser(ctx, id, username)
+}
+
+func credentialsNotReadyError(id, username string) error {
+	return fmt.Errorf("created user %s, but its credentials were not ready after %s; view them later with 'render pg get %s --include-sensitive-connection-info'", username, userCredentialsTimeout, id)
+}
+
+func connectionUser(connectionString string) string {
+	u, err := url.Parse(connectionString)
+	if err != nil || u.User == nil {
+		return ""
+	}
+	return u.User.Username()
+}
@@ -0,0 +1,166 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/render-oss/cli/pkg/client"
+	autoscaling "github.com/render-oss/cli/pkg/client/autoscaling"
+	"github.com/render-oss/cli/pkg/pointers"
+	"github.com/render-oss/cli/pkg/tui"
+	servicetypes "github.com/render-oss/cli/pkg/types/service"
+)
+
+// SetAutoscaling replaces the autoscaling config of an already-resolved
+// service and returns the config the API stored.
+func (s *Repo) SetAutoscaling(ctx context.Context, serviceID string, cfg autoscaling.AutoscalingConfig) (*autoscaling.AutoscalingConfig, error) {
+	resp, err := s.client.AutoscaleServiceWithResponse(ctx, serviceID, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+
+	if resp.JSON200 == nil {
+		return &cfg, nil
+	}
+	return resp.JSON200, nil
+}
+
+// DeleteAutoscaling removes the autoscaling config of an already-resolved
+// service, leaving it at its manual instance count.
+func (s *Repo) DeleteAutoscaling(ctx context.Context, serviceID string) error {
+	resp, err := s.client.DeleteAutoscalingConfigWithResponse(ctx, serviceID)
+	if err != nil {
+		return err
+	}
+
+	return client.ErrorFromResponse(resp)
+}
+
+// ApplyAutoscalingInput returns the config that results from applying in to
+// current, which is nil when the service has never been autoscaled. The
+// result is always enabled. New configs start at one instance with a CPU
+// target unless flags say otherwise.
+func ApplyAutoscalingInput(current *autoscaling.AutoscalingConfig, in servicetypes.AutoscalingSetInput) (autoscaling.AutoscalingConfig, error) {
+	var cfg autoscaling.AutoscalingConfig
+	if current != nil {
+		cfg = *current
+	} else {
+		cfg.Min = 1
+		if in.CPUTarget == nil && in.MemoryTarget == nil {
+			cfg.Criteria.Cpu = autoscaling.AutoscalingCriteriaPercentage{
+				Enabled:    true,
+				Percentage: servicetypes.DefaultAutoscalingTargetPercent,
+			}
+		}
+	}
+	cfg.Enabled = true
+
+	if in.Min != nil {
+		cfg.Min = *in.Min
+	}
+	if in.Max != nil {
+		cfg.Max = *in.Max
+	}
+	if in.CPUTarget != nil {
+		cfg.Criteria.Cpu = targetCriterion(*in.CPUTarget)
+	}
+	if in.MemoryTarget != nil {
+		cfg.Criteria.Memory = targetCriterion(*in.MemoryTarget)
+	}
+
+	if cfg.Max == 0 {
+		return cfg, tui.UserFacingError{Message: "--max is required when enabling autoscaling."}
+	}
+	if cfg.Min > cfg.Max {
+		return cfg, tui.UserFacingError{Message: fmt.Sprintf("Minimum instances (%d) must not be greater than maximum instances (%d).", cfg.Min, cfg.Max)}
+	}
+	if !cfg.Criteria.Cpu.Enabled && !cfg.Criteria.Memory.Enabled {
+		return cfg, tui.UserFacingError{Message: "Autoscaling needs a CPU or memory target. Set --cpu-target or --memory-target."}
+	}
+	return cfg, nil
+}
+
+func targetCriterion(percent int) autoscaling.AutoscalingCriteriaPercentage {
+	if percent == 0 {
+		return autoscaling.AutoscalingCriteriaPercentage{}
+	}
+	return autoscaling.AutoscalingCriteriaPercentage{Enabled: true, Percentage: percent}
+}
+
+// CheckInstanceLimits errors if a service on plan cannot run maxInstances
+// instances. Free instances never scale past one, and no service runs more
+// than servicetypes.MaxServiceInstances.
+func CheckInstanceLimits(plan client.Plan, maxInstances int) error {
+	if plan == client.PlanFree && maxInstances > 1 {
+		return tui.UserFacingError{Message: "Free instances run a single instance. Change the service to a paid instance type to scale it."}
+	}
+	if maxInstances > servicetypes.MaxServiceInstances {
+		return tui.UserFacingError{Message: fmt.Sprintf("Services can run at most %d instances.", servicetypes.MaxServiceInstances)}
+	}
+	return nil
+}
+
+// AutoscalingDiff lists the autoscaling fields that differ between two
+// configs. Targets are nil when that criterion is off.
+type AutoscalingDiff struct {
+	Enabled      *AutoscalingFieldDiff[bool] `json:"enabled,omitempty"`
+	Min          *AutoscalingFieldDiff[int]  `json:"min,omitempty"`
+	Max          *AutoscalingFieldDiff[int]  `json:"max,omitempty"`
+	CPUTarget    *AutoscalingFieldDiff[*int] `json:"cpuTarget,omitempty"`
+	MemoryTarget *AutoscalingFieldDiff[*int] `json:"memoryTarget,omitempty"`
+}
+
+type AutoscalingFieldDiff[T any] struct {
+	Before T `json:"before"`
+	After  T `json:"after"`
+}
+
+// Empty reports whether the configs were identical.
+func (d AutoscalingDiff) Empty() bool {
+	return d == AutoscalingDiff{}
+}
+
+// NewAutoscalingDiff compares before and after. A nil config counts as
+// disabled with no targets.
+func NewAutoscalingDiff(before, after *autoscaling.AutoscalingConfig) AutoscalingDiff {
+	b, a := autoscalingOrZero(before), autoscalingOrZero(after)
+
+	var diff AutoscalingDiff
+	diff.Enabled = newAutoscalingFieldDiff(b.Enabled, a.Enabled)
+	// Disabled configs keep their last bounds, which are not meaningful.
+	if a.Enabled {
+		diff.Min = newAutoscalingFieldDiff(b.Min, a.Min)
+		diff.Max = newAutoscalingFieldDiff(b.Max, a.Max)
+		diff.CPUTarget = newAutoscalingFieldDiff(AutoscalingTarget(b.Criteria.Cpu), AutoscalingTarget(a.Criteria.Cpu))
+		diff.MemoryTarget = newAutoscalingFieldDiff(AutoscalingTarget(b.Criteria.Memory), AutoscalingTarget(a.Criteria.Memory))
+	}
+	return diff
+}
+
+// AutoscalingTarget returns the target percentage, or nil when the criterion
+// is off.
+func AutoscalingTarget(c autoscaling.AutoscalingCriteriaPercentage) *int {
+	if !c.Enabled {
+		return nil
+	}
+	return pointers.From(c.Percentage)
+}
+
+func autoscalingOrZero(cfg *autoscaling.AutoscalingConfig) autoscaling.AutoscalingConfig {
+	if cfg == nil {
+		return autoscaling.AutoscalingConfig{}
+	}
+	return *cfg
+}
+
+func newAutoscalingFieldDiff[T any](before, after T) *AutoscalingFieldDiff[T] {
+	if reflect.DeepEqual(before, after) {
+		return nil
+	}
+	return &AutoscalingFieldDiff[T]{Before: before, After: after}
+}
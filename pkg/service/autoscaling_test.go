@@ -0,0 +1,76 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	autoscaling "github.com/render-oss/cli/pkg/client/autoscaling"
+	"github.com/render-oss/cli/pkg/pointers"
+	servicetypes "github.com/render-oss/cli/pkg/types/service"
+)
+
+func TestApplyAutoscalingInput(t *testing.T) {
+	current := &autoscaling.AutoscalingConfig{
+		Enabled: true,
+		Min:     2,
+		Max:     6,
+		Criteria: autoscaling.AutoscalingCriteria{
+			Cpu: autoscaling.AutoscalingCriteriaPercentage{Enabled: true, Percentage: 60},
+		},
+	}
+
+	t.Run("keeps omitted fields", func(t *testing.T) {
+		cfg, err := ApplyAutoscalingInput(current, servicetypes.AutoscalingSetInput{Max: pointers.From(8)})
+		require.NoError(t, err)
+		assert.Equal(t, 2, cfg.Min)
+		assert.Equal(t, 8, cfg.Max)
+		assert.Equal(t, current.Criteria, cfg.Criteria)
+	})
+
+	t.Run("zero target turns a criterion off", func(t *testing.T) {
+		cfg, err := ApplyAutoscalingInput(current, servicetypes.AutoscalingSetInput{
+			CPUTarget:    pointers.From(0),
+			MemoryTarget: pointers.From(75),
+		})
+		require.NoError(t, err)
+		assert.False(t, cfg.Criteria.Cpu.Enabled)
+		assert.Equal(t, autoscaling.AutoscalingCriteriaPercentage{Enabled: true, Percentage: 75}, cfg.Criteria.Memory)
+	})
+
+	t.Run("needs a target", func(t *testing.T) {
+		_, err := ApplyAutoscalingInput(current, servicetypes.AutoscalingSetInput{CPUTarget: pointers.From(0)})
+		require.ErrorContains(t, err, "needs a CPU or memory target")
+	})
+
+	t.Run("min above current max", func(t *testing.T) {
+		_, err := ApplyAutoscalingInput(current, servicetypes.AutoscalingSetInput{Min: pointers.From(7)})
+		require.ErrorContains(t, err, "must not be greater than maximum")
+	})
+
+	t.Run("re-enables a disabled config", func(t *testing.T) {
+		disabled := *current
+		disabled.Enabled = false
+		cfg, err := ApplyAutoscalingInput(&disabled, servicetypes.AutoscalingSetInput{})
+		require.NoError(t, err)
+		assert.True(t, cfg.Enabled)
+	})
+}
+
+func TestNewAutoscalingDiff(t *testing.T) {
+	before := &autoscaling.AutoscalingConfig{Enabled: true, Min: 1, Max: 3}
+	after := &autoscaling.AutoscalingConfig{Enabled: true, Min: 1, Max: 5}
+
+	diff := NewAutoscalingDiff(before, after)
+	assert.Nil(t, diff.Enabled)
+	assert.Nil(t, diff.Min)
+	assert.Equal(t, &AutoscalingFieldDiff[int]{Before: 3, After: 5}, diff.Max)
+
+	assert.True(t, NewAutoscalingDiff(before, before).Empty())
+	assert.True(t, NewAutoscalingDiff(nil, nil).Empty())
+
+	disable := NewAutoscalingDiff(before, nil)
+	assert.Equal(t, &AutoscalingFieldDiff[bool]{Before: true, After: false}, disable.Enabled)
+	assert.Nil(t, disable.Max)
+}
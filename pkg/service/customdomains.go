@@ -0,0 +1,245 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/render-oss/cli/pkg/client"
+	"github.com/render-oss/cli/pkg/tui"
+)
+
+// RenderLoadBalancerIP is the A record target for apex domains whose DNS
+// provider does not support ALIAS or ANAME records.
+const RenderLoadBalancerIP = "216.24.57.1"
+
+// ErrCustomDomainWaitTimeout is returned by WaitForCustomDomains when the
+// timeout elapses before every domain is verified.
+var ErrCustomDomainWaitTimeout = errors.New("timed out waiting for custom domain verification")
+
+// As with the env var methods, the custom domain methods below expect a
+// service id already resolved against the active workspace.
+
+// ListCustomDomains returns every custom domain attached to the service.
+func (s *Repo) ListCustomDomains(ctx context.Context, serviceID string) ([]client.CustomDomain, error) {
+	return client.ListAll(ctx, &client.ListCustomDomainsParams{}, func(ctx context.Context, params *client.ListCustomDomainsParams) ([]client.CustomDomain, *client.Cursor, error) {
+		return s.listCustomDomainsPage(ctx, serviceID, params)
+	})
+}
+
+func (s *Repo) listCustomDomainsPage(ctx context.Context, serviceID string, params *client.ListCustomDomainsParams) ([]client.CustomDomain, *client.Cursor, error) {
+	resp, err := s.client.ListCustomDomainsWithResponse(ctx, serviceID, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, nil, err
+	}
+	if resp.JSON200 == nil || len(*resp.JSON200) == 0 {
+		return nil, nil, nil
+	}
+
+	res := *resp.JSON200
+	domains := make([]client.CustomDomain, 0, len(res))
+	for _, domainWithCursor := range res {
+		domains = append(domains, domainWithCursor.CustomDomain)
+	}
+
+	return domains, &res[len(res)-1].Cursor, nil
+}
+
+// GetCustomDomain returns a single custom domain by ID or name.
+func (s *Repo) GetCustomDomain(ctx context.Context, serviceID, idOrName string) (*client.CustomDomain, error) {
+	resp, err := s.client.RetrieveCustomDomainWithResponse(ctx, serviceID, idOrName)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode() == http.StatusNotFound {
+		return nil, customDomainNotFoundError(serviceID, idOrName)
+	}
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, fmt.Errorf("custom domain %q lookup failed: empty response", idOrName)
+	}
+
+	return resp.JSON200, nil
+}
+
+// CreateCustomDomain attaches name to the service. Render may create more than
+// one domain, e.g. a www redirect when name is an apex domain, so every
+// created domain is returned.
+func (s *Repo) CreateCustomDomain(ctx context.Context, serviceID, name string) ([]client.CustomDomain, error) {
+	resp, err := s.client.CreateCustomDomainWithResponse(ctx, serviceID, client.CreateCustomDomainJSONRequestBody{Name: name})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+	if resp.JSON201 == nil {
+		return []client.CustomDomain{}, nil
+	}
+
+	return *resp.JSON201, nil
+}
+
+// DeleteCustomDomain detaches a custom domain from the service.
+func (s *Repo) DeleteCustomDomain(ctx context.Context, serviceID, idOrName string) error {
+	resp, err := s.client.DeleteCustomDomainWithResponse(ctx, serviceID, idOrName)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode() == http.StatusNotFound {
+		return customDomainNotFoundError(serviceID, idOrName)
+	}
+
+	return client.ErrorFromResponse(resp)
+}
+
+// RefreshCustomDomain asks Render to re-check the domain's DNS records. The
+// check runs asynchronously; fetch the domain afterwards to see the result.
+func (s *Repo) RefreshCustomDomain(ctx context.Context, serviceID, idOrName string) error {
+	resp, err := s.client.RefreshCustomDomainWithResponse(ctx, serviceID, idOrName)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode() == http.StatusNotFound {
+		return customDomainNotFoundError(serviceID, idOrName)
+	}
+
+	return client.ErrorFromResponse(resp)
+}
+
+// WaitForCustomDomains refreshes each unverified domain every interval until
+// all of them are verified or timeout elapses. It always returns the latest
+// state of every domain, in the order given, alongside
+// ErrCustomDomainWaitTimeout on timeout.
+func (s *Repo) WaitForCustomDomains(ctx context.Context, serviceID string, idsOrNames []string, timeout, interval time.Duration) ([]client.CustomDomain, error) {
+	timeoutTimer := time.NewTimer(timeout)
+	defer timeoutTimer.Stop()
+
+	for {
+		domains, err := s.RefreshCustomDomains(ctx, serviceID, idsOrNames)
+		if err != nil {
+			return nil, err
+		}
+		if AllCustomDomainsVerified(domains) {
+			return domains, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return domains, ctx.Err()
+		case <-timeoutTimer.C:
+			return domains, ErrCustomDomainWaitTimeout
+		case <-time.After(interval):
+		}
+	}
+}
+
+// RefreshCustomDomains refreshes each unverified domain once and returns the
+// latest state of every domain, in the order given.
+func (s *Repo) RefreshCustomDomains(ctx context.Context, serviceID string, idsOrNames []string) ([]client.CustomDomain, error) {
+	domains := make([]client.CustomDomain, 0, len(idsOrNames))
+	for _, idOrName := range idsOrNames {
+		d, err := s.GetCustomDomain(ctx, serviceID, idOrName)
+		if err != nil {
+			return nil, err
+		}
+		if d.VerificationStatus != client.CustomDomainVerificationStatusVerified {
+			if err := s.RefreshCustomDomain(ctx, serviceID, idOrName); err != nil {
+				return nil, err
+			}
+			if d, err = s.GetCustomDomain(ctx, serviceID, idOrName); err != nil {
+				return nil, err
+			}
+		}
+		domains = append(domains, *d)
+	}
+	return domains, nil
+}
+
+// AllCustomDomainsVerified reports whether every domain has passed DNS
+// verification.
+func AllCustomDomainsVerified(domains []client.CustomDomain) bool {
+	for _, d := range domains {
+		if d.VerificationStatus != client.CustomDomainVerificationStatusVerified {
+			return false
+		}
+	}
+	return true
+}
+
+// DNSRecord is a record the user must create with their DNS provider for a
+// custom domain to verify.
+type DNSRecord struct {
+	Type  string `json:"type"`
+	Name  string `json:"name"`
+	Value string `json:"value"`
+	Note  string `json:"note,omitempty"`
+}
+
+// CustomDomainDNSRecords returns the records that point domain at the service
+// whose onrender.com hostname is serviceHost. Apex domains get an ALIAS record
+// with an A record fallback; subdomains get a CNAME. It returns nil when
+// serviceHost is empty.
+func CustomDomainDNSRecords(domain client.CustomDomain, serviceHost string) []DNSRecord {
+	if serviceHost == "" {
+		return nil
+	}
+	if domain.DomainType == client.CustomDomainDomainTypeApex {
+		return []DNSRecord{
+			{Type: "ALIAS", Name: domain.Name, Value: serviceHost, Note: "use ANAME if your DNS provider calls it that"},
+			{Type: "A", Name: domain.Name, Value: RenderLoadBalancerIP, Note: "only if your DNS provider supports neither ALIAS nor ANAME"},
+		}
+	}
+	return []DNSRecord{
+		{Type: "CNAME", Name: domain.Name, Value: serviceHost},
+	}
+}
+
+// ServiceHostname returns the onrender.com hostname of a web service or static
+// site, or "" for service types that are not publicly reachable.
+func ServiceHostname(svc *client.Service) string {
+	if svc == nil {
+		return ""
+	}
+
+	var raw string
+	switch svc.Type {
+	case client.WebService:
+		details, err := svc.ServiceDetails.AsWebServiceDetails()
+		if err != nil {
+			return ""
+		}
+		raw = details.Url
+	case client.StaticSite:
+		details, err := svc.ServiceDetails.AsStaticSiteDetails()
+		if err != nil {
+			return ""
+		}
+		raw = details.Url
+	default:
+		return ""
+	}
+
+	if u, err := url.Parse(raw); err == nil && u.Host != "" {
+		return u.Host
+	}
+	return strings.TrimSuffix(raw, "/")
+}
+
+func customDomainNotFoundError(serviceID, idOrName string) tui.UserFacingError {
+	return tui.UserFacingError{Message: fmt.Sprintf("No custom domain '%s' on service %s.", idOrName, serviceID)}
+}
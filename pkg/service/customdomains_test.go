@@ -0,0 +1,61 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/render-oss/cli/pkg/client"
+)
+
+func TestCustomDomainDNSRecords(t *testing.T) {
+	t.Run("subdomain gets a CNAME", func(t *testing.T) {
+		records := CustomDomainDNSRecords(client.CustomDomain{
+			Name:       "app.example.com",
+			DomainType: client.CustomDomainDomainTypeSubdomain,
+		}, "my-site.onrender.com")
+
+		assert.Equal(t, []DNSRecord{{Type: "CNAME", Name: "app.example.com", Value: "my-site.onrender.com"}}, records)
+	})
+
+	t.Run("apex gets ALIAS with an A record fallback", func(t *testing.T) {
+		records := CustomDomainDNSRecords(client.CustomDomain{
+			Name:       "example.com",
+			DomainType: client.CustomDomainDomainTypeApex,
+		}, "my-site.onrender.com")
+
+		require.Len(t, records, 2)
+		assert.Equal(t, "ALIAS", records[0].Type)
+		assert.Equal(t, "my-site.onrender.com", records[0].Value)
+		assert.Equal(t, "A", records[1].Type)
+		assert.Equal(t, RenderLoadBalancerIP, records[1].Value)
+	})
+
+	t.Run("no records without a service hostname", func(t *testing.T) {
+		assert.Nil(t, CustomDomainDNSRecords(client.CustomDomain{Name: "app.example.com"}, ""))
+	})
+}
+
+func TestServiceHostname(t *testing.T) {
+	newService := func(t *testing.T, serviceType client.ServiceType, url string) *client.Service {
+		t.Helper()
+		svc := &client.Service{Type: serviceType}
+		var err error
+		switch serviceType {
+		case client.WebService:
+			err = svc.ServiceDetails.FromWebServiceDetails(client.WebServiceDetails{Url: url})
+		case client.StaticSite:
+			err = svc.ServiceDetails.FromStaticSiteDetails(client.StaticSiteDetails{Url: url})
+		case client.BackgroundWorker:
+			err = svc.ServiceDetails.FromBackgroundWorkerDetails(client.BackgroundWorkerDetails{})
+		}
+		require.NoError(t, err)
+		return svc
+	}
+
+	assert.Equal(t, "my-api.onrender.com", ServiceHostname(newService(t, client.WebService, "https://my-api.onrender.com")))
+	assert.Equal(t, "my-site.onrender.com", ServiceHostname(newService(t, client.StaticSite, "https://my-site.onrender.com/")))
+	assert.Empty(t, ServiceHostname(newService(t, client.BackgroundWorker, "")))
+	assert.Empty(t, ServiceHostname(nil))
+}
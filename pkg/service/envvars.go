@@ -0,0 +1,214 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/render-oss/cli/pkg/client"
+	envvar "github.com/render-oss/cli/pkg/client/envvar"
+	"github.com/render-oss/cli/pkg/tui"
+)
+
+// The env var methods below do not re-fetch the service to validate workspace
+// ownership, so callers must pass an id already resolved against the active
+// workspace (see ResolveServiceIDFromNameOrID).
+
+// ListEnvVars returns every environment variable set on the service.
+func (s *Repo) ListEnvVars(ctx context.Context, serviceID string) ([]client.EnvVar, error) {
+	return client.ListAll(ctx, &client.GetEnvVarsForServiceParams{}, func(ctx context.Context, params *client.GetEnvVarsForServiceParams) ([]client.EnvVar, *client.Cursor, error) {
+		return s.listEnvVarsPage(ctx, serviceID, params)
+	})
+}
+
+func (s *Repo) listEnvVarsPage(ctx context.Context, serviceID string, params *client.GetEnvVarsForServiceParams) ([]client.EnvVar, *client.Cursor, error) {
+	resp, err := s.client.GetEnvVarsForServiceWithResponse(ctx, serviceID, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, nil, err
+	}
+	if resp.JSON200 == nil || len(*resp.JSON200) == 0 {
+		return nil, nil, nil
+	}
+
+	res := *resp.JSON200
+	envVars := make([]client.EnvVar, 0, len(res))
+	for _, envVarWithCursor := range res {
+		envVars = append(envVars, envVarWithCursor.EnvVar)
+	}
+
+	return envVars, &res[len(res)-1].Cursor, nil
+}
+
+// GetEnvVar returns a single environment variable by key.
+func (s *Repo) GetEnvVar(ctx context.Context, serviceID, key string) (*client.EnvVar, error) {
+	resp, err := s.client.RetrieveEnvVarWithResponse(ctx, serviceID, key)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode() == http.StatusNotFound {
+		return nil, envVarNotFoundError(serviceID, key)
+	}
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, fmt.Errorf("environment variable %q lookup failed: empty response", key)
+	}
+
+	return resp.JSON200, nil
+}
+
+// SetEnvVar adds or updates a single environment variable, leaving all other
+// variables on the service untouched.
+func (s *Repo) SetEnvVar(ctx context.Context, serviceID, key, value string) (*client.EnvVar, error) {
+	var body client.UpdateEnvVarJSONRequestBody
+	if err := body.FromEnvVarValue(client.EnvVarValue{Value: value}); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.UpdateEnvVarWithResponse(ctx, serviceID, key, body)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+
+	return resp.JSON200, nil
+}
+
+// DeleteEnvVar removes a single environment variable from the service.
+func (s *Repo) DeleteEnvVar(ctx context.Context, serviceID, key string) error {
+	resp, err := s.client.DeleteEnvVarWithResponse(ctx, serviceID, key)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode() == http.StatusNotFound {
+		return envVarNotFoundError(serviceID, key)
+	}
+
+	return client.ErrorFromResponse(resp)
+}
+
+// ReplaceEnvVars replaces the service's full set of environment variables.
+// Any variable not present in envVars is removed from the service.
+func (s *Repo) ReplaceEnvVars(ctx context.Context, serviceID string, envVars []client.EnvVar) ([]client.EnvVar, error) {
+	body := make(client.UpdateEnvVarsForServiceJSONRequestBody, 0, len(envVars))
+	for _, ev := range envVars {
+		var input envvar.EnvVarInput
+		if err := input.FromEnvVarKeyValue(envvar.EnvVarKeyValue{Key: ev.Key, Value: ev.Value}); err != nil {
+			return nil, err
+		}
+		body = append(body, input)
+	}
+
+	resp, err := s.client.UpdateEnvVarsForServiceWithResponse(ctx, serviceID, body)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, nil
+	}
+
+	updated := make([]client.EnvVar, 0, len(*resp.JSON200))
+	for _, envVarWithCursor := range *resp.JSON200 {
+		updated = append(updated, envVarWithCursor.EnvVar)
+	}
+	return updated, nil
+}
+
+// EnvVarChanges lists the keys affected by moving from one set of environment
+// variables to another. Each slice is sorted.
+type EnvVarChanges struct {
+	Added   []string `json:"added"`
+	Updated []string `json:"updated"`
+	Removed []string `json:"removed"`
+}
+
+// Empty reports whether the change set has no effect.
+func (c EnvVarChanges) Empty() bool {
+	return len(c.Added) == 0 && len(c.Updated) == 0 && len(c.Removed) == 0
+}
+
+// DiffEnvVars compares before and after by key. A key present in both with a
+// different value counts as updated.
+func DiffEnvVars(before, after []client.EnvVar) EnvVarChanges {
+	beforeByKey := envVarMap(before)
+	afterByKey := envVarMap(after)
+
+	changes := EnvVarChanges{
+		Added:   []string{},
+		Updated: []string{},
+		Removed: []string{},
+	}
+	for key, value := range afterByKey {
+		prev, ok := beforeByKey[key]
+		switch {
+		case !ok:
+			changes.Added = append(changes.Added, key)
+		case prev != value:
+			changes.Updated = append(changes.Updated, key)
+		}
+	}
+	for key := range beforeByKey {
+		if _, ok := afterByKey[key]; !ok {
+			changes.Removed = append(changes.Removed, key)
+		}
+	}
+
+	sort.Strings(changes.Added)
+	sort.Strings(changes.Updated)
+	sort.Strings(changes.Removed)
+	return changes
+}
+
+// MergeEnvVars returns base with every variable in overrides applied on top.
+// The result is sorted by key.
+func MergeEnvVars(base, overrides []client.EnvVar) []client.EnvVar {
+	merged := envVarMap(base)
+	for _, ev := range overrides {
+		merged[ev.Key] = ev.Value
+	}
+	return EnvVarsFromMap(merged)
+}
+
+// EnvVarsFromMap converts a key/value map to a slice sorted by key.
+func EnvVarsFromMap(vars map[string]string) []client.EnvVar {
+	out := make([]client.EnvVar, 0, len(vars))
+	for key, value := range vars {
+		out = append(out, client.EnvVar{Key: key, Value: value})
+	}
+	SortEnvVars(out)
+	return out
+}
+
+// SortEnvVars sorts envVars in place by key.
+func SortEnvVars(envVars []client.EnvVar) {
+	sort.Slice(envVars, func(i, j int) bool {
+		return envVars[i].Key < envVars[j].Key
+	})
+}
+
+func envVarNotFoundError(serviceID, key string) tui.UserFacingError {
+	return tui.UserFacingError{Message: fmt.Sprintf("No environment variable '%s' is set on service %s.", key, serviceID)}
+}
+
+func envVarMap(envVars []client.EnvVar) map[string]string {
+	m := make(map[string]string, len(envVars))
+	for _, ev := range envVars {
+		m[ev.Key] = ev.Value
+	}
+	return m
+}
@@ -0,0 +1,46 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/render-oss/cli/pkg/client"
+)
+
+func TestDiffEnvVars(t *testing.T) {
+	before := []client.EnvVar{
+		{Key: "SAME", Value: "1"},
+		{Key: "CHANGED", Value: "old"},
+		{Key: "GONE", Value: "x"},
+	}
+	after := []client.EnvVar{
+		{Key: "SAME", Value: "1"},
+		{Key: "CHANGED", Value: "new"},
+		{Key: "B_NEW", Value: "b"},
+		{Key: "A_NEW", Value: "a"},
+	}
+
+	changes := DiffEnvVars(before, after)
+
+	assert.Equal(t, EnvVarChanges{
+		Added:   []string{"A_NEW", "B_NEW"},
+		Updated: []string{"CHANGED"},
+		Removed: []string{"GONE"},
+	}, changes)
+	assert.False(t, changes.Empty())
+	assert.True(t, DiffEnvVars(before, before).Empty())
+}
+
+func TestMergeEnvVars(t *testing.T) {
+	merged := MergeEnvVars(
+		[]client.EnvVar{{Key: "B", Value: "base"}, {Key: "KEEP", Value: "k"}},
+		[]client.EnvVar{{Key: "B", Value: "override"}, {Key: "A", Value: "new"}},
+	)
+
+	assert.Equal(t, []client.EnvVar{
+		{Key: "A", Value: "new"},
+		{Key: "B", Value: "override"},
+		{Key: "KEEP", Value: "k"},
+	}, merged)
+}
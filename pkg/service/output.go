@@ -1,6 +1,9 @@
 package service
 
-import "github.com/render-oss/cli/pkg/client"
+import (
+	"github.com/render-oss/cli/pkg/client"
+	autoscaling "github.com/render-oss/cli/pkg/client/autoscaling"
+)
 
 type ServiceOut struct {
 	client.Service
@@ -24,6 +27,61 @@ type DeleteOutMeta struct {
 	Message string `json:"message,omitempty"`
 }
 
+type SuspendOut struct {
+	Data ServiceOut     `json:"data"`
+	Meta SuspendOutMeta `json:"meta"`
+}
+
+type SuspendOutMeta struct {
+	Suspended bool   `json:"suspended"`
+	Message   string `json:"message,omitempty"`
+}
+
+type ResumeOut struct {
+	Data ServiceOut `json:"data"`
+}
+
+// ScaleOut describes a manual scaling change. Data holds the service after
+// the change, or as it is now when the change was only previewed.
+type ScaleOut struct {
+	Data ServiceOut   `json:"data"`
+	Meta ScaleOutMeta `json:"meta"`
+}
+
+type ScaleOutMeta struct {
+	Before  int    `json:"before"`
+	After   int    `json:"after"`
+	Scaled  bool   `json:"scaled"`
+	Message string `json:"message,omitempty"`
+}
+
+// AutoscalingOut is the JSON/YAML contract for a service's autoscaling
+// config. Config is nil when autoscaling has never been configured.
+type AutoscalingOut struct {
+	Data AutoscalingOutData `json:"data"`
+}
+
+type AutoscalingOutData struct {
+	ServiceID    string                         `json:"serviceId"`
+	ServiceName  string                         `json:"serviceName"`
+	NumInstances int                            `json:"numInstances"`
+	Config       *autoscaling.AutoscalingConfig `json:"autoscaling"`
+}
+
+// AutoscalingChangeOut describes an autoscaling change. Data holds the
+// config after the change, or the proposed config when the change was only
+// previewed.
+type AutoscalingChangeOut struct {
+	Data AutoscalingOutData       `json:"data"`
+	Diff AutoscalingDiff          `json:"diff"`
+	Meta AutoscalingChangeOutMeta `json:"meta"`
+}
+
+type AutoscalingChangeOutMeta struct {
+	Applied bool   `json:"applied"`
+	Message string `json:"message,omitempty"`
+}
+
 func newServiceOutFromModel(model *Model) ServiceOut {
 	if model == nil {
 		return ServiceOut{}
@@ -52,9 +110,99 @@ func NewDeleteOutFromModel(model *Model) DeleteOut {
 	}
 }
 
+// NewServiceOutFromModel constructs a [ServiceOut] from a service [Model].
+func NewServiceOutFromModel(model *Model) ServiceOut {
+	return newServiceOutFromModel(model)
+}
+
 // NewUpdateOutFromModel constructs an [UpdateOut] from a service [Model].
 func NewUpdateOutFromModel(model *Model) UpdateOut {
 	return UpdateOut{
 		Data: newServiceOutFromModel(model),
 	}
 }
+
+// EnvVarListOut is the JSON/YAML contract for a service's environment variables.
+type EnvVarListOut struct {
+	Data []client.EnvVar `json:"data"`
+	// ShowValues controls whether text output reveals values. It is not part
+	// of the JSON/YAML contract, which always includes values.
+	ShowValues bool `json:"-"`
+}
+
+// EnvVarGetOut is the JSON/YAML contract for a single environment variable.
+type EnvVarGetOut struct {
+	Data       client.EnvVar `json:"data"`
+	ShowValues bool          `json:"-"`
+}
+
+// EnvVarChangeOut describes the result of setting, unsetting, or importing
+// environment variables. Data holds the service's variables after the change
+// (or the current variables when the change was only previewed).
+type EnvVarChangeOut struct {
+	Data []client.EnvVar  `json:"data"`
+	Meta EnvVarChangeMeta `json:"meta"`
+}
+
+type EnvVarChangeMeta struct {
+	ServiceID string        `json:"serviceId"`
+	Applied   bool          `json:"applied"`
+	Changes   EnvVarChanges `json:"changes"`
+	Message   string        `json:"message,omitempty"`
+}
+
+// EnvVarExportOut is the result of exporting a service's environment
+// variables. Path is empty when the variables were written to stdout.
+// Content holds the rendered .env file for text output.
+type EnvVarExportOut struct {
+	Data    []client.EnvVar     `json:"data"`
+	Meta    EnvVarExportOutMeta `json:"meta"`
+	Content string              `json:"-"`
+}
+
+type EnvVarExportOutMeta struct {
+	Path string `json:"path,omitempty"`
+}
+
+// CustomDomainOut is a custom domain together with the DNS records that point
+// it at the service.
+type CustomDomainOut struct {
+	client.CustomDomain
+	DNSRecords []DNSRecord `json:"dnsRecords"`
+}
+
+// NewCustomDomainOuts pairs each domain with its DNS records for the service
+// reachable at serviceHost.
+func NewCustomDomainOuts(domains []client.CustomDomain, serviceHost string) []CustomDomainOut {
+	out := make([]CustomDomainOut, 0, len(domains))
+	for _, d := range domains {
+		records := CustomDomainDNSRecords(d, serviceHost)
+		if records == nil {
+			records = []DNSRecord{}
+		}
+		out = append(out, CustomDomainOut{CustomDomain: d, DNSRecords: records})
+	}
+	return out
+}
+
+// CustomDomainListOut is the JSON/YAML contract for listing, adding, and
+// verifying custom domains.
+type CustomDomainListOut struct {
+	Data []CustomDomainOut       `json:"data"`
+	Meta CustomDomainListOutMeta `json:"meta"`
+}
+
+type CustomDomainListOutMeta struct {
+	ServiceID string `json:"serviceId"`
+	// Verified is true when every domain in Data has passed DNS verification.
+	Verified bool `json:"verified"`
+	// TimedOut is true when --wait gave up before every domain verified.
+	TimedOut bool   `json:"timedOut,omitempty"`
+	Message  string `json:"message,omitempty"`
+}
+
+// CustomDomainRemoveOut is the JSON/YAML contract for removing a custom domain.
+type CustomDomainRemoveOut struct {
+	Data CustomDomainOut `json:"data"`
+	Meta DeleteOutMeta   `json:"meta"`
+}
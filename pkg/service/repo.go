@@ -161,6 +161,35 @@ func (s *Repo) RestartService(ctx context.Context, id string) error {
 	return nil
 }
 
+func (s *Repo) SuspendService(ctx context.Context, id string) error {
+	resp, err := s.client.SuspendServiceWithResponse(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return client.ErrorFromResponse(resp)
+}
+
+func (s *Repo) ResumeService(ctx context.Context, id string) error {
+	resp, err := s.client.ResumeServiceWithResponse(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return client.ErrorFromResponse(resp)
+}
+
+func (s *Repo) ScaleService(ctx context.Context, id string, numInstances int) error {
+	resp, err := s.client.ScaleServiceWithResponse(ctx, id, client.ScaleServiceJSONRequestBody{
+		NumInstances: numInstances,
+	})
+	if err != nil {
+		return err
+	}
+
+	return client.ErrorFromResponse(resp)
+}
+
 func (s *Repo) ResolveServiceIDFromNameOrID(ctx context.Context, idOrName string) (string, error) {
 	return s.resolveServiceIDFromNameOrID(ctx, idOrName, looksLikeServiceID)
 }
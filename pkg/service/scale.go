@@ -0,0 +1,47 @@
+package service
+
+import (
+	"github.com/render-oss/cli/pkg/client"
+	autoscaling "github.com/render-oss/cli/pkg/client/autoscaling"
+)
+
+// Scaling describes how many instances a service runs.
+type Scaling struct {
+	Plan         client.Plan
+	NumInstances int
+	Autoscaling  *autoscaling.AutoscalingConfig
+}
+
+// Autoscaled reports whether autoscaling manages the instance count, in which
+// case NumInstances is not authoritative.
+func (s Scaling) Autoscaled() bool {
+	return s.Autoscaling != nil && s.Autoscaling.Enabled
+}
+
+// ScalingForService reads the scaling settings from svc's details. The
+// boolean is false for service types that cannot be scaled, such as static
+// sites and cron jobs.
+func ScalingForService(svc *client.Service) (Scaling, bool) {
+	switch svc.Type {
+	case client.WebService:
+		details, err := svc.ServiceDetails.AsWebServiceDetails()
+		if err != nil {
+			return Scaling{}, false
+		}
+		return Scaling{Plan: details.Plan, NumInstances: details.NumInstances, Autoscaling: details.Autoscaling}, true
+	case client.PrivateService:
+		details, err := svc.ServiceDetails.AsPrivateServiceDetails()
+		if err != nil {
+			return Scaling{}, false
+		}
+		return Scaling{Plan: details.Plan, NumInstances: details.NumInstances, Autoscaling: details.Autoscaling}, true
+	case client.BackgroundWorker:
+		details, err := svc.ServiceDetails.AsBackgroundWorkerDetails()
+		if err != nil {
+			return Scaling{}, false
+		}
+		return Scaling{Plan: details.Plan, NumInstances: details.NumInstances, Autoscaling: details.Autoscaling}, true
+	default:
+		return Scaling{}, false
+	}
+}
@@ -4,11 +4,11 @@ import (
 	"encoding/json"
 	"time"
 
-	wfclient "github.com/render-oss/cli/pkg/client/workflows"
+	"github.com/render-oss/cli/pkg/tasks"
 	"github.com/render-oss/cli/pkg/tui"
 )
 
-func TaskRunDetailsFormat(taskRun *wfclient.TaskRunDetails) []tui.KeyValue {
+func TaskRunDetailsFormat(taskRun *tasks.TaskRunDetails) []tui.KeyValue {
 	if taskRun == nil {
 		return nil
 	}
@@ -30,6 +30,10 @@ func TaskRunDetailsFormat(taskRun *wfclient.TaskRunDetails) []tui.KeyValue {
 		{Key: "Completed At", Value: completedAt},
 	}
 
+	if taskRun.RerunOfTaskRunId != nil {
+		keyValues = append(keyValues, tui.KeyValue{Key: "Rerun Of", Value: *taskRun.RerunOfTaskRunId})
+	}
+
 	inputJSON, err := json.Marshal(taskRun.Input)
 	if err == nil {
 		keyValues = append(keyValues, tui.KeyValue{Key: "Input", Value: string(inputJSON)})
@@ -19,6 +19,11 @@ func statusWithStyle(status wfclient.TaskRunStatus) lipgloss.Style {
 	return style.Status.Foreground(style.ColorOK)
 }
 
+// StyledStatus renders status in the color used for it in task run lists.
+func StyledStatus(status wfclient.TaskRunStatus) string {
+	return statusWithStyle(status).Render(string(status))
+}
+
 func Header() []string {
 	return []string{"ID", "Status", "Started", "Completed", "Duration"}
 }
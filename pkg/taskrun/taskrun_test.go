@@ -10,6 +10,7 @@ import (
 
 	wfclient "github.com/render-oss/cli/pkg/client/workflows"
 	"github.com/render-oss/cli/pkg/pointers"
+	"github.com/render-oss/cli/pkg/tasks"
 )
 
 func TestTaskRunDetailsFormat(t *testing.T) {
@@ -25,7 +26,7 @@ func TestTaskRunDetailsFormat(t *testing.T) {
 		var input wfclient.TaskData
 		require.NoError(t, input.FromTaskData1(map[string]any{"key": "value"}))
 
-		taskRun := &wfclient.TaskRunDetails{
+		taskRun := &tasks.TaskRunDetails{TaskRunDetails: wfclient.TaskRunDetails{
 			Id:          "tr-1",
 			Status:      wfclient.Completed,
 			StartedAt:   &startedAt,
@@ -33,7 +34,7 @@ func TestTaskRunDetailsFormat(t *testing.T) {
 			Input:       input,
 			Error:       pointers.From("something went wrong"),
 			Results:     wfclient.TaskRunResult{42},
-		}
+		}}
 
 		kvs := TaskRunDetailsFormat(taskRun)
 		require.NotNil(t, kvs)
@@ -56,13 +57,13 @@ func TestTaskRunDetailsFormat(t *testing.T) {
 		var input wfclient.TaskData
 		require.NoError(t, input.FromTaskData0([]any{}))
 
-		taskRun := &wfclient.TaskRunDetails{
+		taskRun := &tasks.TaskRunDetails{TaskRunDetails: wfclient.TaskRunDetails{
 			Id:          "tr-2",
 			Status:      wfclient.Pending,
 			StartedAt:   nil,
 			CompletedAt: nil,
 			Input:       input,
-		}
+		}}
 
 		kvs := TaskRunDetailsFormat(taskRun)
 		kvMap := make(map[string]string, len(kvs))
@@ -78,12 +79,12 @@ func TestTaskRunDetailsFormat(t *testing.T) {
 		var input wfclient.TaskData
 		require.NoError(t, input.FromTaskData0([]any{}))
 
-		taskRun := &wfclient.TaskRunDetails{
+		taskRun := &tasks.TaskRunDetails{TaskRunDetails: wfclient.TaskRunDetails{
 			Id:     "tr-3",
 			Status: wfclient.Failed,
 			Input:  input,
 			Error:  pointers.From("task timed out"),
-		}
+		}}
 
 		kvs := TaskRunDetailsFormat(taskRun)
 		keys := make([]string, 0, len(kvs))
@@ -98,12 +99,12 @@ func TestTaskRunDetailsFormat(t *testing.T) {
 		var input wfclient.TaskData
 		require.NoError(t, input.FromTaskData0([]any{}))
 
-		taskRun := &wfclient.TaskRunDetails{
+		taskRun := &tasks.TaskRunDetails{TaskRunDetails: wfclient.TaskRunDetails{
 			Id:     "tr-4",
 			Status: wfclient.Running,
 			Input:  input,
 			Error:  nil,
-		}
+		}}
 
 		kvs := TaskRunDetailsFormat(taskRun)
 		keys := make([]string, 0, len(kvs))
@@ -118,12 +119,12 @@ func TestTaskRunDetailsFormat(t *testing.T) {
 		var input wfclient.TaskData
 		require.NoError(t, input.FromTaskData0([]any{}))
 
-		taskRun := &wfclient.TaskRunDetails{
+		taskRun := &tasks.TaskRunDetails{TaskRunDetails: wfclient.TaskRunDetails{
 			Id:      "tr-5",
 			Status:  wfclient.Completed,
 			Input:   input,
 			Results: wfclient.TaskRunResult{"result-data"},
-		}
+		}}
 
 		kvs := TaskRunDetailsFormat(taskRun)
 		keys := make([]string, 0, len(kvs))
@@ -138,12 +139,12 @@ func TestTaskRunDetailsFormat(t *testing.T) {
 		var input wfclient.TaskData
 		require.NoError(t, input.FromTaskData0([]any{}))
 
-		taskRun := &wfclient.TaskRunDetails{
+		taskRun := &tasks.TaskRunDetails{TaskRunDetails: wfclient.TaskRunDetails{
 			Id:      "tr-6",
 			Status:  wfclient.Running,
 			Input:   input,
 			Results: nil,
-		}
+		}}
 
 		kvs := TaskRunDetailsFormat(taskRun)
 		keys := make([]string, 0, len(kvs))
@@ -153,6 +154,28 @@ func TestTaskRunDetailsFormat(t *testing.T) {
 
 		assert.NotContains(t, keys, "Results")
 	})
+
+	t.Run("rerun of field appended when set", func(t *testing.T) {
+		var input wfclient.TaskData
+		require.NoError(t, input.FromTaskData0([]any{}))
+
+		taskRun := &tasks.TaskRunDetails{
+			TaskRunDetails: wfclient.TaskRunDetails{
+				Id:     "tr-7",
+				Status: wfclient.Completed,
+				Input:  input,
+			},
+			RerunOfTaskRunId: pointers.From("tr-1"),
+		}
+
+		kvs := TaskRunDetailsFormat(taskRun)
+		kvMap := make(map[string]string, len(kvs))
+		for _, kv := range kvs {
+			kvMap[kv.Key] = kv.Value
+		}
+
+		assert.Equal(t, "tr-1", kvMap["Rerun Of"])
+	})
 }
 
 func TestRow(t *testing.T) {
@@ -0,0 +1,114 @@
+package taskrun
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	wfclient "github.com/render-oss/cli/pkg/client/workflows"
+	"github.com/render-oss/cli/pkg/tasks"
+)
+
+// TimelineWidth is the number of columns in the timeline column of a run
+// tree.
+const TimelineWidth = 40
+
+const (
+	timelineAttempt       = '█'
+	timelineFailedAttempt = '░'
+)
+
+func TreeHeader() []string {
+	return []string{"Run", "Task", "Status", "Attempts", "Duration", "Timeline"}
+}
+
+// TreeRows returns a row for every run in the tree, depth first, with the
+// run ID indented to show where it sits in the tree. The timeline column
+// charts each run's attempts against the span of the whole tree; failed
+// attempts are drawn lighter than the latest one. Runs still going are
+// charted up to now.
+func TreeRows(root *tasks.RunNode, now time.Time, styleStatus func(wfclient.TaskRunStatus) string) [][]string {
+	start, end := root.Span(now)
+
+	var rows [][]string
+	var add func(node *tasks.RunNode, prefix, branch string)
+	add = func(node *tasks.RunNode, prefix, branch string) {
+		rows = append(rows, []string{
+			prefix + branch + node.Run.Id,
+			node.TaskName,
+			styleStatus(node.Run.Status),
+			fmt.Sprint(attemptCount(node.Run)),
+			treeDuration(node.Run, now),
+			Timeline(node.Run, start, end, now, TimelineWidth),
+		})
+
+		switch branch {
+		case "├─ ":
+			prefix += "│  "
+		case "└─ ":
+			prefix += "   "
+		}
+		for i, child := range node.Children {
+			childBranch := "├─ "
+			if i == len(node.Children)-1 {
+				childBranch = "└─ "
+			}
+			add(child, prefix, childBranch)
+		}
+	}
+	add(root, "", "")
+
+	return rows
+}
+
+// Timeline charts run's attempts between start and end in width columns.
+func Timeline(run *wfclient.TaskRun, start, end, now time.Time, width int) string {
+	line := []rune(strings.Repeat(" ", width))
+	if run.StartedAt == nil || !end.After(start) {
+		return "│" + string(line) + "│"
+	}
+
+	position := func(t time.Time) float64 {
+		return float64(t.Sub(start)) / float64(end.Sub(start)) * float64(width)
+	}
+	// A bar covers every column it overlaps, and always at least one.
+	fill := func(from, to time.Time, r rune) {
+		first := min(max(int(position(from)), 0), width-1)
+		last := min(max(int(math.Ceil(position(to)))-1, first), width-1)
+		for i := first; i <= last; i++ {
+			line[i] = r
+		}
+	}
+
+	if len(run.Attempts) == 0 {
+		fill(*run.StartedAt, tasks.RunEnd(run, now), timelineAttempt)
+	}
+	for i, attempt := range run.Attempts {
+		attemptEnd := now
+		if attempt.CompletedAt != nil {
+			attemptEnd = *attempt.CompletedAt
+		}
+		r := timelineAttempt
+		if i < len(run.Attempts)-1 {
+			r = timelineFailedAttempt
+		}
+		fill(attempt.StartedAt, attemptEnd, r)
+	}
+
+	return "│" + string(line) + "│"
+}
+
+func attemptCount(run *wfclient.TaskRun) int {
+	if len(run.Attempts) == 0 && run.StartedAt != nil {
+		return 1
+	}
+	return len(run.Attempts)
+}
+
+func treeDuration(run *wfclient.TaskRun, now time.Time) string {
+	if run.StartedAt == nil {
+		return ""
+	}
+	return tasks.RunEnd(run, now).Sub(*run.StartedAt).Round(time.Millisecond).String()
+}
@@ -0,0 +1,69 @@
+package taskrun
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	wfclient "github.com/render-oss/cli/pkg/client/workflows"
+	"github.com/render-oss/cli/pkg/tasks"
+)
+
+func TestTreeRows(t *testing.T) {
+	base := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+	at := func(seconds int) *time.Time {
+		ts := base.Add(time.Duration(seconds) * time.Second)
+		return &ts
+	}
+	plain := func(status wfclient.TaskRunStatus) string { return string(status) }
+
+	root := &tasks.RunNode{
+		Run:      &wfclient.TaskRun{Id: "trn-root", Status: wfclient.Completed, StartedAt: at(0), CompletedAt: at(40)},
+		TaskName: "parent",
+		Children: []*tasks.RunNode{
+			{
+				Run: &wfclient.TaskRun{
+					Id: "trn-a", Status: wfclient.Completed, StartedAt: at(0), CompletedAt: at(20),
+					Attempts: []wfclient.TaskAttempt{
+						{Attempt: 0, Status: wfclient.Failed, StartedAt: *at(0), CompletedAt: at(9)},
+						{Attempt: 1, Status: wfclient.Completed, StartedAt: *at(10), CompletedAt: at(19)},
+					},
+				},
+				TaskName: "child",
+				Children: []*tasks.RunNode{
+					{Run: &wfclient.TaskRun{Id: "trn-c", Status: wfclient.Pending}, TaskName: "child"},
+				},
+			},
+			{Run: &wfclient.TaskRun{Id: "trn-b", Status: wfclient.Running, StartedAt: at(20)}, TaskName: "child"},
+		},
+	}
+
+	rows := TreeRows(root, *at(40), plain)
+	require.Len(t, rows, 4)
+
+	var labels []string
+	for _, row := range rows {
+		labels = append(labels, row[0])
+	}
+	assert.Equal(t, []string{"trn-root", "├─ trn-a", "│  └─ trn-c", "└─ trn-b"}, labels)
+
+	assert.Equal(t, []string{"child", "completed", "2", "20s"}, rows[1][1:5])
+	assert.Equal(t, "1", rows[3][3], "a started run without attempt history counts as one attempt")
+	assert.Equal(t, "20s", rows[3][4], "a running run's duration runs up to now")
+	assert.Equal(t, "", rows[2][4], "a pending run has no duration")
+
+	assert.Equal(t, "│"+repeat('█', TimelineWidth)+"│", rows[0][5])
+	assert.Equal(t, "│"+repeat('░', 9)+" "+repeat('█', 9)+repeat(' ', 21)+"│", rows[1][5])
+	assert.Equal(t, "│"+repeat(' ', TimelineWidth)+"│", rows[2][5])
+	assert.Equal(t, "│"+repeat(' ', 20)+repeat('█', 20)+"│", rows[3][5])
+}
+
+func repeat(r rune, n int) string {
+	out := make([]rune, n)
+	for i := range out {
+		out[i] = r
+	}
+	return string(out)
+}
@@ -1,19 +1,40 @@
 package tasks
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
+	"net/url"
 
 	"github.com/render-oss/cli/pkg/client"
 	workflows "github.com/render-oss/cli/pkg/client/workflows"
+	"github.com/render-oss/cli/pkg/config"
 )
 
 func NewRepo(c *client.ClientWithResponses) *Repo {
 	return &Repo{client: c}
 }
 
+// NewLocalRepo returns a Repo for the local workflow development server
+// described by localConfig, which also supports re-running task runs.
+func NewLocalRepo(c *client.ClientWithResponses, localConfig *config.APIConfig) *Repo {
+	return &Repo{
+		client:      c,
+		localConfig: localConfig,
+		httpClient:  &http.Client{},
+	}
+}
+
 type Repo struct {
 	client *client.ClientWithResponses
+
+	// localConfig and httpClient are only set for the local workflow
+	// development server, to call routes the generated client doesn't model.
+	localConfig *config.APIConfig
+	httpClient  *http.Client
 }
 
 func (r *Repo) RunTask(ctx context.Context, taskSlug string, input *workflows.TaskData) (*workflows.TaskRun, error) {
@@ -105,7 +126,7 @@ func (r *Repo) CancelTaskRun(ctx context.Context, taskRunID string) error {
 	return client.ErrorFromResponse(resp)
 }
 
-func (r *Repo) GetTaskRunDetails(ctx context.Context, taskRunID string) (*workflows.TaskRunDetails, error) {
+func (r *Repo) GetTaskRunDetails(ctx context.Context, taskRunID string) (*TaskRunDetails, error) {
 	resp, err := r.client.GetTaskRunWithResponse(ctx, taskRunID)
 	if err != nil {
 		return nil, err
@@ -114,5 +135,56 @@ func (r *Repo) GetTaskRunDetails(ctx context.Context, taskRunID string) (*workfl
 	if err := client.ErrorFromResponse(resp); err != nil {
 		return nil, err
 	}
-	return resp.JSON200, nil
+
+	// Decode the body again rather than using JSON200 to pick up
+	// rerunOfTaskRunId, which the generated type doesn't model.
+	var details TaskRunDetails
+	if err := json.Unmarshal(resp.Body, &details); err != nil {
+		return nil, fmt.Errorf("failed to decode task run: %w", err)
+	}
+	return &details, nil
+}
+
+// RerunTaskRun starts a re-run of taskRunID on the local workflow development
+// server, which links the new run to the original. A nil input reuses the
+// original run's input.
+func (r *Repo) RerunTaskRun(ctx context.Context, taskRunID string, input *workflows.TaskData) (*workflows.TaskRun, error) {
+	if r.localConfig == nil {
+		return nil, errors.New("re-running a task run is only supported by the local workflow development server")
+	}
+
+	body, err := json.Marshal(RerunTaskRunBody{Input: input})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode input: %w", err)
+	}
+	rerunURL, err := url.JoinPath(r.localConfig.Host, "task-runs", url.PathEscape(taskRunID), "rerun")
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, rerunURL, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header = client.AddHeaders(req.Header, r.localConfig.Key)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := r.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusAccepted {
+		var apiErr client.Error
+		if err := json.NewDecoder(resp.Body).Decode(&apiErr); err == nil && apiErr.Message != nil {
+			return nil, fmt.Errorf("received response code %d: %s", resp.StatusCode, *apiErr.Message)
+		}
+		return nil, fmt.Errorf("received response code %d", resp.StatusCode)
+	}
+
+	var run workflows.TaskRun
+	if err := json.NewDecoder(resp.Body).Decode(&run); err != nil {
+		return nil, fmt.Errorf("failed to decode task run: %w", err)
+	}
+	return &run, nil
 }
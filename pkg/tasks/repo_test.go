@@ -0,0 +1,97 @@
+package tasks_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/render-oss/cli/pkg/client"
+	workflows "github.com/render-oss/cli/pkg/client/workflows"
+	"github.com/render-oss/cli/pkg/config"
+	"github.com/render-oss/cli/pkg/tasks"
+)
+
+func TestRerunTaskRun(t *testing.T) {
+	var gotPath, gotAuth string
+	var gotBody map[string]json.RawMessage
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.Method + " " + r.URL.Path
+		gotAuth = r.Header.Get("Authorization")
+		gotBody = nil
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&gotBody))
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		_ = json.NewEncoder(w).Encode(workflows.TaskRun{Id: "trn-new"})
+	}))
+	defer srv.Close()
+
+	localConfig := &config.APIConfig{Host: srv.URL + "/v1/", Key: "local"}
+	c, err := client.NewClientWithResponses(localConfig.Host)
+	require.NoError(t, err)
+	repo := tasks.NewLocalRepo(c, localConfig)
+
+	t.Run("sends the input override", func(t *testing.T) {
+		var input workflows.TaskData
+		require.NoError(t, input.FromTaskData0(workflows.TaskData0{"a"}))
+
+		run, err := repo.RerunTaskRun(context.Background(), "trn-old", &input)
+		require.NoError(t, err)
+
+		require.Equal(t, "trn-new", run.Id)
+		require.Equal(t, "POST /v1/task-runs/trn-old/rerun", gotPath)
+		require.Equal(t, "Bearer local", gotAuth)
+		require.JSONEq(t, `["a"]`, string(gotBody["input"]))
+	})
+
+	t.Run("omits the input without an override", func(t *testing.T) {
+		_, err := repo.RerunTaskRun(context.Background(), "trn-old", nil)
+		require.NoError(t, err)
+
+		require.Equal(t, "POST /v1/task-runs/trn-old/rerun", gotPath)
+		require.NotContains(t, gotBody, "input")
+	})
+
+	t.Run("is only supported locally", func(t *testing.T) {
+		_, err := tasks.NewRepo(c).RerunTaskRun(context.Background(), "trn-old", nil)
+		require.EqualError(t, err, "re-running a task run is only supported by the local workflow development server")
+	})
+}
+
+func TestRerunTaskRunError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message":"task run not found: trn-old"}`))
+	}))
+	defer srv.Close()
+
+	localConfig := &config.APIConfig{Host: srv.URL + "/v1/", Key: "local"}
+	c, err := client.NewClientWithResponses(localConfig.Host)
+	require.NoError(t, err)
+
+	_, err = tasks.NewLocalRepo(c, localConfig).RerunTaskRun(context.Background(), "trn-old", nil)
+	require.EqualError(t, err, "received response code 404: task run not found: trn-old")
+}
+
+func TestGetTaskRunDetails(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"trn-new","taskId":"tsk-1","status":"completed","input":["a"],"rerunOfTaskRunId":"trn-old"}`))
+	}))
+	defer srv.Close()
+
+	c, err := client.NewClientWithResponses(srv.URL + "/v1/")
+	require.NoError(t, err)
+
+	details, err := tasks.NewRepo(c).GetTaskRunDetails(context.Background(), "trn-new")
+	require.NoError(t, err)
+
+	require.Equal(t, "trn-new", details.Id)
+	require.Equal(t, "tsk-1", details.TaskId)
+	require.NotNil(t, details.RerunOfTaskRunId)
+	require.Equal(t, "trn-old", *details.RerunOfTaskRunId)
+}
@@ -0,0 +1,28 @@
+package tasks
+
+import (
+	workflows "github.com/render-oss/cli/pkg/client/workflows"
+)
+
+// RerunTaskRunBody is the optional request body of the local workflow
+// development server's POST /v1/task-runs/{taskRunID}/rerun. When Input is
+// omitted, the original run's input is reused.
+type RerunTaskRunBody struct {
+	Input *workflows.TaskData `json:"input,omitempty"`
+}
+
+// TaskRun is a task run as listed by the API. RerunOfTaskRunId is only set by
+// the local workflow development server.
+type TaskRun struct {
+	workflows.TaskRun
+	// RerunOfTaskRunId is the ID of the task run this run re-ran, if any.
+	RerunOfTaskRunId *string `json:"rerunOfTaskRunId,omitempty"`
+}
+
+// TaskRunDetails is a task run with its input and results. RerunOfTaskRunId
+// is only set by the local workflow development server.
+type TaskRunDetails struct {
+	workflows.TaskRunDetails
+	// RerunOfTaskRunId is the ID of the task run this run re-ran, if any.
+	RerunOfTaskRunId *string `json:"rerunOfTaskRunId,omitempty"`
+}
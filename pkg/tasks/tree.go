@@ -0,0 +1,99 @@
+package tasks
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	workflows "github.com/render-oss/cli/pkg/client/workflows"
+)
+
+// RunNode is a task run together with the subtask runs it spawned.
+type RunNode struct {
+	Run      *workflows.TaskRun `json:"run"`
+	TaskName string             `json:"taskName,omitempty"`
+	Children []*RunNode         `json:"children,omitempty"`
+}
+
+// Walk calls fn for the node and each of its descendants in depth-first
+// order, passing how deep below the root each one is.
+func (n *RunNode) Walk(fn func(node *RunNode, depth int)) {
+	n.walk(fn, 0)
+}
+
+func (n *RunNode) walk(fn func(node *RunNode, depth int), depth int) {
+	fn(n, depth)
+	for _, child := range n.Children {
+		child.walk(fn, depth+1)
+	}
+}
+
+// Span returns the earliest start and latest completion across the node and
+// its descendants. Runs that haven't completed yet are treated as ending at
+// now.
+func (n *RunNode) Span(now time.Time) (time.Time, time.Time) {
+	var start, end time.Time
+	n.Walk(func(node *RunNode, _ int) {
+		if node.Run.StartedAt == nil {
+			return
+		}
+		if start.IsZero() || node.Run.StartedAt.Before(start) {
+			start = *node.Run.StartedAt
+		}
+		runEnd := RunEnd(node.Run, now)
+		if runEnd.After(end) {
+			end = runEnd
+		}
+	})
+	return start, end
+}
+
+// RunEnd returns when run completed, or now if it is still going.
+func RunEnd(run *workflows.TaskRun, now time.Time) time.Time {
+	if run.CompletedAt != nil {
+		return *run.CompletedAt
+	}
+	return now
+}
+
+// BuildRunTree arranges runs, which all share the root run rootTaskRunID,
+// into a tree. Children are ordered by start time. A run whose parent isn't
+// among runs is attached to the root so that it is still shown. taskNames
+// maps task IDs to task names.
+func BuildRunTree(rootTaskRunID string, runs []*workflows.TaskRun, taskNames map[string]string) (*RunNode, error) {
+	nodes := make(map[string]*RunNode, len(runs))
+	for _, run := range runs {
+		nodes[run.Id] = &RunNode{Run: run, TaskName: taskNames[run.TaskId]}
+	}
+
+	root, ok := nodes[rootTaskRunID]
+	if !ok {
+		return nil, fmt.Errorf("root task run %s not found", rootTaskRunID)
+	}
+
+	for _, run := range runs {
+		if run.Id == rootTaskRunID {
+			continue
+		}
+		parent, ok := nodes[run.ParentTaskRunId]
+		if !ok || run.ParentTaskRunId == run.Id {
+			parent = root
+		}
+		parent.Children = append(parent.Children, nodes[run.Id])
+	}
+
+	root.Walk(func(node *RunNode, _ int) {
+		sort.SliceStable(node.Children, func(i, j int) bool {
+			a, b := node.Children[i].Run, node.Children[j].Run
+			if a.StartedAt == nil || b.StartedAt == nil {
+				return a.StartedAt != nil
+			}
+			if a.StartedAt.Equal(*b.StartedAt) {
+				return a.Id < b.Id
+			}
+			return a.StartedAt.Before(*b.StartedAt)
+		})
+	})
+
+	return root, nil
+}
@@ -0,0 +1,65 @@
+package tasks_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	workflows "github.com/render-oss/cli/pkg/client/workflows"
+	"github.com/render-oss/cli/pkg/tasks"
+)
+
+func TestBuildRunTree(t *testing.T) {
+	base := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+	at := func(seconds int) *time.Time {
+		ts := base.Add(time.Duration(seconds) * time.Second)
+		return &ts
+	}
+
+	t.Run("nests runs under their parents in start order", func(t *testing.T) {
+		runs := []*workflows.TaskRun{
+			{Id: "trn-b", ParentTaskRunId: "trn-root", TaskId: "tsk-1", StartedAt: at(2)},
+			{Id: "trn-root", TaskId: "tsk-0", StartedAt: at(0), CompletedAt: at(10)},
+			{Id: "trn-a", ParentTaskRunId: "trn-root", TaskId: "tsk-1", StartedAt: at(1), CompletedAt: at(3)},
+			{Id: "trn-c", ParentTaskRunId: "trn-a", TaskId: "tsk-1"},
+		}
+
+		root, err := tasks.BuildRunTree("trn-root", runs, map[string]string{"tsk-0": "parent", "tsk-1": "child"})
+		require.NoError(t, err)
+
+		assert.Equal(t, "parent", root.TaskName)
+		require.Len(t, root.Children, 2)
+		assert.Equal(t, "trn-a", root.Children[0].Run.Id)
+		assert.Equal(t, "trn-b", root.Children[1].Run.Id)
+		require.Len(t, root.Children[0].Children, 1)
+		assert.Equal(t, "trn-c", root.Children[0].Children[0].Run.Id)
+
+		var depths []int
+		root.Walk(func(_ *tasks.RunNode, depth int) { depths = append(depths, depth) })
+		assert.Equal(t, []int{0, 1, 2, 1}, depths)
+
+		start, end := root.Span(base.Add(time.Minute))
+		assert.Equal(t, base, start)
+		// trn-b is still running, so it is charted up to now.
+		assert.Equal(t, base.Add(time.Minute), end)
+	})
+
+	t.Run("attaches runs with a missing parent to the root", func(t *testing.T) {
+		runs := []*workflows.TaskRun{
+			{Id: "trn-root"},
+			{Id: "trn-orphan", ParentTaskRunId: "trn-gone"},
+		}
+
+		root, err := tasks.BuildRunTree("trn-root", runs, nil)
+		require.NoError(t, err)
+		require.Len(t, root.Children, 1)
+		assert.Equal(t, "trn-orphan", root.Children[0].Run.Id)
+	})
+
+	t.Run("errors when the root run is missing", func(t *testing.T) {
+		_, err := tasks.BuildRunTree("trn-root", []*workflows.TaskRun{{Id: "trn-a"}}, nil)
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,56 @@
+package text
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/jedib0t/go-pretty/table"
+
+	"github.com/render-oss/cli/pkg/auditlog"
+	"github.com/render-oss/cli/pkg/client"
+)
+
+func AuditLogTable(out *auditlog.ListOut) string {
+	if len(out.Data) == 0 {
+		return FormatString("No audit log entries found")
+	}
+	t := newTable()
+	t.AppendHeader(table.Row{"Time", "Event", "Status", "Actor", "Details"})
+	for _, entry := range out.Data {
+		t.AppendRow(table.Row{
+			entry.Timestamp.Local().Format(time.DateTime),
+			entry.Event,
+			entry.Status,
+			auditLogActor(entry.Actor),
+			auditLogMetadata(entry.Metadata),
+		})
+	}
+	return FormatString(t.Render())
+}
+
+func auditLogActor(actor client.AuditLogActor) string {
+	switch {
+	case actor.Email != nil && *actor.Email != "":
+		return *actor.Email
+	case actor.Id != nil && *actor.Id != "":
+		return fmt.Sprintf("%s (%s)", *actor.Id, actor.Type)
+	default:
+		return string(actor.Type)
+	}
+}
+
+func auditLogMetadata(metadata map[string]string) string {
+	keys := make([]string, 0, len(metadata))
+	for k := range metadata {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+	for _, k := range keys {
+		pairs = append(pairs, k+"="+metadata[k])
+	}
+	return strings.Join(pairs, " ")
+}
@@ -0,0 +1,98 @@
+package text
+
+import (
+	"fmt"
+	"strings"
+
+	autoscaling "github.com/render-oss/cli/pkg/client/autoscaling"
+	"github.com/render-oss/cli/pkg/service"
+)
+
+// Autoscaling renders a service's autoscaling config.
+func Autoscaling(out *service.AutoscalingOut) string {
+	return FormatString(autoscalingDetail(out.Data))
+}
+
+// AutoscalingChange renders the diff for an autoscaling change followed by
+// the resulting config.
+func AutoscalingChange(out *service.AutoscalingChangeOut) string {
+	if out.Diff.Empty() {
+		return FormatString(autoscalingDetail(out.Data) + "\n\nNo changes")
+	}
+
+	header := "Updated autoscaling:"
+	if !out.Meta.Applied {
+		header = "This command would change autoscaling:"
+	}
+	lines := []string{header, "", AutoscalingDiff(out.Diff), "", autoscalingDetail(out.Data)}
+	if !out.Meta.Applied {
+		lines = append(lines, "", "Re-run with --confirm to proceed")
+	}
+	return FormatString(strings.Join(lines, "\n"))
+}
+
+// AutoscalingDiff renders one "before → after" line per changed field.
+func AutoscalingDiff(diff service.AutoscalingDiff) string {
+	// Labels are left-padded to a common width so the "before → after"
+	// columns line up.
+	const labelWidth = len("Memory target:")
+	row := func(label, before, after string) string {
+		return fmt.Sprintf("  %-*s %s → %s", labelWidth, label, before, after)
+	}
+
+	var lines []string
+	if diff.Enabled != nil {
+		lines = append(lines, row("Autoscaling:", enabledLabel(diff.Enabled.Before), enabledLabel(diff.Enabled.After)))
+	}
+	if diff.Min != nil {
+		lines = append(lines, row("Min:", fmt.Sprint(diff.Min.Before), fmt.Sprint(diff.Min.After)))
+	}
+	if diff.Max != nil {
+		lines = append(lines, row("Max:", fmt.Sprint(diff.Max.Before), fmt.Sprint(diff.Max.After)))
+	}
+	if diff.CPUTarget != nil {
+		lines = append(lines, row("CPU target:", targetLabel(diff.CPUTarget.Before), targetLabel(diff.CPUTarget.After)))
+	}
+	if diff.MemoryTarget != nil {
+		lines = append(lines, row("Memory target:", targetLabel(diff.MemoryTarget.Before), targetLabel(diff.MemoryTarget.After)))
+	}
+	return strings.Join(lines, "\n")
+}
+
+func autoscalingDetail(data service.AutoscalingOutData) string {
+	lines := []string{
+		fmt.Sprintf("Service: %s (%s)", data.ServiceName, data.ServiceID),
+	}
+	cfg := data.Config
+	if cfg == nil || !cfg.Enabled {
+		lines = append(lines,
+			"Autoscaling: disabled",
+			fmt.Sprintf("Instances: %d", data.NumInstances),
+		)
+		return strings.Join(lines, "\n")
+	}
+	return strings.Join(append(lines,
+		"Autoscaling: enabled",
+		fmt.Sprintf("Instances: %d-%d", cfg.Min, cfg.Max),
+		fmt.Sprintf("CPU target: %s", criterionLabel(cfg.Criteria.Cpu)),
+		fmt.Sprintf("Memory target: %s", criterionLabel(cfg.Criteria.Memory)),
+	), "\n")
+}
+
+func criterionLabel(c autoscaling.AutoscalingCriteriaPercentage) string {
+	return targetLabel(service.AutoscalingTarget(c))
+}
+
+func targetLabel(percent *int) string {
+	if percent == nil {
+		return "off"
+	}
+	return fmt.Sprintf("%d%%", *percent)
+}
+
+func enabledLabel(enabled bool) string {
+	if enabled {
+		return "enabled"
+	}
+	return "disabled"
+}
@@ -0,0 +1,60 @@
+package text
+
+import (
+	"strings"
+
+	"github.com/jedib0t/go-pretty/table"
+
+	"github.com/render-oss/cli/pkg/client"
+	"github.com/render-oss/cli/pkg/service"
+)
+
+// CustomDomainTable renders domains with their verification status, followed
+// by the DNS records still needed for any domain that is not yet verified.
+func CustomDomainTable(out *service.CustomDomainListOut) string {
+	if len(out.Data) == 0 {
+		return FormatStringF("No custom domains on service %s", out.Meta.ServiceID)
+	}
+
+	t := newTable()
+	t.AppendHeader(table.Row{"Name", "Type", "Status", "Redirects To", "ID"})
+	for _, d := range out.Data {
+		t.AppendRow(table.Row{d.Name, d.DomainType, d.VerificationStatus, d.RedirectForName, d.Id})
+	}
+	sections := []string{t.Render()}
+
+	if records := customDomainDNSRecordsBlock(out.Data); records != "" {
+		sections = append(sections, records)
+	}
+	if out.Meta.Message != "" {
+		sections = append(sections, out.Meta.Message)
+	}
+	return FormatString(strings.Join(sections, "\n\n"))
+}
+
+func CustomDomainRemove(out *service.CustomDomainRemoveOut) string {
+	if out.Meta.Deleted {
+		return FormatStringF("Removed custom domain %s", out.Data.Name)
+	}
+	return FormatStringF("This command would remove custom domain %s (%s).\n\nRe-run with --confirm to proceed",
+		out.Data.Name, out.Data.Id)
+}
+
+func customDomainDNSRecordsBlock(domains []service.CustomDomainOut) string {
+	t := newTable()
+	t.AppendHeader(table.Row{"Type", "Name", "Value", "Note"})
+	rows := 0
+	for _, d := range domains {
+		if d.VerificationStatus == client.CustomDomainVerificationStatusVerified {
+			continue
+		}
+		for _, r := range d.DNSRecords {
+			t.AppendRow(table.Row{r.Type, r.Name, r.Value, r.Note})
+			rows++
+		}
+	}
+	if rows == 0 {
+		return ""
+	}
+	return "Create these DNS records with your DNS provider, then run `render services domains verify`:\n\n" + t.Render()
+}
@@ -0,0 +1,99 @@
+package text
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jedib0t/go-pretty/table"
+
+	disks "github.com/render-oss/cli/pkg/client/disks"
+	"github.com/render-oss/cli/pkg/disk"
+	"github.com/render-oss/cli/pkg/pointers"
+)
+
+func DiskTable(out *disk.ListOut) string {
+	if len(out.Data) == 0 {
+		return FormatString("No disks found")
+	}
+	t := newTable()
+	t.AppendHeader(table.Row{"Name", "Mount Path", "Size", "Service", "ID"})
+	for _, d := range out.Data {
+		t.AppendRow(table.Row{
+			d.Name,
+			d.MountPath,
+			diskSize(d.SizeGB),
+			pointers.StringValue(d.ServiceId),
+			d.Id,
+		})
+	}
+	return FormatString(t.Render())
+}
+
+func DiskDetail(d *disks.DiskDetails) string {
+	lines := []string{
+		fmt.Sprintf("Name: %s", d.Name),
+		fmt.Sprintf("ID: %s", d.Id),
+		fmt.Sprintf("Mount path: %s", d.MountPath),
+		fmt.Sprintf("Size: %s", diskSize(d.SizeGB)),
+	}
+	if d.ServiceId != nil {
+		lines = append(lines, fmt.Sprintf("Service: %s", *d.ServiceId))
+	}
+	if !d.CreatedAt.IsZero() {
+		lines = append(lines, fmt.Sprintf("Created: %s", d.CreatedAt.Local().Format(time.DateTime)))
+	}
+	return FormatString(strings.Join(lines, "\n"))
+}
+
+func DiskGet(out *disk.GetOut) string {
+	return DiskDetail(out.Data)
+}
+
+func DiskAdd(out *disk.AddOut) string {
+	return "Added disk:\n\n" + DiskDetail(out.Data)
+}
+
+func DiskResize(out *disk.ResizeOut) string {
+	change := fmt.Sprintf("  Size: %s → %s", diskSize(out.Meta.BeforeGB), diskSize(out.Meta.AfterGB))
+	if out.Meta.Resized {
+		return FormatString("Resized disk " + out.Data.Name + ":\n\n" + change)
+	}
+	return FormatString("This command would resize disk " + out.Data.Name + ":\n\n" + change +
+		"\n\nDisks cannot be shrunk after they are resized.\n\nRe-run with --confirm to proceed")
+}
+
+func DiskDelete(out *disk.DeleteOut) string {
+	return "Deleted this disk:\n\n" + DiskDetail(out.Data)
+}
+
+func DiskSnapshotTable(out *disk.SnapshotListOut) string {
+	if len(out.Data) == 0 {
+		return FormatString(fmt.Sprintf("No snapshots found for disk %s", out.Meta.DiskName))
+	}
+	t := newTable()
+	t.AppendHeader(table.Row{"Created", "Instance", "Snapshot Key"})
+	for _, s := range out.Data {
+		created := ""
+		if s.CreatedAt != nil {
+			created = s.CreatedAt.Local().Format(time.DateTime)
+		}
+		t.AppendRow(table.Row{
+			created,
+			pointers.StringValue(s.InstanceId),
+			pointers.StringValue(s.SnapshotKey),
+		})
+	}
+	return FormatString(t.Render())
+}
+
+func DiskSnapshotRestore(out *disk.SnapshotRestoreOut) string {
+	return FormatString(fmt.Sprintf(
+		"Restoring disk %s from snapshot %s. The service redeploys with the restored data.",
+		out.Data.Name, out.Meta.SnapshotKey,
+	))
+}
+
+func diskSize(gb int) string {
+	return fmt.Sprintf("%d GB", gb)
+}
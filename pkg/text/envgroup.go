@@ -0,0 +1,112 @@
+package text
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jedib0t/go-pretty/table"
+
+	"github.com/render-oss/cli/pkg/client"
+	"github.com/render-oss/cli/pkg/envgroup"
+	"github.com/render-oss/cli/pkg/pointers"
+)
+
+func EnvGroupTable(v []*client.EnvGroupMeta) string {
+	if len(v) == 0 {
+		return FormatString("No env groups found")
+	}
+	t := newTable()
+	t.AppendHeader(table.Row{"Name", "Environment", "Linked Services", "ID"})
+	for _, g := range v {
+		t.AppendRow(table.Row{
+			g.Name,
+			pointers.StringValue(g.EnvironmentId),
+			len(g.ServiceLinks),
+			g.Id,
+		})
+	}
+	return FormatString(t.Render())
+}
+
+func EnvGroupDetail(g *client.EnvGroup, showValues bool) string {
+	lines := []string{
+		fmt.Sprintf("Name: %s", g.Name),
+		fmt.Sprintf("ID: %s", g.Id),
+	}
+	if g.EnvironmentId != nil {
+		lines = append(lines, fmt.Sprintf("Environment: %s", *g.EnvironmentId))
+	}
+
+	lines = append(lines, "", "Linked services:")
+	if len(g.ServiceLinks) == 0 {
+		lines = append(lines, "  (none)")
+	}
+	for _, link := range g.ServiceLinks {
+		lines = append(lines, fmt.Sprintf("  %s (%s, %s)", link.Name, link.Type, link.Id))
+	}
+
+	lines = append(lines, "", "Environment variables:")
+	if len(g.EnvVars) == 0 {
+		lines = append(lines, "  (none)")
+	}
+	for _, ev := range g.EnvVars {
+		lines = append(lines, fmt.Sprintf("  %s=%s", ev.Key, envVarDisplayValue(ev.Value, showValues)))
+	}
+
+	if len(g.SecretFiles) > 0 {
+		lines = append(lines, "", "Secret files:")
+		for _, f := range g.SecretFiles {
+			lines = append(lines, "  "+f.Name)
+		}
+	}
+	return FormatString(strings.Join(lines, "\n"))
+}
+
+func EnvGroupCreate(out *envgroup.CreateOut) string {
+	return "Created env group:\n\n" + EnvGroupDetail(out.Data, false)
+}
+
+func EnvGroupDelete(out *envgroup.DeleteOut) string {
+	if out.Meta.Deleted {
+		return FormatStringF("Deleted env group %s (%s)", out.Data.Name, out.Data.Id)
+	}
+	return FormatStringF("This command would delete env group %s (%s) and unlink %d service(s).\n\nRe-run with --confirm to proceed",
+		out.Data.Name, out.Data.Id, len(out.Data.ServiceLinks))
+}
+
+// EnvGroupEnvVarChanges renders which keys and secret files a set or unset
+// touched. Values are never shown.
+func EnvGroupEnvVarChanges(out *envgroup.EnvVarChangeOut) string {
+	changes := out.Meta.Changes
+	if changes.Empty() && len(out.Meta.SecretFiles) == 0 {
+		return FormatStringF("No changes to env group %s", out.Data.Name)
+	}
+
+	var header string
+	if out.Meta.Applied {
+		header = fmt.Sprintf("Updated env group %s:", out.Data.Name)
+	} else {
+		header = fmt.Sprintf("This command would update env group %s:", out.Data.Name)
+	}
+
+	lines := append([]string{header, ""}, envVarChangeLines(changes)...)
+	for _, name := range out.Meta.SecretFiles {
+		lines = append(lines, "  ~ secret file "+name)
+	}
+	if !out.Meta.Applied {
+		lines = append(lines, "", "Re-run with --confirm to proceed")
+	}
+	return FormatString(strings.Join(lines, "\n"))
+}
+
+func EnvGroupLink(out *envgroup.LinkOut) string {
+	switch {
+	case !out.Meta.Applied:
+		return FormatStringF("This command would unlink service %s from env group %s.\n\nRe-run with --confirm to proceed",
+			out.Meta.ServiceID, out.Data.Name)
+	case out.Meta.Linked:
+		return FormatStringF("Linked service %s to env group %s", out.Meta.ServiceID, out.Data.Name)
+	default:
+		return FormatStringF("Unlinked service %s from env group %s", out.Meta.ServiceID, out.Data.Name)
+	}
+}
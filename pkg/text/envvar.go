@@ -0,0 +1,81 @@
+package text
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jedib0t/go-pretty/table"
+
+	"github.com/render-oss/cli/pkg/client"
+	"github.com/render-oss/cli/pkg/service"
+)
+
+const maskedEnvVarValue = "********"
+
+// MaskEnvVarValue hides an environment variable value for display. Empty
+// values stay empty so that unset-looking variables remain recognizable.
+func MaskEnvVarValue(value string) string {
+	if value == "" {
+		return ""
+	}
+	return maskedEnvVarValue
+}
+
+func EnvVarTable(v []client.EnvVar, showValues bool) string {
+	if len(v) == 0 {
+		return FormatString("No environment variables set")
+	}
+	t := newTable()
+	t.AppendHeader(table.Row{"Key", "Value"})
+	for _, ev := range v {
+		t.AppendRow(table.Row{ev.Key, envVarDisplayValue(ev.Value, showValues)})
+	}
+	return FormatString(t.Render())
+}
+
+func EnvVarDetail(ev client.EnvVar, showValues bool) string {
+	return FormatStringF("%s=%s", ev.Key, envVarDisplayValue(ev.Value, showValues))
+}
+
+// EnvVarChanges renders which keys a set, unset, or import touched. Values are
+// never shown.
+func EnvVarChanges(out *service.EnvVarChangeOut) string {
+	changes := out.Meta.Changes
+	if changes.Empty() {
+		return FormatStringF("No changes to environment variables for service %s", out.Meta.ServiceID)
+	}
+
+	var header string
+	if out.Meta.Applied {
+		header = fmt.Sprintf("Updated environment variables for service %s:", out.Meta.ServiceID)
+	} else {
+		header = fmt.Sprintf("This command would update environment variables for service %s:", out.Meta.ServiceID)
+	}
+
+	lines := append([]string{header, ""}, envVarChangeLines(changes)...)
+	if !out.Meta.Applied {
+		lines = append(lines, "", "Re-run with --confirm to proceed")
+	}
+	return FormatString(strings.Join(lines, "\n"))
+}
+
+func envVarChangeLines(changes service.EnvVarChanges) []string {
+	var lines []string
+	for _, key := range changes.Added {
+		lines = append(lines, "  + "+key)
+	}
+	for _, key := range changes.Updated {
+		lines = append(lines, "  ~ "+key)
+	}
+	for _, key := range changes.Removed {
+		lines = append(lines, "  - "+key)
+	}
+	return lines
+}
+
+func envVarDisplayValue(value string, showValues bool) string {
+	if showValues {
+		return value
+	}
+	return MaskEnvVarValue(value)
+}
@@ -0,0 +1,50 @@
+package text
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/render-oss/cli/pkg/client"
+	"github.com/render-oss/cli/pkg/service"
+)
+
+func TestEnvVarTable(t *testing.T) {
+	vars := []client.EnvVar{{Key: "API_KEY", Value: "hunter2"}, {Key: "EMPTY", Value: ""}}
+
+	masked := EnvVarTable(vars, false)
+	assert.Contains(t, masked, "API_KEY")
+	assert.Contains(t, masked, maskedEnvVarValue)
+	assert.NotContains(t, masked, "hunter2")
+
+	assert.Contains(t, EnvVarTable(vars, true), "hunter2")
+	assert.Contains(t, EnvVarTable(nil, false), "No environment variables set")
+}
+
+func TestEnvVarChanges(t *testing.T) {
+	out := &service.EnvVarChangeOut{
+		Meta: service.EnvVarChangeMeta{
+			ServiceID: "srv-123",
+			Changes: service.EnvVarChanges{
+				Added:   []string{"NEW"},
+				Updated: []string{"CHANGED"},
+				Removed: []string{"GONE"},
+			},
+		},
+	}
+
+	preview := EnvVarChanges(out)
+	assert.Contains(t, preview, "would update")
+	assert.Contains(t, preview, "+ NEW")
+	assert.Contains(t, preview, "~ CHANGED")
+	assert.Contains(t, preview, "- GONE")
+	assert.Contains(t, preview, "--confirm")
+
+	out.Meta.Applied = true
+	applied := EnvVarChanges(out)
+	assert.Contains(t, applied, "Updated environment variables for service srv-123")
+	assert.NotContains(t, applied, "--confirm")
+
+	out.Meta.Changes = service.EnvVarChanges{}
+	assert.Contains(t, EnvVarChanges(out), "No changes")
+}
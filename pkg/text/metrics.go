@@ -0,0 +1,58 @@
+package text
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jedib0t/go-pretty/table"
+
+	"github.com/render-oss/cli/pkg/metrics"
+	"github.com/render-oss/cli/pkg/tui"
+)
+
+const metricsSparklineWidth = 40
+
+// Metrics renders a summary table per metric with one row per series and a
+// sparkline of its values over the requested range.
+func Metrics(out *metrics.Out) string {
+	sections := make([]string, 0, len(out.Data))
+	for _, result := range out.Data {
+		header := fmt.Sprintf("%s for %s", result.Metric.Title(), out.Meta.ResourceID)
+		if len(result.Series) == 0 {
+			sections = append(sections, header+"\n\nNo data in this time range")
+			continue
+		}
+
+		t := newTable()
+		t.AppendHeader(table.Row{"Series", "Latest", "Min", "Avg", "Max", "Trend"})
+		for _, ts := range result.Series {
+			values := metrics.SeriesValues(ts)
+			if len(values) == 0 {
+				t.AppendRow(table.Row{metrics.SeriesLabel(ts), "-", "-", "-", "-", ""})
+				continue
+			}
+			lo, avg, hi := metricsSummary(values)
+			t.AppendRow(table.Row{
+				metrics.SeriesLabel(ts),
+				metrics.FormatValue(values[len(values)-1], ts.Unit),
+				metrics.FormatValue(lo, ts.Unit),
+				metrics.FormatValue(avg, ts.Unit),
+				metrics.FormatValue(hi, ts.Unit),
+				tui.Sparkline(values, metricsSparklineWidth),
+			})
+		}
+		sections = append(sections, header+"\n\n"+t.Render())
+	}
+	return FormatString(strings.Join(sections, "\n\n"))
+}
+
+func metricsSummary(values []float64) (lo, avg, hi float64) {
+	lo, hi = values[0], values[0]
+	var sum float64
+	for _, v := range values {
+		lo = min(lo, v)
+		hi = max(hi, v)
+		sum += v
+	}
+	return lo, sum / float64(len(values)), hi
+}
@@ -0,0 +1,51 @@
+package text
+
+import (
+	"time"
+
+	"github.com/jedib0t/go-pretty/table"
+
+	"github.com/render-oss/cli/pkg/postgres"
+	"github.com/render-oss/cli/pkg/utils"
+)
+
+func PostgresExportTable(out *postgres.ExportListOut) string {
+	if len(out.Data) == 0 {
+		return FormatStringF("No exports found for Postgres database %s", out.Meta.PostgresName)
+	}
+	t := newTable()
+	t.AppendHeader(table.Row{"Created", "Status", "ID"})
+	for _, export := range out.Data {
+		status := "in progress"
+		if export.Url != nil {
+			status = "ready"
+		}
+		t.AppendRow(table.Row{
+			export.CreatedAt.Local().Format(time.DateTime),
+			status,
+			export.Id,
+		})
+	}
+	return FormatString(t.Render())
+}
+
+func PostgresExportCreate(out *postgres.ExportCreateOut) string {
+	return FormatStringF("Started an export of Postgres database %s. Run 'render pg export list %s' to check when it is ready, then download it with 'render pg export download %s'.",
+		out.Meta.PostgresName, out.Meta.PostgresID, out.Meta.PostgresID)
+}
+
+func PostgresExportDownload(out *postgres.ExportDownloadOut) string {
+	return FormatStringF("Downloaded export %s of %s to %s (%s)",
+		out.Data.ExportID, out.Meta.PostgresName, out.Data.LocalPath, utils.FormatBytes(out.Data.SizeBytes))
+}
+
+func PostgresRecover(out *postgres.RecoverOut) string {
+	restoreTime := out.Meta.RestoreTime.Local().Format(time.RFC3339)
+	if out.Meta.Recovered && out.Data != nil {
+		return FormatStringF("Recovering %s to %s as this new database:\n", out.Meta.Source.PostgresName, restoreTime) +
+			PostgresDetail(out.Data) + "\n"
+	}
+	return FormatStringF("This command would recover Postgres database %s (%s) to %s as a new database named %s.\n\n"+
+		"The recovery time is within the database's recovery window.\n\nRe-run with --confirm to proceed",
+		out.Meta.Source.PostgresName, out.Meta.Source.PostgresID, restoreTime, out.Meta.RestoreName)
+}
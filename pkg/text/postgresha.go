@@ -0,0 +1,64 @@
+package text
+
+import (
+	"fmt"
+
+	"github.com/render-oss/cli/pkg/pointers"
+	"github.com/render-oss/cli/pkg/postgres"
+)
+
+// PostgresHA reports a failover, promotion, or replication setup, followed
+// by the database's latest state.
+func PostgresHA(out *postgres.HAOut) string {
+	pg := out.Data
+	var summary string
+	switch {
+	case out.Meta.TimedOut:
+		summary = out.Meta.Message
+	case out.Meta.Waited && out.Meta.Healthy:
+		summary = haFinishedSummary(out)
+	default:
+		summary = haStartedSummary(out)
+		if out.Meta.Operation == postgres.HAReplicate && !replicationRestarts(out) {
+			summary += fmt.Sprintf("\nThe change takes effect the next time the database restarts. Run 'render restart %s' to restart it now.", pg.Id)
+		} else {
+			summary += fmt.Sprintf("\nRun 'render pg get %s' to check its status, or pass --wait to wait for it to finish.", pg.Id)
+		}
+	}
+	return FormatStringF("%s\n", summary) + PostgresDetail(&pg) + "\n"
+}
+
+func haStartedSummary(out *postgres.HAOut) string {
+	pg := out.Data
+	switch out.Meta.Operation {
+	case postgres.HAFailover:
+		return fmt.Sprintf("Started failover of Postgres database %s (%s) to its standby.", pg.Name, pg.Id)
+	case postgres.HAPromote:
+		return fmt.Sprintf("Started promoting read replica %s (%s) to a standalone primary.", pg.Name, pg.Id)
+	default:
+		return fmt.Sprintf("Set up logical replication of %s on Postgres database %s (%s).", replicationTarget(out), pg.Name, pg.Id)
+	}
+}
+
+func haFinishedSummary(out *postgres.HAOut) string {
+	pg := out.Data
+	switch out.Meta.Operation {
+	case postgres.HAFailover:
+		return fmt.Sprintf("Failed over Postgres database %s (%s); it is available.", pg.Name, pg.Id)
+	case postgres.HAPromote:
+		return fmt.Sprintf("Promoted %s (%s) to a standalone primary; it is available.", pg.Name, pg.Id)
+	default:
+		return fmt.Sprintf("Set up logical replication of %s on Postgres database %s (%s); it restarted and is available.", replicationTarget(out), pg.Name, pg.Id)
+	}
+}
+
+func replicationRestarts(out *postgres.HAOut) bool {
+	return out.Meta.Replication != nil && pointers.ValueOrDefault(out.Meta.Replication.RestartNow, false)
+}
+
+func replicationTarget(out *postgres.HAOut) string {
+	if out.Meta.Replication == nil {
+		return "the database"
+	}
+	return fmt.Sprintf("schema %s of database %s", out.Meta.Replication.SchemaName, out.Meta.Replication.DatabaseName)
+}
@@ -0,0 +1,79 @@
+package text
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jedib0t/go-pretty/table"
+
+	"github.com/render-oss/cli/pkg/pointers"
+	"github.com/render-oss/cli/pkg/postgres"
+	"github.com/render-oss/cli/pkg/utils"
+)
+
+const insightsQueryWidth = 80
+
+// PostgresInsights renders a section per kind of insight: top queries,
+// sequential table scans, active processes, and table sizes.
+func PostgresInsights(out *postgres.InsightsOut) string {
+	sections := []string{
+		insightsSection("Top queries", len(out.Data.TopQueries), func(t table.Writer) {
+			t.AppendHeader(table.Row{"Calls", "Total", "Mean", "Max", "Rows", "Query"})
+			for _, q := range out.Data.TopQueries {
+				t.AppendRow(table.Row{
+					pointers.ValueOrDefault(q.Calls, 0),
+					postgres.FormatMillis(q.TotalTimeMs),
+					postgres.FormatMillis(q.MeanTimeMs),
+					postgres.FormatMillis(q.MaxTimeMs),
+					pointers.ValueOrDefault(q.Rows, 0),
+					postgres.ShortQuery(q.Query, insightsQueryWidth),
+				})
+			}
+		}),
+		insightsSection("Sequential table scans", len(out.Data.TableScans), func(t table.Writer) {
+			t.AppendHeader(table.Row{"Database", "Table", "Scans"})
+			for _, s := range out.Data.TableScans {
+				t.AppendRow(table.Row{
+					pointers.StringValue(s.Database),
+					postgres.RelationName(s.Schema, s.Table),
+					pointers.ValueOrDefault(s.Scans, 0),
+				})
+			}
+		}),
+		insightsSection("Active processes", len(out.Data.Processes), func(t table.Writer) {
+			t.AppendHeader(table.Row{"PID", "User", "Database", "State", "Duration", "Wait Event", "Query"})
+			for _, p := range out.Data.Processes {
+				t.AppendRow(table.Row{
+					pointers.ValueOrDefault(p.Pid, 0),
+					pointers.StringValue(p.Username),
+					pointers.StringValue(p.DatabaseName),
+					pointers.StringValue(p.State),
+					postgres.FormatProcessDuration(p.Duration),
+					pointers.StringValue(p.WaitEvent),
+					postgres.ShortQuery(p.Query, insightsQueryWidth),
+				})
+			}
+		}),
+		insightsSection("Table sizes", len(out.Data.Sizes), func(t table.Writer) {
+			t.AppendHeader(table.Row{"Database", "Table", "Index", "Size"})
+			for _, s := range out.Data.Sizes {
+				t.AppendRow(table.Row{
+					pointers.StringValue(s.Database),
+					postgres.RelationName(s.Schema, s.Table),
+					pointers.StringValue(s.Index),
+					utils.FormatBytes(int64(pointers.ValueOrDefault(s.Bytes, 0))),
+				})
+			}
+		}),
+	}
+	return FormatString(fmt.Sprintf("Insights for Postgres database %s\n\n", out.Meta.PostgresName) + strings.Join(sections, "\n\n"))
+}
+
+func insightsSection(title string, rows int, fill func(table.Writer)) string {
+	if rows == 0 {
+		return title + "\n\nNo data"
+	}
+	t := newTable()
+	fill(t)
+	return title + "\n\n" + t.Render()
+}
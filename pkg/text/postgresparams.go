@@ -0,0 +1,86 @@
+package text
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/jedib0t/go-pretty/table"
+
+	"github.com/render-oss/cli/pkg/postgres"
+)
+
+func PostgresParamsTable(out *postgres.ParametersOut) string {
+	if len(out.Data) == 0 {
+		return FormatStringF("No parameter overrides set on Postgres database %s", out.Meta.PostgresName)
+	}
+	t := newTable()
+	t.AppendHeader(table.Row{"Name", "Value"})
+	for _, name := range slices.Sorted(maps.Keys(out.Data)) {
+		t.AppendRow(table.Row{name, out.Data[name]})
+	}
+	return FormatString(t.Render())
+}
+
+func PostgresAvailableParamsTable(out *postgres.AvailableParametersOut) string {
+	if len(out.Data) == 0 {
+		return FormatStringF("No parameters can be overridden on Postgres database %s", out.Meta.PostgresName)
+	}
+	t := newTable()
+	t.AppendHeader(table.Row{"Name", "Allowed Values", "Restart", "Description"})
+	for _, param := range out.Data {
+		restart := ""
+		if param.RequiresRestart {
+			restart = "required"
+		}
+		t.AppendRow(table.Row{param.Name, postgres.ParameterRange(param), restart, param.Description})
+	}
+	return FormatString(t.Render())
+}
+
+// PostgresParamsUpdate renders a parameter override change as one line per
+// parameter, with names padded so the arrows align:
+//
+//	"  work_mem:          4MB → 64MB"
+func PostgresParamsUpdate(out *postgres.ParametersUpdateOut) string {
+	if len(out.Diff) == 0 {
+		return FormatStringF("No changes to parameter overrides on Postgres database %s", out.Meta.PostgresName)
+	}
+
+	var header string
+	if out.Meta.Applied {
+		header = fmt.Sprintf("Updated parameter overrides on Postgres database %s:", out.Meta.PostgresName)
+	} else {
+		header = fmt.Sprintf("This command would update parameter overrides on Postgres database %s:", out.Meta.PostgresName)
+	}
+
+	names := slices.Sorted(maps.Keys(out.Diff))
+	width := 0
+	for _, name := range names {
+		width = max(width, len(name)+2)
+	}
+	lines := []string{header, ""}
+	for _, name := range names {
+		change := out.Diff[name]
+		lines = append(lines, fmt.Sprintf("  %-*s%s → %s", width, name+":", paramValueLabel(change.Before), paramValueLabel(change.After)))
+	}
+
+	switch {
+	case out.Meta.Applied && out.Meta.RequiresRestart:
+		lines = append(lines, "", fmt.Sprintf("Some changes take effect only after a restart. Run 'render restart %s' to apply them.", out.Meta.PostgresID))
+	case !out.Meta.Applied && out.Meta.RequiresRestart:
+		lines = append(lines, "", "Some of these parameters take effect only after the database restarts.")
+	}
+	if !out.Meta.Applied {
+		lines = append(lines, "", "Re-run with --confirm to proceed")
+	}
+	return FormatString(strings.Join(lines, "\n"))
+}
+
+func paramValueLabel(value *string) string {
+	if value == nil {
+		return "(default)"
+	}
+	return *value
+}
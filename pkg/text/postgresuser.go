@@ -0,0 +1,65 @@
+package text
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jedib0t/go-pretty/table"
+
+	"github.com/render-oss/cli/pkg/pointers"
+	"github.com/render-oss/cli/pkg/postgres"
+)
+
+func PostgresUsersTable(out *postgres.UsersListOut) string {
+	if len(out.Data) == 0 {
+		return FormatStringF("No users found for Postgres database %s", out.Meta.PostgresName)
+	}
+	t := newTable()
+	t.AppendHeader(table.Row{"Username", "Default", "Open Connections", "Created"})
+	for _, u := range out.Data {
+		isDefault := ""
+		if u.Default {
+			isDefault = "yes"
+		}
+		t.AppendRow(table.Row{
+			u.Username,
+			isDefault,
+			strconv.Itoa(u.OpenConnections),
+			postgresUserCreated(u.CreatedAt),
+		})
+	}
+	return FormatString(t.Render())
+}
+
+// PostgresUserCreate prints a new user's credentials. The API does not return
+// them again once another user becomes the default, so the output says so.
+func PostgresUserCreate(out *postgres.UserCreateOut) string {
+	c := out.Data
+	return strings.Join([]string{
+		fmt.Sprintf("Created user %s on Postgres database %s.", c.Username, out.Meta.PostgresName),
+		"It is now the database's default user.",
+		"",
+		fmt.Sprintf("Username: %s", c.Username),
+		fmt.Sprintf("Password: %s", c.Password),
+		fmt.Sprintf("Internal: %s", c.InternalConnectionString),
+		fmt.Sprintf("External: %s", c.ExternalConnectionString),
+		fmt.Sprintf("PSQL:     %s", c.PsqlCommand),
+		"",
+		"Save these credentials now. They are only shown while this user is the default.",
+		"Use --output json to pipe them to a secret manager.",
+	}, "\n") + "\n"
+}
+
+func PostgresUserDelete(out *postgres.UserDeleteOut) string {
+	return FormatStringF("Deleted user %s from Postgres database %s", out.Data.Username, out.Meta.PostgresName)
+}
+
+func postgresUserCreated(createdAt *string) string {
+	s := pointers.StringValue(createdAt)
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t.Local().Format(time.DateTime)
+	}
+	return s
+}
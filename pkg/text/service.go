@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/render-oss/cli/pkg/client"
 	"github.com/render-oss/cli/pkg/service"
 	rstrings "github.com/render-oss/cli/pkg/strings"
 )
@@ -34,6 +35,9 @@ func ServiceDetail(svc *service.ServiceOut) string {
 	if label := rstrings.ResourceLabel(svc.EnvironmentName, environmentID); label != "" {
 		lines = append(lines, fmt.Sprintf("Environment: %s", label))
 	}
+	if data.Suspended == client.ServiceSuspendedSuspended {
+		lines = append(lines, "Status: suspended")
+	}
 	if data.DashboardUrl != "" {
 		lines = append(lines, fmt.Sprintf("Dashboard: %s", data.DashboardUrl))
 	}
@@ -39,6 +39,10 @@ func TestServiceDetail(t *testing.T) {
 	assert.NotContains(t, detail, "Environment ID:")
 	assert.Contains(t, detail, "Dashboard: https://dashboard.render.com/web/srv-12345678901234567890")
 	assert.NotContains(t, detail, "Repo:")
+	assert.NotContains(t, detail, "Status:")
+
+	out.Suspended = client.ServiceSuspendedSuspended
+	assert.Contains(t, ServiceDetail(&out), "Status: suspended")
 }
 
 func TestServiceDetailNil(t *testing.T) {
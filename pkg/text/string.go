@@ -8,6 +8,7 @@ import (
 	wfclient "github.com/render-oss/cli/pkg/client/workflows"
 
 	"github.com/render-oss/cli/pkg/deploy"
+	"github.com/render-oss/cli/pkg/tasks"
 )
 
 func FormatString(s string) string {
@@ -37,6 +38,17 @@ func Deploy(serviceID string) func(dep *client.Deploy) string {
 	}
 }
 
+// DeployRollback describes a rollback, or the rollback that would happen
+// when it was only previewed.
+func DeployRollback(out *deploy.RollbackOut) string {
+	if out.Meta.RolledBack && out.Data.Deploy != nil {
+		return fmt.Sprintf("Rolling back service %s to deploy %s with a new deploy:\n\n%s",
+			out.Data.ServiceID, out.Data.Target.Id, DeployTable([]*client.Deploy{out.Data.Deploy}))
+	}
+	return fmt.Sprintf("This command would roll back service %s to this deploy:\n\n%s\nRe-run with --confirm to proceed\n",
+		out.Data.ServiceID, DeployTable([]*client.Deploy{out.Data.Target}))
+}
+
 func Version(workflowID string) func(wfv *wfclient.WorkflowVersion) string {
 	return func(wfv *wfclient.WorkflowVersion) string {
 		// TODO CAP-7490
@@ -46,7 +58,12 @@ func Version(workflowID string) func(wfv *wfclient.WorkflowVersion) string {
 	}
 }
 
-func TaskRunDetails(taskRun *wfclient.TaskRunDetails) string {
+func TaskRunDetails(taskRun *tasks.TaskRunDetails) string {
+	rerunOfStr := ""
+	if taskRun.RerunOfTaskRunId != nil {
+		rerunOfStr = fmt.Sprintf(",\nrerun of: %s", *taskRun.RerunOfTaskRunId)
+	}
+
 	inputStr := ""
 	inputJSON, err := json.Marshal(taskRun.Input)
 	if err == nil {
@@ -61,6 +78,6 @@ func TaskRunDetails(taskRun *wfclient.TaskRunDetails) string {
 	}
 
 	return FormatStringF(
-		"Task run details for %s: status %s, started at %s, completed at %s%s%s",
-		taskRun.Id, taskRun.Status, taskRun.StartedAt, taskRun.CompletedAt, inputStr, errorOrResults)
+		"Task run details for %s: status %s, started at %s, completed at %s%s%s%s",
+		taskRun.Id, taskRun.Status, taskRun.StartedAt, taskRun.CompletedAt, rerunOfStr, inputStr, errorOrResults)
 }
@@ -0,0 +1,41 @@
+package text
+
+import (
+	"fmt"
+	"time"
+
+	wfclient "github.com/render-oss/cli/pkg/client/workflows"
+	"github.com/render-oss/cli/pkg/taskrun"
+	"github.com/render-oss/cli/pkg/tasks"
+)
+
+// TaskRunTree renders a root task run and all of its subtask runs as a tree
+// with a timeline of their attempts.
+func TaskRunTree(root *tasks.RunNode) string {
+	return taskRunTree(root, time.Now(), func(status wfclient.TaskRunStatus) string {
+		return string(status)
+	})
+}
+
+// StyledTaskRunTree is TaskRunTree with statuses colored for the terminal.
+func StyledTaskRunTree(root *tasks.RunNode) string {
+	return taskRunTree(root, time.Now(), taskrun.StyledStatus)
+}
+
+func taskRunTree(root *tasks.RunNode, now time.Time, styleStatus func(wfclient.TaskRunStatus) string) string {
+	count := 0
+	root.Walk(func(*tasks.RunNode, int) { count++ })
+
+	summary := fmt.Sprintf("Task run tree for %s (%d runs", root.Run.Id, count)
+	if start, end := root.Span(now); !start.IsZero() {
+		summary += fmt.Sprintf(", %s", end.Sub(start).Round(time.Millisecond))
+	}
+	summary += ")"
+
+	t := newTable()
+	t.AppendHeader(toRow(taskrun.TreeHeader()))
+	for _, row := range taskrun.TreeRows(root, now, styleStatus) {
+		t.AppendRow(toRow(row))
+	}
+	return FormatStringF("%s\n\n%s", summary, t.Render())
+}
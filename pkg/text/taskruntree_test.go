@@ -0,0 +1,30 @@
+package text_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	wfclient "github.com/render-oss/cli/pkg/client/workflows"
+	"github.com/render-oss/cli/pkg/tasks"
+	"github.com/render-oss/cli/pkg/text"
+)
+
+func TestTaskRunTree(t *testing.T) {
+	started := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+	completed := started.Add(90 * time.Second)
+	root := &tasks.RunNode{
+		Run:      &wfclient.TaskRun{Id: "trn-root", Status: wfclient.Completed, StartedAt: &started, CompletedAt: &completed},
+		TaskName: "parent",
+		Children: []*tasks.RunNode{
+			{Run: &wfclient.TaskRun{Id: "trn-child", Status: wfclient.Failed, StartedAt: &started, CompletedAt: &completed}, TaskName: "child"},
+		},
+	}
+
+	out := text.TaskRunTree(root)
+	for _, want := range []string{"Task run tree for trn-root (2 runs, 1m30s)", "RUN", "TIMELINE", "trn-root", "└─ trn-child", "parent", "failed", "1m30s"} {
+		assert.True(t, strings.Contains(out, want), "expected %q in output:\n%s", want, out)
+	}
+}
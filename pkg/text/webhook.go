@@ -0,0 +1,130 @@
+package text
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/jedib0t/go-pretty/table"
+
+	webhooks "github.com/render-oss/cli/pkg/client/webhooks"
+	"github.com/render-oss/cli/pkg/webhook"
+)
+
+func WebhookTable(out *webhook.ListOut) string {
+	if len(out.Data) == 0 {
+		return FormatString("No webhooks found")
+	}
+	t := newTable()
+	t.AppendHeader(table.Row{"Name", "URL", "Events", "Enabled", "ID"})
+	for _, w := range out.Data {
+		t.AppendRow(table.Row{w.Name, w.Url, webhookEventFilter(w.EventFilter), w.Enabled, w.Id})
+	}
+	return FormatString(t.Render())
+}
+
+// WebhookDetail renders a webhook including its signing secret, which is
+// only shown after create and update.
+func WebhookDetail(w *webhooks.Webhook) string {
+	lines := []string{
+		fmt.Sprintf("Name: %s", w.Name),
+		fmt.Sprintf("ID: %s", w.Id),
+		fmt.Sprintf("URL: %s", w.Url),
+		fmt.Sprintf("Enabled: %t", w.Enabled),
+		fmt.Sprintf("Events: %s", webhookEventFilter(w.EventFilter)),
+		fmt.Sprintf("Signing secret: %s", w.Secret),
+	}
+	return strings.Join(lines, "\n") + "\n"
+}
+
+func WebhookChange(verb string) func(out *webhook.ChangeOut) string {
+	return func(out *webhook.ChangeOut) string {
+		return verb + " webhook:\n\n" + WebhookDetail(out.Data)
+	}
+}
+
+func WebhookDelete(out *webhook.DeleteOut) string {
+	summary := fmt.Sprintf("  %s (%s) → %s\n", out.Data.Name, out.Data.Id, out.Data.Url)
+	if out.Meta.Deleted {
+		return "Deleted this webhook:\n\n" + summary
+	}
+	return "This command would delete this webhook:\n\n" + summary + "\nRe-run with --confirm to proceed\n"
+}
+
+func WebhookEvents(out *webhook.EventsOut) string {
+	if len(out.Data) == 0 {
+		return FormatString(fmt.Sprintf("No deliveries found for webhook %s", out.Meta.WebhookName))
+	}
+	t := newTable()
+	t.AppendHeader(table.Row{"Sent", "Event", "Status", "Event ID"})
+	for _, e := range out.Data {
+		status := "-"
+		if e.StatusCode != nil {
+			status = fmt.Sprint(*e.StatusCode)
+		}
+		if e.Error != nil && *e.Error != "" {
+			status = "error: " + *e.Error
+		}
+		t.AppendRow(table.Row{e.SentAt.Local().Format(time.DateTime), e.EventType, status, e.EventId})
+	}
+	return FormatString(t.Render())
+}
+
+// WebhookDelivery renders one delivery received by `webhooks listen`.
+func WebhookDelivery(d webhook.Delivery) string {
+	title := fmt.Sprintf("%s %s %s", d.ReceivedAt.Local().Format(time.DateTime), d.Method, d.Path)
+	if d.EventType != "" {
+		title += "  " + d.EventType
+	}
+	lines := []string{"── " + title}
+
+	if d.Signature != webhook.SignatureUnverified {
+		lines = append(lines, "Signature: "+string(d.Signature))
+	}
+	if f := d.Forward; f != nil {
+		if f.Error != "" {
+			lines = append(lines, fmt.Sprintf("Forward to %s failed: %s", f.URL, f.Error))
+		} else {
+			lines = append(lines, fmt.Sprintf("Forwarded to %s → %d (%dms)", f.URL, f.StatusCode, f.DurationMs))
+		}
+	}
+
+	lines = append(lines, "Headers:")
+	keys := make([]string, 0, len(d.Headers))
+	for k := range d.Headers {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		lines = append(lines, fmt.Sprintf("  %s: %s", k, strings.Join(d.Headers[k], ", ")))
+	}
+
+	lines = append(lines, "Payload:")
+	switch {
+	case len(d.Payload) > 0:
+		var pretty bytes.Buffer
+		if err := json.Indent(&pretty, d.Payload, "  ", "  "); err != nil {
+			pretty.Write(d.Payload)
+		}
+		lines = append(lines, "  "+pretty.String())
+	case d.Body != "":
+		lines = append(lines, "  "+d.Body)
+	default:
+		lines = append(lines, "  (empty)")
+	}
+	return strings.Join(lines, "\n") + "\n\n"
+}
+
+func webhookEventFilter(filter webhooks.EventFilter) string {
+	if len(filter) == 0 {
+		return "all"
+	}
+	events := make([]string, 0, len(filter))
+	for _, e := range filter {
+		events = append(events, string(e))
+	}
+	return strings.Join(events, ", ")
+}
@@ -0,0 +1,39 @@
+package text
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/render-oss/cli/pkg/workflows/testharness"
+)
+
+// WorkflowTestReport lists each scenario as passed or failed, with the
+// reasons and recent logs of failed ones, followed by a summary.
+func WorkflowTestReport(report *testharness.Report) string {
+	var b strings.Builder
+	for _, result := range report.Results {
+		status := "PASS"
+		if !result.Passed {
+			status = "FAIL"
+		}
+		fmt.Fprintf(&b, "%s  %s (%s)\n", status, result.Name, result.Duration.Round(time.Millisecond))
+		for _, failure := range result.Failures {
+			fmt.Fprintf(&b, "      %s\n", failure)
+		}
+		if len(result.Logs) > 0 {
+			b.WriteString("      logs:\n")
+			for _, line := range result.Logs {
+				fmt.Fprintf(&b, "        %s\n", line)
+			}
+		}
+	}
+
+	plural := "s"
+	if len(report.Results) == 1 {
+		plural = ""
+	}
+	fmt.Fprintf(&b, "\n%d scenario%s: %d passed, %d failed (%s)\n",
+		len(report.Results), plural, report.Passed, report.Failed, report.Duration.Round(time.Millisecond))
+	return b.String()
+}
@@ -0,0 +1,32 @@
+package text_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/render-oss/cli/pkg/text"
+	"github.com/render-oss/cli/pkg/workflows/testharness"
+)
+
+func TestWorkflowTestReport(t *testing.T) {
+	out := text.WorkflowTestReport(&testharness.Report{
+		Results: []*testharness.Result{
+			{Name: "adds", Passed: true, Duration: 500 * time.Millisecond},
+			{Name: "fails", Failures: []string{"expected output 4, got [3]"}, Logs: []string{"computing"}, Duration: time.Second},
+		},
+		Passed:   1,
+		Failed:   1,
+		Duration: 1500 * time.Millisecond,
+	})
+
+	assert.Equal(t, `PASS  adds (500ms)
+FAIL  fails (1s)
+      expected output 4, got [3]
+      logs:
+        computing
+
+2 scenarios: 1 passed, 1 failed (1.5s)
+`, out)
+}
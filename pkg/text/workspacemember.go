@@ -0,0 +1,46 @@
+package text
+
+import (
+	"fmt"
+
+	"github.com/jedib0t/go-pretty/table"
+
+	"github.com/render-oss/cli/pkg/client"
+	"github.com/render-oss/cli/pkg/owner"
+	workspacetypes "github.com/render-oss/cli/pkg/types/workspace"
+)
+
+func WorkspaceMemberTable(out *owner.MembersOut) string {
+	if len(out.Data) == 0 {
+		return FormatString("No members found")
+	}
+	t := newTable()
+	t.AppendHeader(table.Row{"Name", "Email", "Role", "Status", "MFA", "User ID"})
+	for _, m := range out.Data {
+		t.AppendRow(table.Row{m.Name, m.Email, workspacetypes.RoleName(m.Role), m.Status, m.MfaEnabled, m.UserId})
+	}
+	return FormatString(t.Render())
+}
+
+func WorkspaceMemberRole(out *owner.MemberRoleOut) string {
+	if out.Meta.PreviousRole == out.Data.Role {
+		return fmt.Sprintf("%s already has role %s\n", out.Data.Email, workspacetypes.RoleName(out.Data.Role))
+	}
+	return fmt.Sprintf("Changed role of %s from %s to %s\n",
+		out.Data.Email, workspacetypes.RoleName(out.Meta.PreviousRole), workspacetypes.RoleName(out.Data.Role))
+}
+
+func WorkspaceMemberRemove(out *owner.MemberRemoveOut) string {
+	summary := fmt.Sprintf("  %s (%s)\n", workspaceMemberName(out.Data), workspacetypes.RoleName(out.Data.Role))
+	if out.Meta.Removed {
+		return "Removed this member from the workspace:\n\n" + summary
+	}
+	return "This command would remove this member from the workspace:\n\n" + summary + "\nRe-run with --confirm to proceed\n"
+}
+
+func workspaceMemberName(m *client.TeamMember) string {
+	if m.Name == "" {
+		return m.Email
+	}
+	return fmt.Sprintf("%s <%s>", m.Name, m.Email)
+}
@@ -0,0 +1,125 @@
+package tui
+
+import (
+	"math"
+	"strings"
+)
+
+// sparkLevels are the block characters used for sparklines and chart bars,
+// from lowest to highest. Each is one eighth taller than the previous.
+var sparkLevels = []rune("▁▂▃▄▅▆▇█")
+
+// Sparkline renders values as a single line of block characters at most width
+// runes wide. Values are averaged into buckets when there are more values than
+// columns.
+func Sparkline(values []float64, width int) string {
+	values = resample(values, width)
+	if len(values) == 0 {
+		return ""
+	}
+
+	lo, hi := valueRange(values)
+	var sb strings.Builder
+	for _, v := range values {
+		sb.WriteRune(sparkLevels[scaleTo(v, lo, hi, len(sparkLevels)-1)])
+	}
+	return sb.String()
+}
+
+// BarChart renders values as vertical bars height rows tall, with the y-axis
+// range labelled on the left using formatValue. The plot area is at most
+// width columns including the labels.
+func BarChart(values []float64, width, height int, formatValue func(float64) string) string {
+	if len(values) == 0 || height <= 0 {
+		return ""
+	}
+
+	lo, hi := valueRange(values)
+	lo = math.Min(lo, 0)
+	top, bottom := formatValue(hi), formatValue(lo)
+	labelWidth := max(len(top), len(bottom))
+
+	values = resample(values, width-labelWidth-1)
+	if len(values) == 0 {
+		return ""
+	}
+
+	// Each row holds eight levels, so a bar's height is measured in eighths.
+	eighths := make([]int, len(values))
+	for i, v := range values {
+		eighths[i] = scaleTo(v, lo, hi, height*8)
+	}
+
+	lines := make([]string, 0, height)
+	for row := height - 1; row >= 0; row-- {
+		var sb strings.Builder
+		switch row {
+		case height - 1:
+			sb.WriteString(padLeft(top, labelWidth))
+		case 0:
+			sb.WriteString(padLeft(bottom, labelWidth))
+		default:
+			sb.WriteString(strings.Repeat(" ", labelWidth))
+		}
+		sb.WriteString(verticalLine)
+
+		for _, e := range eighths {
+			filled := e - row*8
+			switch {
+			case filled >= 8:
+				sb.WriteRune(sparkLevels[len(sparkLevels)-1])
+			case filled > 0:
+				sb.WriteRune(sparkLevels[filled-1])
+			default:
+				sb.WriteByte(' ')
+			}
+		}
+		lines = append(lines, sb.String())
+	}
+	return strings.Join(lines, "\n")
+}
+
+func resample(values []float64, width int) []float64 {
+	if width <= 0 {
+		return nil
+	}
+	if len(values) <= width {
+		return values
+	}
+
+	out := make([]float64, width)
+	for i := range out {
+		start := i * len(values) / width
+		end := (i + 1) * len(values) / width
+		var sum float64
+		for _, v := range values[start:end] {
+			sum += v
+		}
+		out[i] = sum / float64(end-start)
+	}
+	return out
+}
+
+func valueRange(values []float64) (float64, float64) {
+	lo, hi := math.Inf(1), math.Inf(-1)
+	for _, v := range values {
+		lo = math.Min(lo, v)
+		hi = math.Max(hi, v)
+	}
+	return lo, hi
+}
+
+// scaleTo maps v from [lo, hi] onto [0, steps]. A flat series maps to 0.
+func scaleTo(v, lo, hi float64, steps int) int {
+	if hi <= lo {
+		return 0
+	}
+	return int(math.Round((v - lo) / (hi - lo) * float64(steps)))
+}
+
+func padLeft(s string, width int) string {
+	if len(s) >= width {
+		return s
+	}
+	return strings.Repeat(" ", width-len(s)) + s
+}
@@ -0,0 +1,36 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSparkline(t *testing.T) {
+	assert.Equal(t, "▁▅█", Sparkline([]float64{0, 5, 10}, 10))
+	assert.Equal(t, "▁▁▁", Sparkline([]float64{3, 3, 3}, 10), "flat series stays at the baseline")
+	assert.Equal(t, "", Sparkline(nil, 10))
+}
+
+func TestSparkline_ResamplesToWidth(t *testing.T) {
+	line := Sparkline([]float64{0, 0, 10, 10}, 2)
+	assert.Equal(t, "▁█", line)
+}
+
+func TestBarChart(t *testing.T) {
+	chart := BarChart([]float64{0, 1, 2}, 10, 2, func(f float64) string {
+		return strings.Repeat("x", int(f)+1)
+	})
+
+	lines := strings.Split(chart, "\n")
+	require.Len(t, lines, 2)
+	assert.Equal(t, "xxx│  █", lines[0])
+	assert.Equal(t, "  x│ ██", lines[1])
+}
+
+func TestBarChart_Empty(t *testing.T) {
+	assert.Equal(t, "", BarChart(nil, 10, 5, func(float64) string { return "" }))
+	assert.Equal(t, "", BarChart([]float64{1}, 10, 0, func(float64) string { return "" }))
+}
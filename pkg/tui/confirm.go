@@ -1,8 +1,13 @@
 package tui
 
 import (
+	"fmt"
+
+	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+
+	renderstyle "github.com/render-oss/cli/pkg/style"
 )
 
 var dialogBoxStyle = lipgloss.NewStyle().
@@ -29,9 +34,12 @@ var activeButtonStyle = buttonStyle.
 	MarginLeft(2).
 	Underline(true)
 
+// ShowConfirmMsg asks the user to confirm before running OnConfirm. When
+// ConfirmText is set, the user must type it exactly instead of answering y/n.
 type ShowConfirmMsg struct {
-	Message   string
-	OnConfirm func() tea.Cmd
+	Message     string
+	ConfirmText string
+	OnConfirm   func() tea.Cmd
 }
 
 type ConfirmModel struct {
@@ -42,6 +50,12 @@ type ConfirmModel struct {
 
 	message string
 
+	// confirmText is the text the user must type to confirm. It is empty for
+	// yes/no confirmations.
+	confirmText string
+	input       textinput.Model
+	mismatch    bool
+
 	width  int
 	height int
 }
@@ -61,7 +75,31 @@ func NewConfirmModel(
 	return m
 }
 
+// NewTypedConfirmModel returns a confirmation that only runs onConfirm once the
+// user has typed confirmText, such as the name of a resource about to be
+// destroyed.
+func NewTypedConfirmModel(
+	message string,
+	confirmText string,
+	onConfirm func() tea.Cmd,
+	onCancel func() tea.Cmd,
+) *ConfirmModel {
+	input := textinput.New()
+	input.Placeholder = confirmText
+	input.Prompt = "> "
+	input.Width = 40
+	input.Focus()
+
+	m := NewConfirmModel(message, onConfirm, onCancel)
+	m.confirmText = confirmText
+	m.input = input
+	return m
+}
+
 func (m *ConfirmModel) Init() tea.Cmd {
+	if m.confirmText != "" {
+		return textinput.Blink
+	}
 	return nil
 }
 
@@ -71,6 +109,9 @@ func (m *ConfirmModel) Update(msg tea.Msg) (*ConfirmModel, tea.Cmd) {
 		m.width = msg.Width
 		m.height = msg.Height
 	case tea.KeyMsg:
+		if m.confirmText != "" {
+			return m.updateTyped(msg)
+		}
 		switch msg.Type {
 		case tea.KeyRight:
 			m.selected = false
@@ -94,7 +135,26 @@ func (m *ConfirmModel) Update(msg tea.Msg) (*ConfirmModel, tea.Cmd) {
 	return m, nil
 }
 
+func (m *ConfirmModel) updateTyped(msg tea.KeyMsg) (*ConfirmModel, tea.Cmd) {
+	if msg.Type == tea.KeyEnter {
+		if m.input.Value() == m.confirmText {
+			return m, m.onConfirm()
+		}
+		m.mismatch = true
+		return m, nil
+	}
+
+	m.mismatch = false
+	var cmd tea.Cmd
+	m.input, cmd = m.input.Update(msg)
+	return m, cmd
+}
+
 func (m *ConfirmModel) View() string {
+	if m.confirmText != "" {
+		return m.place(m.typedView())
+	}
+
 	var okButton, cancelButton string
 	if m.selected {
 		okButton = activeButtonStyle.Render("Yes (y)")
@@ -108,12 +168,30 @@ func (m *ConfirmModel) View() string {
 	buttons := lipgloss.JoinHorizontal(lipgloss.Top, okButton, cancelButton)
 	ui := lipgloss.JoinVertical(lipgloss.Center, question, buttons)
 
-	dialog := lipgloss.Place(m.width, m.height,
+	return m.place(ui)
+}
+
+func (m *ConfirmModel) typedView() string {
+	question := lipgloss.NewStyle().Width(50).Align(lipgloss.Center).Render(m.message)
+	prompt := lipgloss.NewStyle().Width(50).Align(lipgloss.Center).MarginTop(1).
+		Render(fmt.Sprintf("Type %s to confirm", renderstyle.Bold(m.confirmText)))
+	input := lipgloss.NewStyle().Width(46).Margin(1, 2, 0).Render(m.input.View())
+
+	hint := "enter confirm · esc cancel"
+	if m.mismatch {
+		hint = "The text you entered does not match"
+	}
+	footer := lipgloss.NewStyle().Width(50).Align(lipgloss.Center).MarginTop(1).
+		Render(renderstyle.SubtleText.Render(hint))
+
+	return lipgloss.JoinVertical(lipgloss.Center, question, prompt, input, footer)
+}
+
+func (m *ConfirmModel) place(ui string) string {
+	return lipgloss.Place(m.width, m.height,
 		lipgloss.Center, lipgloss.Center,
 		dialogBoxStyle.Render(ui),
 	)
-
-	return dialog
 }
 
 type ModelWithConfirm struct {
@@ -136,15 +214,18 @@ func (m *ModelWithConfirm) Init() tea.Cmd {
 func (m *ModelWithConfirm) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
 	case ShowConfirmMsg:
-		m.confirm = NewConfirmModel(msg.Message,
-			func() tea.Cmd {
-				m.confirm = nil
-				return msg.OnConfirm()
-			},
-			func() tea.Cmd {
-				return func() tea.Msg { return DoneMsg{} }
-			},
-		)
+		onConfirm := func() tea.Cmd {
+			m.confirm = nil
+			return msg.OnConfirm()
+		}
+		onCancel := func() tea.Cmd {
+			return func() tea.Msg { return DoneMsg{} }
+		}
+		if msg.ConfirmText != "" {
+			m.confirm = NewTypedConfirmModel(msg.Message, msg.ConfirmText, onConfirm, onCancel)
+			return m, m.confirm.Init()
+		}
+		m.confirm = NewConfirmModel(msg.Message, onConfirm, onCancel)
 	}
 
 	var cmd tea.Cmd
@@ -0,0 +1,53 @@
+package tui_test
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/require"
+
+	"github.com/render-oss/cli/pkg/tui"
+)
+
+func TestTypedConfirmModel(t *testing.T) {
+	newModel := func() (*tui.ConfirmModel, *bool, *bool) {
+		var confirmed, canceled bool
+		m := tui.NewTypedConfirmModel("Delete disk data?", "data",
+			func() tea.Cmd { confirmed = true; return nil },
+			func() tea.Cmd { canceled = true; return nil },
+		)
+		return m, &confirmed, &canceled
+	}
+	typeText := func(m *tui.ConfirmModel, s string) {
+		for _, r := range s {
+			m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+		}
+	}
+
+	t.Run("confirms when the typed text matches", func(t *testing.T) {
+		m, confirmed, canceled := newModel()
+		typeText(m, "data")
+		m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+		require.True(t, *confirmed)
+		require.False(t, *canceled)
+	})
+
+	t.Run("does not confirm on mismatch", func(t *testing.T) {
+		m, confirmed, canceled := newModel()
+		typeText(m, "dat")
+		m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+		require.False(t, *confirmed)
+		require.False(t, *canceled)
+		require.Contains(t, m.View(), "does not match")
+	})
+
+	t.Run("y does not confirm", func(t *testing.T) {
+		m, confirmed, _ := newModel()
+		m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'y'}})
+		m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+		require.False(t, *confirmed)
+	})
+}
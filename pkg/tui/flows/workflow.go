@@ -13,6 +13,8 @@ import (
 	"github.com/render-oss/cli/pkg/pointers"
 	"github.com/render-oss/cli/pkg/resource"
 	"github.com/render-oss/cli/pkg/taskrun"
+	"github.com/render-oss/cli/pkg/tasks"
+	"github.com/render-oss/cli/pkg/text"
 	"github.com/render-oss/cli/pkg/tui"
 	"github.com/render-oss/cli/pkg/tui/views"
 	workflowviews "github.com/render-oss/cli/pkg/tui/views/workflows"
@@ -29,6 +31,7 @@ type WorkflowDeps interface {
 	ReleaseVersion() *cobra.Command
 	ListWorkflow() *cobra.Command
 	RunDetailsCmd() *cobra.Command
+	RunTreeCmd() *cobra.Command
 	LogFlowDeps
 }
 
@@ -361,13 +364,38 @@ func (f *Workflow) runListPalette(ctx context.Context, taskRun *workflows.TaskRu
 }
 
 func (f *Workflow) runDetails(ctx context.Context, input *workflowviews.TaskRunTargetInput) tea.Cmd {
-	return command.AddToStack(f.deps.Stack(), f.deps.RunDetailsCmd(), "Details", input, tui.NewDetailsModel[*workflows.TaskRunDetails](
+	return command.AddToStack(f.deps.Stack(), f.deps.RunDetailsCmd(), "Details", input, tui.NewDetailsModel[*tasks.TaskRunDetails](
 		"Task Run Details",
 		command.LoadCmd(ctx, f.deps.WorkflowLoader().LoadTaskRunDetails, input),
 		taskrun.TaskRunDetailsFormat,
 	))
 }
 
+func (f *Workflow) runTree(ctx context.Context, input *workflowviews.TaskRunTargetInput) tea.Cmd {
+	return command.AddToStack(f.deps.Stack(), f.deps.RunTreeCmd(), "Tree", input, tui.NewSimpleModel(
+		command.LoadCmd(ctx, func(ctx context.Context, input *workflowviews.TaskRunTargetInput) (string, error) {
+			root, err := f.deps.WorkflowLoader().LoadTaskRunTree(ctx, input)
+			if err != nil {
+				return "", err
+			}
+			return text.StyledTaskRunTree(root), nil
+		}, input),
+	))
+}
+
+func (f *Workflow) RunTreeFlow(ctx context.Context, input *workflowviews.TaskRunTargetInput) tea.Cmd {
+	if input.TaskRunID == "" {
+		return f.unspecifiedTask(ctx, func(t *workflows.Task) tea.Cmd {
+			return f.runList(ctx, &workflowviews.TaskRunListInput{TaskSlug: t.Id}, func(tr *workflows.TaskRun) tea.Cmd {
+				input.TaskRunID = tr.Id
+				return f.runTree(ctx, input)
+			})
+		})
+	}
+
+	return f.runTree(ctx, input)
+}
+
 func (f *Workflow) RunDetailsFlow(ctx context.Context, input *workflowviews.TaskRunTargetInput) tea.Cmd {
 	if input.TaskRunID == "" {
 		return f.unspecifiedTask(ctx, func(t *workflows.Task) tea.Cmd {
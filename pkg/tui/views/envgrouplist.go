@@ -0,0 +1,164 @@
+package views
+
+import (
+	"context"
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	btable "github.com/evertras/bubble-table/table"
+
+	"github.com/render-oss/cli/pkg/client"
+	"github.com/render-oss/cli/pkg/command"
+	"github.com/render-oss/cli/pkg/envgroup"
+	"github.com/render-oss/cli/pkg/environment"
+	"github.com/render-oss/cli/pkg/owner"
+	"github.com/render-oss/cli/pkg/pointers"
+	"github.com/render-oss/cli/pkg/project"
+	"github.com/render-oss/cli/pkg/resolve"
+	"github.com/render-oss/cli/pkg/service"
+	"github.com/render-oss/cli/pkg/tui"
+)
+
+type EnvGroupList struct {
+	table *tui.Table[*client.EnvGroupMeta]
+}
+
+type EnvGroupInput struct {
+	ProjectIDOrName     *string `cli:"project"`
+	EnvironmentIDOrName *string `cli:"environment"`
+}
+
+func NewEnvGroupList(ctx context.Context, in EnvGroupInput, selectFunc OnSelectFuncT[*client.EnvGroupMeta], opts ...tui.TableOption[*client.EnvGroupMeta]) *EnvGroupList {
+	columns := []btable.Column{
+		btable.NewFlexColumn("Name", "Name", 3).WithFiltered(true),
+		btable.NewFlexColumn("Environment", "Environment", 2).WithFiltered(true),
+		btable.NewColumn("Services", "Linked Services", 16),
+		btable.NewColumn("ID", "ID", 25).WithFiltered(true),
+	}
+
+	createRowFunc := func(g *client.EnvGroupMeta) btable.Row {
+		return btable.NewRow(btable.RowData{
+			"ID":          g.Id,
+			"Name":        g.Name,
+			"Environment": pointers.StringValue(g.EnvironmentId),
+			"Services":    fmt.Sprintf("%d", len(g.ServiceLinks)),
+			"envGroup":    g, // this will be hidden in the UI, but will be used to get the env group when selected
+		})
+	}
+
+	onSelect := func(rows []btable.Row) tea.Cmd {
+		if len(rows) == 0 {
+			return nil
+		}
+
+		g, ok := rows[0].Data["envGroup"].(*client.EnvGroupMeta)
+		if !ok {
+			return nil
+		}
+
+		return selectFunc(ctx, g)
+	}
+
+	t := tui.NewTable(
+		columns,
+		command.LoadCmd(ctx, listEnvGroups, in),
+		createRowFunc,
+		onSelect,
+		opts...,
+	)
+
+	return &EnvGroupList{
+		table: t,
+	}
+}
+
+func listEnvGroups(ctx context.Context, in EnvGroupInput) ([]*client.EnvGroupMeta, error) {
+	c, err := client.NewDefaultClient()
+	if err != nil {
+		return nil, err
+	}
+
+	environmentRepo := environment.NewRepo(c)
+	ownerRepo := owner.NewRepo(c)
+	projectRepo := project.NewRepo(c)
+	resolver := resolve.New(ownerRepo, projectRepo, environmentRepo)
+
+	envGroupService := envgroup.NewService(envgroup.NewRepo(c), service.NewRepo(c), resolver)
+
+	return envGroupService.List(ctx, envgroup.ListInput{
+		ProjectIDOrName:     in.ProjectIDOrName,
+		EnvironmentIDOrName: in.EnvironmentIDOrName,
+	})
+}
+
+func (l *EnvGroupList) Init() tea.Cmd {
+	return l.table.Init()
+}
+
+func (l *EnvGroupList) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	return l.table.Update(msg)
+}
+
+func (l *EnvGroupList) View() string {
+	return l.table.View()
+}
+
+// EnvGroupEnvVarList shows the keys set on a single env group. Values are not
+// displayed; use `render env-groups get --show-values` to reveal them.
+type EnvGroupEnvVarList struct {
+	table *tui.Table[client.EnvVar]
+}
+
+type EnvGroupEnvVarInput struct {
+	EnvGroupID string `cli:"arg:0"`
+}
+
+func NewEnvGroupEnvVarList(ctx context.Context, in EnvGroupEnvVarInput, opts ...tui.TableOption[client.EnvVar]) *EnvGroupEnvVarList {
+	columns := []btable.Column{
+		btable.NewFlexColumn("Key", "Key", 1).WithFiltered(true),
+	}
+
+	createRowFunc := func(ev client.EnvVar) btable.Row {
+		return btable.NewRow(btable.RowData{
+			"Key": ev.Key,
+		})
+	}
+
+	t := tui.NewTable(
+		columns,
+		command.LoadCmd(ctx, loadEnvGroupEnvVars, in),
+		createRowFunc,
+		func([]btable.Row) tea.Cmd { return nil },
+		opts...,
+	)
+
+	return &EnvGroupEnvVarList{
+		table: t,
+	}
+}
+
+func loadEnvGroupEnvVars(ctx context.Context, in EnvGroupEnvVarInput) ([]client.EnvVar, error) {
+	c, err := client.NewDefaultClient()
+	if err != nil {
+		return nil, err
+	}
+
+	g, err := envgroup.NewRepo(c).GetEnvGroup(ctx, in.EnvGroupID)
+	if err != nil {
+		return nil, err
+	}
+	service.SortEnvVars(g.EnvVars)
+	return g.EnvVars, nil
+}
+
+func (l *EnvGroupEnvVarList) Init() tea.Cmd {
+	return l.table.Init()
+}
+
+func (l *EnvGroupEnvVarList) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	return l.table.Update(msg)
+}
+
+func (l *EnvGroupEnvVarList) View() string {
+	return l.table.View()
+}
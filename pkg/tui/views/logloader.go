@@ -55,6 +55,20 @@ func (l *LogLoader) LoadLogData(ctx context.Context, in LogInput) (*tui.LogResul
 }
 
 func (l *LogLoader) getResourceIDsFromIDOrNames(ctx context.Context, idOrNames []string) ([]string, error) {
+	return resolveResourceIDs(ctx, l.serviceRepo, l.kvRepo, l.postgresRepo, l.workflowRepo, idOrNames)
+}
+
+// resolveResourceIDs maps each resource ID or name to an ID. Names are matched
+// against services, Key Value instances, Postgres databases, and workflows, in
+// that order.
+func resolveResourceIDs(
+	ctx context.Context,
+	serviceRepo *service.Repo,
+	kvRepo *keyvalue.Repo,
+	postgresRepo *postgres.Repo,
+	workflowRepo *workflow.Repo,
+	idOrNames []string,
+) ([]string, error) {
 	resourceIds := make([]string, len(idOrNames))
 
 	for i, idOrName := range idOrNames {
@@ -69,7 +83,7 @@ func (l *LogLoader) getResourceIDsFromIDOrNames(ctx context.Context, idOrNames [
 
 		// We have a name, not an ID. See if we can find a match
 
-		services, err := l.serviceRepo.ListServices(ctx, &client.ListServicesParams{
+		services, err := serviceRepo.ListServices(ctx, &client.ListServicesParams{
 			Name: &client.NameParam{idOrName},
 		})
 		if err != nil {
@@ -81,7 +95,7 @@ func (l *LogLoader) getResourceIDsFromIDOrNames(ctx context.Context, idOrNames [
 			continue
 		}
 
-		kvs, err := l.kvRepo.ListKeyValue(ctx, &client.ListKeyValueParams{
+		kvs, err := kvRepo.ListKeyValue(ctx, &client.ListKeyValueParams{
 			Name: &client.NameParam{idOrName},
 		})
 		if err != nil {
@@ -93,7 +107,7 @@ func (l *LogLoader) getResourceIDsFromIDOrNames(ctx context.Context, idOrNames [
 			continue
 		}
 
-		postgreses, err := l.postgresRepo.ListPostgres(ctx, &client.ListPostgresParams{
+		postgreses, err := postgresRepo.ListPostgres(ctx, &client.ListPostgresParams{
 			Name: &client.NameParam{idOrName},
 		})
 		if err != nil {
@@ -105,7 +119,7 @@ func (l *LogLoader) getResourceIDsFromIDOrNames(ctx context.Context, idOrNames [
 			continue
 		}
 
-		workflows, err := l.workflowRepo.ListWorkflows(ctx, &client.ListWorkflowsParams{
+		workflows, err := workflowRepo.ListWorkflows(ctx, &client.ListWorkflowsParams{
 			Name: &client.NameParam{idOrName},
 		})
 		if err != nil {
@@ -0,0 +1,227 @@
+package views
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	metricsclient "github.com/render-oss/cli/pkg/client/metrics"
+	"github.com/render-oss/cli/pkg/command"
+	"github.com/render-oss/cli/pkg/config"
+	"github.com/render-oss/cli/pkg/keyvalue"
+	"github.com/render-oss/cli/pkg/metrics"
+	"github.com/render-oss/cli/pkg/postgres"
+	"github.com/render-oss/cli/pkg/service"
+	renderstyle "github.com/render-oss/cli/pkg/style"
+	"github.com/render-oss/cli/pkg/tui"
+	"github.com/render-oss/cli/pkg/workflow"
+)
+
+type MetricsInput struct {
+	ResourceIDOrName string                  `cli:"arg:0"`
+	Metrics          []string                `cli:"metric"`
+	StartTime        *command.TimeOrRelative `cli:"start"`
+	EndTime          *command.TimeOrRelative `cli:"end"`
+	Resolution       int                     `cli:"resolution"`
+}
+
+func (in MetricsInput) Validate(_ bool) error {
+	if in.Resolution < 0 {
+		return fmt.Errorf("--resolution must not be negative")
+	}
+	if in.StartTime != nil && in.EndTime != nil && in.StartTime.T.After(*in.EndTime.T) {
+		return fmt.Errorf("--start must be before --end")
+	}
+	_, err := metrics.ParseMetrics(in.Metrics)
+	return err
+}
+
+type MetricsLoader struct {
+	metricsRepo  *metrics.Repo
+	serviceRepo  *service.Repo
+	kvRepo       *keyvalue.Repo
+	postgresRepo *postgres.Repo
+	workflowRepo *workflow.Repo
+}
+
+func NewMetricsLoader(metricsRepo *metrics.Repo, serviceRepo *service.Repo, kvRepo *keyvalue.Repo, postgresRepo *postgres.Repo, workflowRepo *workflow.Repo) *MetricsLoader {
+	return &MetricsLoader{metricsRepo: metricsRepo, serviceRepo: serviceRepo, kvRepo: kvRepo, postgresRepo: postgresRepo, workflowRepo: workflowRepo}
+}
+
+// LoadMetrics resolves the resource and fetches every requested metric for it.
+func (l *MetricsLoader) LoadMetrics(ctx context.Context, in MetricsInput) (*metrics.Out, error) {
+	if _, err := config.WorkspaceID(); err != nil {
+		return nil, err
+	}
+
+	requested, err := metrics.ParseMetrics(in.Metrics)
+	if err != nil {
+		return nil, err
+	}
+
+	ids, err := resolveResourceIDs(ctx, l.serviceRepo, l.kvRepo, l.postgresRepo, l.workflowRepo, []string{in.ResourceIDOrName})
+	if err != nil {
+		return nil, err
+	}
+	resourceID := ids[0]
+
+	kind, err := metrics.KindForResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	if err := metrics.CheckSupported(kind, requested); err != nil {
+		return nil, err
+	}
+
+	params := metrics.QueryParams{ResourceID: resourceID, ResolutionSeconds: in.Resolution}
+	if in.StartTime != nil {
+		params.StartTime = in.StartTime.T
+	}
+	if in.EndTime != nil {
+		params.EndTime = in.EndTime.T
+	}
+
+	out := &metrics.Out{
+		Data: make([]metrics.Result, 0, len(requested)),
+		Meta: metrics.OutMeta{
+			ResourceID:        resourceID,
+			StartTime:         params.StartTime,
+			EndTime:           params.EndTime,
+			ResolutionSeconds: in.Resolution,
+		},
+	}
+	for _, m := range requested {
+		series, err := l.metricsRepo.Query(ctx, m, params)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load %s metrics: %w", m, err)
+		}
+		out.Data = append(out.Data, metrics.Result{Metric: m, Series: series})
+	}
+	return out, nil
+}
+
+const (
+	minMetricsChartHeight = 3
+	maxMetricsChartHeight = 12
+)
+
+// MetricsView shows one chart per instance for the selected metric. Use
+// shift+left/right to switch metrics and r to reload.
+type MetricsView struct {
+	load   tui.TypedCmd[*metrics.Out]
+	data   *metrics.Out
+	active int
+	width  int
+	height int
+}
+
+func NewMetricsView(ctx context.Context, loadMetrics func(context.Context, MetricsInput) (*metrics.Out, error), input MetricsInput) *MetricsView {
+	return &MetricsView{
+		load: command.LoadCmd(ctx, loadMetrics, input),
+	}
+}
+
+func (v *MetricsView) Init() tea.Cmd {
+	return v.load.Unwrap()
+}
+
+func (v *MetricsView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tui.LoadDataMsg[*metrics.Out]:
+		v.data = msg.Data
+		if v.data != nil && v.active >= len(v.data.Data) {
+			v.active = 0
+		}
+	case tui.StackSizeMsg:
+		v.width = msg.Width
+		v.height = msg.Height
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "shift+right", "tab":
+			if v.data != nil && len(v.data.Data) > 0 {
+				v.active = (v.active + 1) % len(v.data.Data)
+			}
+		case "shift+left", "shift+tab":
+			if v.data != nil && len(v.data.Data) > 0 {
+				v.active = (v.active + len(v.data.Data) - 1) % len(v.data.Data)
+			}
+		case "r":
+			return v, v.load.Unwrap()
+		}
+	}
+	return v, nil
+}
+
+func (v *MetricsView) View() string {
+	if v.data == nil {
+		return ""
+	}
+	if len(v.data.Data) == 0 {
+		return "No metrics requested"
+	}
+
+	result := v.data.Data[v.active]
+	sections := []string{v.header(), ""}
+
+	if len(result.Series) == 0 {
+		sections = append(sections, renderstyle.SubtleText.Render("No data in this time range"))
+	} else {
+		chartHeight := v.chartHeight(len(result.Series))
+		for _, ts := range result.Series {
+			sections = append(sections, metricsSeriesChart(ts, v.width, chartHeight), "")
+		}
+	}
+
+	sections = append(sections, renderstyle.SubtleText.Render("shift+←/→ switch metric · r reload · esc back"))
+	return lipgloss.NewStyle().MaxWidth(v.width).Render(strings.Join(sections, "\n"))
+}
+
+func (v *MetricsView) header() string {
+	var tabs []string
+	for i, r := range v.data.Data {
+		if i == v.active {
+			tabs = append(tabs, renderstyle.TitleBlock.Render(r.Metric.Title()))
+		} else {
+			tabs = append(tabs, renderstyle.SubtleText.Padding(0, 1).Render(r.Metric.Title()))
+		}
+	}
+	return strings.Join(tabs, " ")
+}
+
+// chartHeight splits the space left after the header and footer between the
+// charts, staying within readable bounds.
+func (v *MetricsView) chartHeight(series int) int {
+	// header, blank line, and footer, plus a title and blank line per chart
+	available := v.height - 3 - 2*series
+	return min(max(available/series, minMetricsChartHeight), maxMetricsChartHeight)
+}
+
+func metricsSeriesChart(ts metricsclient.TimeSeries, width, height int) string {
+	values := metrics.SeriesValues(ts)
+	title := renderstyle.Label.Render(metrics.SeriesLabel(ts))
+	if len(values) > 0 {
+		title += renderstyle.SubtleText.Render(fmt.Sprintf("  latest %s", metrics.FormatValue(values[len(values)-1], ts.Unit)))
+	}
+	if last := latestTimestamp(ts); !last.IsZero() {
+		title += renderstyle.SubtleText.Render(fmt.Sprintf(" at %s", last.Local().Format(time.DateTime)))
+	}
+
+	chart := tui.BarChart(values, width, height, func(f float64) string {
+		return metrics.FormatValue(f, ts.Unit)
+	})
+	return title + "\n" + chart
+}
+
+func latestTimestamp(ts metricsclient.TimeSeries) time.Time {
+	var latest time.Time
+	for _, p := range ts.Values {
+		if p.Timestamp.After(latest) {
+			latest = p.Timestamp
+		}
+	}
+	return latest
+}
@@ -0,0 +1,180 @@
+package views
+
+import (
+	"context"
+	"strconv"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	btable "github.com/evertras/bubble-table/table"
+
+	pgclient "github.com/render-oss/cli/pkg/client/postgres"
+	"github.com/render-oss/cli/pkg/command"
+	"github.com/render-oss/cli/pkg/pointers"
+	"github.com/render-oss/cli/pkg/postgres"
+	renderstyle "github.com/render-oss/cli/pkg/style"
+	"github.com/render-oss/cli/pkg/tui"
+	pgtypes "github.com/render-oss/cli/pkg/types/postgres"
+	"github.com/render-oss/cli/pkg/utils"
+)
+
+const insightsQueryWidth = 200
+
+// PostgresInsightsView shows a database's insights with a tab per kind of
+// insight. Use shift+left/right to switch tabs and r to reload.
+type PostgresInsightsView struct {
+	load tui.TypedCmd[*postgres.InsightsOut]
+	tabs *tui.TabModel
+
+	topQueries *insightsTab[pgclient.PostgresQueryStatistic]
+	tableScans *insightsTab[pgclient.PostgresTableScan]
+	processes  *insightsTab[pgclient.PostgresProcess]
+	sizes      *insightsTab[pgclient.PostgresSize]
+}
+
+func NewPostgresInsightsView(ctx context.Context, loadInsights func(context.Context, pgtypes.InsightsInput) (*postgres.InsightsOut, error), input pgtypes.InsightsInput) *PostgresInsightsView {
+	v := &PostgresInsightsView{
+		load: command.LoadCmd(ctx, loadInsights, input),
+		topQueries: newInsightsTab([]btable.Column{
+			btable.NewColumn("Calls", "Calls", 10),
+			btable.NewColumn("Total", "Total", 12),
+			btable.NewColumn("Mean", "Mean", 12),
+			btable.NewColumn("Max", "Max", 12),
+			btable.NewColumn("Rows", "Rows", 10),
+			btable.NewFlexColumn("Query", "Query", 1).WithFiltered(true),
+		}, func(q pgclient.PostgresQueryStatistic) btable.Row {
+			return btable.NewRow(btable.RowData{
+				"Calls": strconv.Itoa(pointers.ValueOrDefault(q.Calls, 0)),
+				"Total": postgres.FormatMillis(q.TotalTimeMs),
+				"Mean":  postgres.FormatMillis(q.MeanTimeMs),
+				"Max":   postgres.FormatMillis(q.MaxTimeMs),
+				"Rows":  strconv.Itoa(pointers.ValueOrDefault(q.Rows, 0)),
+				"Query": postgres.ShortQuery(q.Query, insightsQueryWidth),
+			})
+		}),
+		tableScans: newInsightsTab([]btable.Column{
+			btable.NewFlexColumn("Database", "Database", 1).WithFiltered(true),
+			btable.NewFlexColumn("Table", "Table", 2).WithFiltered(true),
+			btable.NewColumn("Scans", "Scans", 12),
+		}, func(s pgclient.PostgresTableScan) btable.Row {
+			return btable.NewRow(btable.RowData{
+				"Database": pointers.StringValue(s.Database),
+				"Table":    postgres.RelationName(s.Schema, s.Table),
+				"Scans":    strconv.Itoa(pointers.ValueOrDefault(s.Scans, 0)),
+			})
+		}),
+		processes: newInsightsTab([]btable.Column{
+			btable.NewColumn("PID", "PID", 8),
+			btable.NewColumn("User", "User", 14).WithFiltered(true),
+			btable.NewColumn("Database", "Database", 14).WithFiltered(true),
+			btable.NewColumn("State", "State", 20).WithFiltered(true),
+			btable.NewColumn("Duration", "Duration", 12),
+			btable.NewFlexColumn("Query", "Query", 1).WithFiltered(true),
+		}, func(p pgclient.PostgresProcess) btable.Row {
+			return btable.NewRow(btable.RowData{
+				"PID":      strconv.Itoa(pointers.ValueOrDefault(p.Pid, 0)),
+				"User":     pointers.StringValue(p.Username),
+				"Database": pointers.StringValue(p.DatabaseName),
+				"State":    pointers.StringValue(p.State),
+				"Duration": postgres.FormatProcessDuration(p.Duration),
+				"Query":    postgres.ShortQuery(p.Query, insightsQueryWidth),
+			})
+		}),
+		sizes: newInsightsTab([]btable.Column{
+			btable.NewFlexColumn("Database", "Database", 1).WithFiltered(true),
+			btable.NewFlexColumn("Table", "Table", 2).WithFiltered(true),
+			btable.NewFlexColumn("Index", "Index", 2).WithFiltered(true),
+			btable.NewColumn("Size", "Size", 12),
+		}, func(s pgclient.PostgresSize) btable.Row {
+			return btable.NewRow(btable.RowData{
+				"Database": pointers.StringValue(s.Database),
+				"Table":    postgres.RelationName(s.Schema, s.Table),
+				"Index":    pointers.StringValue(s.Index),
+				"Size":     utils.FormatBytes(int64(pointers.ValueOrDefault(s.Bytes, 0))),
+			})
+		}),
+	}
+	v.tabs = tui.NewTabModel([]*tui.Tab{
+		{Name: "Top queries", Content: v.topQueries},
+		{Name: "Table scans", Content: v.tableScans},
+		{Name: "Processes", Content: v.processes},
+		{Name: "Sizes", Content: v.sizes},
+	})
+	return v
+}
+
+func (v *PostgresInsightsView) Init() tea.Cmd {
+	return tea.Batch(v.load.Unwrap(), v.tabs.Init())
+}
+
+func (v *PostgresInsightsView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tui.LoadDataMsg[*postgres.InsightsOut]:
+		if msg.Data != nil {
+			v.topQueries.setData(msg.Data.Data.TopQueries)
+			v.tableScans.setData(msg.Data.Data.TableScans)
+			v.processes.setData(msg.Data.Data.Processes)
+			v.sizes.setData(msg.Data.Data.Sizes)
+		}
+		return v, nil
+	case tui.StackSizeMsg:
+		v.tabs.SetWidth(msg.Width)
+		v.tabs.SetHeight(msg.Height - lipgloss.Height(v.footer()))
+		return v, nil
+	case tea.KeyMsg:
+		if msg.String() == "r" && !v.filtering() {
+			return v, v.load.Unwrap()
+		}
+	}
+
+	_, cmd := v.tabs.Update(msg)
+	return v, cmd
+}
+
+func (v *PostgresInsightsView) View() string {
+	return lipgloss.JoinVertical(lipgloss.Left, v.tabs.View(), v.footer())
+}
+
+func (v *PostgresInsightsView) footer() string {
+	return renderstyle.SubtleText.Render("shift+←/→ switch tab · / search · r reload · esc back")
+}
+
+// filtering reports whether the active tab's search input has focus, in which
+// case keys belong to the search.
+func (v *PostgresInsightsView) filtering() bool {
+	tab, ok := v.tabs.CurrentTab().Content.(interface{ filtering() bool })
+	return ok && tab.filtering()
+}
+
+// insightsTab is a table of insights that the tab model can size.
+type insightsTab[T any] struct {
+	*tui.Table[T]
+	width  int
+	height int
+}
+
+func newInsightsTab[T any](columns []btable.Column, createRow func(T) btable.Row) *insightsTab[T] {
+	// Rows aren't selectable and data arrives with the view's single load, so
+	// the table has neither a select handler nor a load command of its own.
+	return &insightsTab[T]{
+		Table: tui.NewTable(columns, nil, createRow, func([]btable.Row) tea.Cmd { return nil }),
+	}
+}
+
+func (t *insightsTab[T]) setData(data []T) {
+	t.Table.Update(tui.LoadDataMsg[[]T]{Data: data})
+}
+
+func (t *insightsTab[T]) SetWidth(width int) {
+	t.width = width
+	t.Table.Update(tui.StackSizeMsg{Width: t.width, Height: t.height})
+}
+
+func (t *insightsTab[T]) SetHeight(height int) {
+	t.height = height
+	t.Table.Update(tui.StackSizeMsg{Width: t.width, Height: t.height})
+}
+
+func (t *insightsTab[T]) filtering() bool {
+	return t.Table.Model.GetIsFilterInputFocused()
+}
@@ -0,0 +1,47 @@
+package views_test
+
+import (
+	"context"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pgclient "github.com/render-oss/cli/pkg/client/postgres"
+	"github.com/render-oss/cli/pkg/pointers"
+	"github.com/render-oss/cli/pkg/postgres"
+	"github.com/render-oss/cli/pkg/tui"
+	"github.com/render-oss/cli/pkg/tui/views"
+	pgtypes "github.com/render-oss/cli/pkg/types/postgres"
+)
+
+func TestPostgresInsightsView(t *testing.T) {
+	out := &postgres.InsightsOut{
+		Data: postgres.Insights{
+			TopQueries: []pgclient.PostgresQueryStatistic{{Query: pointers.From("SELECT * FROM orders"), Calls: pointers.From(3)}},
+			TableScans: []pgclient.PostgresTableScan{{Schema: pointers.From("public"), Table: pointers.From("customers"), Scans: pointers.From(42)}},
+		},
+	}
+	load := func(context.Context, pgtypes.InsightsInput) (*postgres.InsightsOut, error) {
+		return out, nil
+	}
+
+	v := views.NewPostgresInsightsView(context.Background(), load, pgtypes.InsightsInput{IDOrName: "my-db"})
+	v.Init()
+	v.Update(tui.StackSizeMsg{Width: 120, Height: 40})
+	v.Update(tui.LoadDataMsg[*postgres.InsightsOut]{Data: out})
+
+	view := v.View()
+	assert.Contains(t, view, "Top queries")
+	assert.Contains(t, view, "SELECT * FROM orders")
+	assert.NotContains(t, view, "public.customers")
+
+	v.Update(tea.KeyMsg{Type: tea.KeyShiftRight})
+	view = v.View()
+	assert.Contains(t, view, "public.customers")
+	assert.NotContains(t, view, "SELECT * FROM orders")
+
+	_, cmd := v.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("r")})
+	require.NotNil(t, cmd, "expected r to reload")
+}
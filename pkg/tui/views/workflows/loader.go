@@ -22,8 +22,9 @@ type taskRepo interface {
 	GetTask(ctx context.Context, id string) (*workflows.Task, error)
 	ListTasks(ctx context.Context, params *client.ListTasksParams) (client.Cursor, []*workflows.Task, error)
 	ListTaskRuns(ctx context.Context, params *client.ListTaskRunsParams) (client.Cursor, []*workflows.TaskRun, error)
-	GetTaskRunDetails(ctx context.Context, taskRunID string) (*workflows.TaskRunDetails, error)
+	GetTaskRunDetails(ctx context.Context, taskRunID string) (*tasks.TaskRunDetails, error)
 	CancelTaskRun(ctx context.Context, taskRunID string) error
+	RerunTaskRun(ctx context.Context, taskRunID string, input *workflows.TaskData) (*workflows.TaskRun, error)
 }
 
 // versionRepo defines the version operations the loader needs (unexported; used for testability).
@@ -325,10 +326,93 @@ func (w *WorkflowLoader) LoadAllTaskRuns(ctx context.Context, input TaskRunListI
 	return taskRuns, err
 }
 
-func (w *WorkflowLoader) LoadTaskRunDetails(ctx context.Context, input *TaskRunTargetInput) (*workflows.TaskRunDetails, error) {
+func (w *WorkflowLoader) LoadTaskRunDetails(ctx context.Context, input *TaskRunTargetInput) (*tasks.TaskRunDetails, error) {
 	return w.taskRepo.GetTaskRunDetails(ctx, input.TaskRunID)
 }
 
+// LoadTaskRunTree loads every run that shares a root task run with the
+// target run and arranges them into a tree starting at that root.
+func (w *WorkflowLoader) LoadTaskRunTree(ctx context.Context, input *TaskRunTargetInput) (*tasks.RunNode, error) {
+	target, err := w.taskRepo.GetTaskRunDetails(ctx, input.TaskRunID)
+	if err != nil {
+		return nil, err
+	}
+	rootID := target.RootTaskRunId
+	if rootID == "" {
+		rootID = target.Id
+	}
+
+	pageSize := 100
+	params := &client.ListTaskRunsParams{
+		Limit:         &pageSize,
+		RootTaskRunId: pointers.From([]string{rootID}),
+	}
+	seen := map[string]bool{}
+	var runs []*wfclient.TaskRun
+	for {
+		cursor, page, err := w.taskRepo.ListTaskRuns(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+		added := 0
+		for _, run := range page {
+			if !seen[run.Id] {
+				seen[run.Id] = true
+				runs = append(runs, run)
+				added++
+			}
+		}
+		// The local development server ignores the cursor and returns every
+		// run at once, so stop as soon as a page adds nothing new.
+		if cursor == "" || added == 0 {
+			break
+		}
+		params.Cursor = &cursor
+	}
+
+	taskNames := map[string]string{}
+	for _, run := range runs {
+		if _, ok := taskNames[run.TaskId]; ok || run.TaskId == "" {
+			continue
+		}
+		taskNames[run.TaskId] = run.TaskId
+		if task, err := w.taskRepo.GetTask(ctx, run.TaskId); err == nil && task != nil {
+			taskNames[run.TaskId] = task.Name
+		}
+	}
+
+	return tasks.BuildRunTree(rootID, runs, taskNames)
+}
+
 func (w *WorkflowLoader) CancelTaskRun(ctx context.Context, taskRunID string) error {
 	return w.taskRepo.CancelTaskRun(ctx, taskRunID)
 }
+
+// RerunTaskRun starts a new run of the task behind taskRunID with input, a
+// JSON array or object, or with the original run's input when input is
+// empty. The local dev server links the new run to the original; the hosted
+// API doesn't track re-runs, so there it is started as an ordinary run of the
+// same task.
+func (w *WorkflowLoader) RerunTaskRun(ctx context.Context, taskRunID string, input string, local bool) (*workflows.TaskRun, error) {
+	var inputData *workflows.TaskData
+	if input != "" {
+		var err error
+		inputData, err = unmarshalInputData(input)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if local {
+		return w.taskRepo.RerunTaskRun(ctx, taskRunID, inputData)
+	}
+
+	original, err := w.taskRepo.GetTaskRunDetails(ctx, taskRunID)
+	if err != nil {
+		return nil, err
+	}
+	if inputData == nil {
+		inputData = &original.Input
+	}
+	return w.taskRepo.RunTask(ctx, original.TaskId, inputData)
+}
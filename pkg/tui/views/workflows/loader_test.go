@@ -14,28 +14,54 @@ import (
 	"github.com/render-oss/cli/pkg/client"
 	wfclient "github.com/render-oss/cli/pkg/client/workflows"
 	"github.com/render-oss/cli/pkg/pointers"
+	"github.com/render-oss/cli/pkg/tasks"
 	"github.com/render-oss/cli/pkg/version"
 )
 
 // mockTaskRepo implements taskRepo for testing.
 type mockTaskRepo struct {
-	listTasksFn func(ctx context.Context, params *client.ListTasksParams) (client.Cursor, []*wfclient.Task, error)
+	listTasksFn         func(ctx context.Context, params *client.ListTasksParams) (client.Cursor, []*wfclient.Task, error)
+	getTaskFn           func(ctx context.Context, id string) (*wfclient.Task, error)
+	listTaskRunsFn      func(ctx context.Context, params *client.ListTaskRunsParams) (client.Cursor, []*wfclient.TaskRun, error)
+	getTaskRunDetailsFn func(ctx context.Context, taskRunID string) (*tasks.TaskRunDetails, error)
+	runTaskFn           func(ctx context.Context, taskID string, input *wfclient.TaskData) (*wfclient.TaskRun, error)
+	rerunTaskRunFn      func(ctx context.Context, taskRunID string, input *wfclient.TaskData) (*wfclient.TaskRun, error)
 }
 
-func (m *mockTaskRepo) RunTask(context.Context, string, *wfclient.TaskData) (*wfclient.TaskRun, error) {
+func (m *mockTaskRepo) RunTask(ctx context.Context, taskID string, input *wfclient.TaskData) (*wfclient.TaskRun, error) {
+	if m.runTaskFn != nil {
+		return m.runTaskFn(ctx, taskID, input)
+	}
+	return nil, nil
+}
+func (m *mockTaskRepo) GetTask(ctx context.Context, id string) (*wfclient.Task, error) {
+	if m.getTaskFn != nil {
+		return m.getTaskFn(ctx, id)
+	}
 	return nil, nil
 }
-func (m *mockTaskRepo) GetTask(context.Context, string) (*wfclient.Task, error) { return nil, nil }
 func (m *mockTaskRepo) ListTasks(ctx context.Context, params *client.ListTasksParams) (client.Cursor, []*wfclient.Task, error) {
 	return m.listTasksFn(ctx, params)
 }
-func (m *mockTaskRepo) ListTaskRuns(context.Context, *client.ListTaskRunsParams) (client.Cursor, []*wfclient.TaskRun, error) {
+func (m *mockTaskRepo) ListTaskRuns(ctx context.Context, params *client.ListTaskRunsParams) (client.Cursor, []*wfclient.TaskRun, error) {
+	if m.listTaskRunsFn != nil {
+		return m.listTaskRunsFn(ctx, params)
+	}
 	return "", nil, nil
 }
-func (m *mockTaskRepo) GetTaskRunDetails(context.Context, string) (*wfclient.TaskRunDetails, error) {
+func (m *mockTaskRepo) GetTaskRunDetails(ctx context.Context, taskRunID string) (*tasks.TaskRunDetails, error) {
+	if m.getTaskRunDetailsFn != nil {
+		return m.getTaskRunDetailsFn(ctx, taskRunID)
+	}
 	return nil, nil
 }
 func (m *mockTaskRepo) CancelTaskRun(context.Context, string) error { return nil }
+func (m *mockTaskRepo) RerunTaskRun(ctx context.Context, taskRunID string, input *wfclient.TaskData) (*wfclient.TaskRun, error) {
+	if m.rerunTaskRunFn != nil {
+		return m.rerunTaskRunFn(ctx, taskRunID, input)
+	}
+	return nil, nil
+}
 
 // mockVersionRepo implements versionRepo for testing.
 type mockVersionRepo struct {
@@ -206,6 +232,120 @@ func TestLoadTaskList(t *testing.T) {
 	})
 }
 
+func TestLoadTaskRunTree(t *testing.T) {
+	runs := []*wfclient.TaskRun{
+		{Id: "trn-root", TaskId: "tsk-parent", RootTaskRunId: "trn-root"},
+		{Id: "trn-child", TaskId: "tsk-child", ParentTaskRunId: "trn-root", RootTaskRunId: "trn-root"},
+		{Id: "trn-grandchild", TaskId: "tsk-child", ParentTaskRunId: "trn-child", RootTaskRunId: "trn-root"},
+	}
+
+	t.Run("loads the whole tree from any run in it", func(t *testing.T) {
+		var listCalls int
+		repo := &mockTaskRepo{
+			getTaskRunDetailsFn: func(_ context.Context, id string) (*tasks.TaskRunDetails, error) {
+				return &tasks.TaskRunDetails{TaskRunDetails: wfclient.TaskRunDetails{Id: id, RootTaskRunId: "trn-root"}}, nil
+			},
+			listTaskRunsFn: func(_ context.Context, params *client.ListTaskRunsParams) (client.Cursor, []*wfclient.TaskRun, error) {
+				listCalls++
+				require.NotNil(t, params.RootTaskRunId)
+				assert.Equal(t, []string{"trn-root"}, *params.RootTaskRunId)
+				// Like the local server, ignore the cursor and return everything.
+				return "trn-grandchild", runs, nil
+			},
+			getTaskFn: func(_ context.Context, id string) (*wfclient.Task, error) {
+				return &wfclient.Task{Id: id, Name: id[len("tsk-"):]}, nil
+			},
+		}
+		loader := &WorkflowLoader{taskRepo: repo}
+
+		root, err := loader.LoadTaskRunTree(context.Background(), &TaskRunTargetInput{TaskRunID: "trn-grandchild"})
+		require.NoError(t, err)
+
+		assert.Equal(t, 2, listCalls)
+		assert.Equal(t, "trn-root", root.Run.Id)
+		assert.Equal(t, "parent", root.TaskName)
+		require.Len(t, root.Children, 1)
+		assert.Equal(t, "child", root.Children[0].TaskName)
+		require.Len(t, root.Children[0].Children, 1)
+		assert.Equal(t, "trn-grandchild", root.Children[0].Children[0].Run.Id)
+	})
+
+	t.Run("falls back to the task ID when the task can't be loaded", func(t *testing.T) {
+		repo := &mockTaskRepo{
+			getTaskRunDetailsFn: func(_ context.Context, id string) (*tasks.TaskRunDetails, error) {
+				return &tasks.TaskRunDetails{TaskRunDetails: wfclient.TaskRunDetails{Id: id}}, nil
+			},
+			listTaskRunsFn: func(context.Context, *client.ListTaskRunsParams) (client.Cursor, []*wfclient.TaskRun, error) {
+				return "", runs[:1], nil
+			},
+			getTaskFn: func(context.Context, string) (*wfclient.Task, error) {
+				return nil, errors.New("not found")
+			},
+		}
+		loader := &WorkflowLoader{taskRepo: repo}
+
+		root, err := loader.LoadTaskRunTree(context.Background(), &TaskRunTargetInput{TaskRunID: "trn-root"})
+		require.NoError(t, err)
+		assert.Equal(t, "tsk-parent", root.TaskName)
+	})
+}
+
+func TestRerunTaskRun(t *testing.T) {
+	var originalInput wfclient.TaskData
+	require.NoError(t, originalInput.FromTaskData0(wfclient.TaskData0{"original"}))
+	getDetails := func(_ context.Context, id string) (*tasks.TaskRunDetails, error) {
+		return &tasks.TaskRunDetails{TaskRunDetails: wfclient.TaskRunDetails{Id: id, TaskId: "tsk-1", Input: originalInput}}, nil
+	}
+
+	t.Run("locally only sends input that overrides the original", func(t *testing.T) {
+		var gotInput *wfclient.TaskData
+		repo := &mockTaskRepo{
+			getTaskRunDetailsFn: func(context.Context, string) (*tasks.TaskRunDetails, error) {
+				t.Fatal("the local server looks up the original run itself")
+				return nil, nil
+			},
+			rerunTaskRunFn: func(_ context.Context, taskRunID string, input *wfclient.TaskData) (*wfclient.TaskRun, error) {
+				assert.Equal(t, "trn-old", taskRunID)
+				gotInput = input
+				return &wfclient.TaskRun{Id: "trn-new"}, nil
+			},
+		}
+		loader := &WorkflowLoader{taskRepo: repo}
+
+		run, err := loader.RerunTaskRun(context.Background(), "trn-old", "", true)
+		require.NoError(t, err)
+		assert.Equal(t, "trn-new", run.Id)
+		assert.Nil(t, gotInput)
+
+		_, err = loader.RerunTaskRun(context.Background(), "trn-old", `["edited"]`, true)
+		require.NoError(t, err)
+		require.NotNil(t, gotInput)
+		edited, err := gotInput.MarshalJSON()
+		require.NoError(t, err)
+		assert.JSONEq(t, `["edited"]`, string(edited))
+	})
+
+	t.Run("against Render starts the task with the original input", func(t *testing.T) {
+		var gotInput *wfclient.TaskData
+		repo := &mockTaskRepo{
+			getTaskRunDetailsFn: getDetails,
+			runTaskFn: func(_ context.Context, taskID string, input *wfclient.TaskData) (*wfclient.TaskRun, error) {
+				assert.Equal(t, "tsk-1", taskID)
+				gotInput = input
+				return &wfclient.TaskRun{Id: "trn-new"}, nil
+			},
+		}
+		loader := &WorkflowLoader{taskRepo: repo}
+
+		_, err := loader.RerunTaskRun(context.Background(), "trn-old", "", false)
+		require.NoError(t, err)
+		require.NotNil(t, gotInput)
+		original, err := gotInput.MarshalJSON()
+		require.NoError(t, err)
+		assert.JSONEq(t, `["original"]`, string(original))
+	})
+}
+
 func TestReleaseVersion(t *testing.T) {
 	readyVersion := &wfclient.WorkflowVersion{Id: "wfv-new", Name: "v2", Status: wfclient.Ready}
 
@@ -0,0 +1,86 @@
+package views
+
+import (
+	"context"
+
+	tea "github.com/charmbracelet/bubbletea"
+	btable "github.com/evertras/bubble-table/table"
+
+	"github.com/render-oss/cli/pkg/client"
+	"github.com/render-oss/cli/pkg/command"
+	"github.com/render-oss/cli/pkg/tui"
+	workspacetypes "github.com/render-oss/cli/pkg/types/workspace"
+)
+
+type ListWorkspaceMembersInput struct{}
+
+const memberKey = "member"
+
+type WorkspaceMemberListView struct {
+	table *tui.Table[*client.TeamMember]
+}
+
+// NewWorkspaceMemberListView shows the active workspace's members. Selecting
+// a member calls onSelect, which typically opens a palette of member actions.
+func NewWorkspaceMemberListView(
+	ctx context.Context,
+	input ListWorkspaceMembersInput,
+	loadMembers func(context.Context, ListWorkspaceMembersInput) ([]*client.TeamMember, error),
+	onSelect func(*client.TeamMember) tea.Cmd,
+) *WorkspaceMemberListView {
+	columns := []btable.Column{
+		btable.NewFlexColumn("Name", "Name", 1).WithFiltered(true),
+		btable.NewFlexColumn("Email", "Email", 2).WithFiltered(true),
+		btable.NewColumn("Role", "Role", 14).WithFiltered(true),
+		btable.NewColumn("Status", "Status", 10),
+		btable.NewColumn("MFA", "MFA", 5),
+	}
+
+	createRowFunc := func(m *client.TeamMember) btable.Row {
+		mfa := "no"
+		if m.MfaEnabled {
+			mfa = "yes"
+		}
+		return btable.NewRow(btable.RowData{
+			// memberKey has no column; it carries the member to onSelect.
+			memberKey: m,
+			"Name":    m.Name,
+			"Email":   m.Email,
+			"Role":    workspacetypes.RoleName(m.Role),
+			"Status":  string(m.Status),
+			"MFA":     mfa,
+		})
+	}
+
+	onSelectRows := func(rows []btable.Row) tea.Cmd {
+		if len(rows) == 0 {
+			return nil
+		}
+		m, ok := rows[0].Data[memberKey].(*client.TeamMember)
+		if !ok {
+			return nil
+		}
+		return onSelect(m)
+	}
+
+	return &WorkspaceMemberListView{
+		table: tui.NewTable(
+			columns,
+			command.LoadCmd(ctx, loadMembers, input),
+			createRowFunc,
+			onSelectRows,
+		),
+	}
+}
+
+func (v *WorkspaceMemberListView) Init() tea.Cmd {
+	return v.table.Init()
+}
+
+func (v *WorkspaceMemberListView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	return v.table.Update(msg)
+}
+
+func (v *WorkspaceMemberListView) View() string {
+	return v.table.View()
+}
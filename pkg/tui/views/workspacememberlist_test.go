@@ -0,0 +1,40 @@
+package views_test
+
+import (
+	"context"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/render-oss/cli/pkg/client"
+	"github.com/render-oss/cli/pkg/tui"
+	"github.com/render-oss/cli/pkg/tui/views"
+)
+
+func TestWorkspaceMemberListViewSelectsMember(t *testing.T) {
+	members := []*client.TeamMember{
+		{UserId: "usr-1", Name: "Alice", Email: "alice@example.com", Role: client.ADMIN},
+		{UserId: "usr-2", Name: "Bob", Email: "bob@example.com", Role: client.WORKSPACEVIEWER},
+	}
+
+	var selected *client.TeamMember
+	view := views.NewWorkspaceMemberListView(context.Background(), views.ListWorkspaceMembersInput{},
+		func(context.Context, views.ListWorkspaceMembersInput) ([]*client.TeamMember, error) {
+			return members, nil
+		},
+		func(m *client.TeamMember) tea.Cmd {
+			selected = m
+			return nil
+		},
+	)
+
+	view.Update(tui.LoadDataMsg[[]*client.TeamMember]{Data: members})
+	view.Update(tea.KeyMsg{Type: tea.KeyDown})
+	view.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+	require.NotNil(t, selected)
+	assert.Equal(t, "usr-2", selected.UserId)
+	assert.Contains(t, view.View(), "viewer")
+}
@@ -0,0 +1,95 @@
+package auditlog
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/render-oss/cli/pkg/auditlog"
+	"github.com/render-oss/cli/pkg/client"
+	"github.com/render-oss/cli/pkg/command"
+)
+
+// DefaultLimit is the number of entries `audit-logs` shows by default.
+const DefaultLimit = 100
+
+// ListInput is the raw command input for listing audit logs.
+type ListInput struct {
+	Start        *command.TimeOrRelative `cli:"start"`
+	End          *command.TimeOrRelative `cli:"end"`
+	Actors       []string                `cli:"actor"`
+	Events       []string                `cli:"event"`
+	Status       string                  `cli:"status"`
+	Organization *string                 `cli:"organization"`
+	Limit        int                     `cli:"limit"`
+	Export       string                  `cli:"export"`
+}
+
+func (in ListInput) Validate(_ bool) error {
+	if in.Start != nil && in.End != nil && in.Start.T.After(*in.End.T) {
+		return errors.New("--start must be before --end")
+	}
+	if in.Limit < 0 {
+		return errors.New("--limit must not be negative")
+	}
+	if in.Organization != nil && *in.Organization == "" {
+		return errors.New("--organization must not be empty")
+	}
+	_, err := ParseEvents(in.Events)
+	return err
+}
+
+// Query converts the input into a repo query.
+func (in ListInput) Query() (auditlog.Query, error) {
+	events, err := ParseEvents(in.Events)
+	if err != nil {
+		return auditlog.Query{}, err
+	}
+
+	q := auditlog.Query{
+		Filter: auditlog.Filter{Actors: in.Actors, Events: events},
+		Limit:  in.Limit,
+	}
+	if in.Organization != nil {
+		q.OrganizationID = *in.Organization
+	}
+	if in.Start != nil {
+		q.Start = in.Start.T
+	}
+	if in.End != nil {
+		q.End = in.End.T
+	}
+	if in.Status != "" {
+		status := client.AuditLogStatus(in.Status)
+		q.Filter.Status = &status
+	}
+	return q, nil
+}
+
+// ParseEvents maps event names to their API values. The "Event" suffix and
+// a leading capital are optional, so "createServer" and "CreateServerEvent"
+// are equivalent.
+func ParseEvents(names []string) ([]client.AuditLogEvent, error) {
+	events := make([]client.AuditLogEvent, 0, len(names))
+	for _, name := range names {
+		event, ok := parseEvent(name)
+		if !ok {
+			return nil, fmt.Errorf("unknown audit log event %q", name)
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+func parseEvent(name string) (client.AuditLogEvent, bool) {
+	if name == "" {
+		return "", false
+	}
+	name = strings.ToUpper(name[:1]) + name[1:]
+	for _, candidate := range []string{name, name + "Event"} {
+		if event := client.AuditLogEvent(candidate); event.Valid() {
+			return event, true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,72 @@
+package disk
+
+import (
+	"errors"
+	"strings"
+)
+
+// MinSizeGB is the smallest disk the API accepts.
+const MinSizeGB = 1
+
+// ListInput is the raw command input for listing disks.
+type ListInput struct {
+	ServiceIDOrName *string `cli:"service"`
+}
+
+// GetInput is the raw command input for reading one disk.
+type GetInput struct {
+	IDOrName string `cli:"arg:0"`
+}
+
+// AddInput is the raw command input for attaching a new disk to a service.
+type AddInput struct {
+	ServiceIDOrName string `cli:"arg:0"`
+	Name            string `cli:"name"`
+	MountPath       string `cli:"mount-path"`
+	SizeGB          int    `cli:"size"`
+}
+
+func (in AddInput) Validate(_ bool) error {
+	if strings.TrimSpace(in.Name) == "" {
+		return errors.New("--name is required")
+	}
+	if !strings.HasPrefix(in.MountPath, "/") {
+		return errors.New("--mount-path must be an absolute path")
+	}
+	if in.SizeGB < MinSizeGB {
+		return errors.New("--size must be at least 1 GB")
+	}
+	return nil
+}
+
+// ResizeInput is the raw command input for growing a disk.
+type ResizeInput struct {
+	IDOrName string `cli:"arg:0"`
+	SizeGB   int    `cli:"size"`
+}
+
+func (in ResizeInput) Validate(_ bool) error {
+	if in.SizeGB < MinSizeGB {
+		return errors.New("--size must be at least 1 GB")
+	}
+	return nil
+}
+
+// DeleteInput is the raw command input for deleting a disk.
+type DeleteInput struct {
+	IDOrName string `cli:"arg:0"`
+}
+
+// SnapshotListInput is the raw command input for listing a disk's snapshots.
+type SnapshotListInput struct {
+	IDOrName string `cli:"arg:0"`
+}
+
+// SnapshotRestoreInput is the raw command input for restoring a disk from a
+// snapshot. InstanceID selects which instance's disk to restore on scaled
+// services.
+type SnapshotRestoreInput struct {
+	IDOrName    string  `cli:"arg:0"`
+	SnapshotKey string  `cli:"arg:1"`
+	InstanceID  *string `cli:"instance"`
+}
@@ -0,0 +1,66 @@
+package envgroup
+
+import (
+	"strings"
+
+	types "github.com/render-oss/cli/pkg/types"
+)
+
+// ListInput is the raw command input for listing env groups.
+type ListInput struct {
+	ProjectIDOrName     *string `cli:"project"`
+	EnvironmentIDOrName *string `cli:"environment"`
+}
+
+// GetInput is the raw command input for reading one env group.
+type GetInput struct {
+	IDOrName   string `cli:"arg:0"`
+	ShowValues bool   `cli:"show-values"`
+}
+
+// CreateInput is the raw command input for creating an env group. KEY=VALUE
+// pairs after the name are positional and parsed separately.
+type CreateInput struct {
+	Name                string   `cli:"arg:0"`
+	ProjectIDOrName     *string  `cli:"project"`
+	EnvironmentIDOrName *string  `cli:"environment"`
+	Services            []string `cli:"service"`
+	SecretFiles         []string `cli:"secret-file"`
+}
+
+// DeleteInput is the raw command input for deleting an env group.
+type DeleteInput struct {
+	IDOrName string `cli:"arg:0"`
+}
+
+// SetInput is the raw command input for setting variables and secret files.
+// KEY=VALUE pairs are positional and parsed separately.
+type SetInput struct {
+	IDOrName    string   `cli:"arg:0"`
+	SecretFiles []string `cli:"secret-file"`
+}
+
+// UnsetInput is the raw command input for removing variables. Keys are
+// positional and parsed separately.
+type UnsetInput struct {
+	IDOrName string `cli:"arg:0"`
+}
+
+// LinkInput is the raw command input for linking or unlinking a service.
+type LinkInput struct {
+	IDOrName        string `cli:"arg:0"`
+	ServiceIDOrName string `cli:"arg:1"`
+}
+
+func NormalizeListInput(input ListInput) ListInput {
+	input.ProjectIDOrName = types.OptionalNonZeroString(input.ProjectIDOrName)
+	input.EnvironmentIDOrName = types.OptionalNonZeroString(input.EnvironmentIDOrName)
+	return input
+}
+
+func NormalizeCreateInput(input CreateInput) CreateInput {
+	input.Name = strings.TrimSpace(input.Name)
+	input.ProjectIDOrName = types.OptionalNonZeroString(input.ProjectIDOrName)
+	input.EnvironmentIDOrName = types.OptionalNonZeroString(input.EnvironmentIDOrName)
+	return input
+}
@@ -0,0 +1,34 @@
+package postgres
+
+import "github.com/render-oss/cli/pkg/types"
+
+// ExportInput is the raw command input parsed from Cobra args and flags for
+// listing or creating a Postgres database's exports.
+type ExportInput struct {
+	IDOrName            string  `cli:"arg:0"`
+	ProjectIDOrName     *string `cli:"project"`
+	EnvironmentIDOrName *string `cli:"environment"`
+}
+
+func NormalizeExportInput(input ExportInput) ExportInput {
+	input.ProjectIDOrName = types.OptionalNonZeroString(input.ProjectIDOrName)
+	input.EnvironmentIDOrName = types.OptionalNonZeroString(input.EnvironmentIDOrName)
+	return input
+}
+
+// ExportDownloadInput is the raw command input parsed from Cobra args and
+// flags for downloading a Postgres export.
+type ExportDownloadInput struct {
+	IDOrName            string  `cli:"arg:0"`
+	ExportID            *string `cli:"export"`
+	FilePath            string  `cli:"file"`
+	ProjectIDOrName     *string `cli:"project"`
+	EnvironmentIDOrName *string `cli:"environment"`
+}
+
+func NormalizeExportDownloadInput(input ExportDownloadInput) ExportDownloadInput {
+	input.ExportID = types.OptionalNonZeroString(input.ExportID)
+	input.ProjectIDOrName = types.OptionalNonZeroString(input.ProjectIDOrName)
+	input.EnvironmentIDOrName = types.OptionalNonZeroString(input.EnvironmentIDOrName)
+	return input
+}
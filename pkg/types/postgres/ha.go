@@ -0,0 +1,89 @@
+package postgres
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/render-oss/cli/pkg/types"
+)
+
+// DefaultHAWaitTimeoutSeconds bounds how long --wait polls for a database to
+// become healthy when --timeout is not set.
+const DefaultHAWaitTimeoutSeconds = 900
+
+// DefaultReplicationSchema is the schema replicated when --schema is not set.
+const DefaultReplicationSchema = "public"
+
+// HAInput is the raw command input parsed from Cobra args and flags for a
+// failover or promotion.
+type HAInput struct {
+	IDOrName            string  `cli:"arg:0"`
+	Wait                bool    `cli:"wait"`
+	Timeout             int     `cli:"timeout"`
+	SkipStandbyCheck    bool    `cli:"skip-standby-check"`
+	ProjectIDOrName     *string `cli:"project"`
+	EnvironmentIDOrName *string `cli:"environment"`
+}
+
+func (in HAInput) Validate(_ bool) error {
+	return validateHAWaitTimeout(in.Timeout)
+}
+
+func NormalizeHAInput(input HAInput) HAInput {
+	input.ProjectIDOrName = types.OptionalNonZeroString(input.ProjectIDOrName)
+	input.EnvironmentIDOrName = types.OptionalNonZeroString(input.EnvironmentIDOrName)
+	return input
+}
+
+// ReplicateInput is the raw command input parsed from Cobra args and flags
+// for setting up logical replication. An unset Database means the database's
+// own default database.
+type ReplicateInput struct {
+	IDOrName            string  `cli:"arg:0"`
+	Database            *string `cli:"database"`
+	Schema              string  `cli:"schema"`
+	Publication         *string `cli:"publication"`
+	Slot                *string `cli:"slot"`
+	Restart             bool    `cli:"restart"`
+	Wait                bool    `cli:"wait"`
+	Timeout             int     `cli:"timeout"`
+	ProjectIDOrName     *string `cli:"project"`
+	EnvironmentIDOrName *string `cli:"environment"`
+}
+
+func (in ReplicateInput) Validate(_ bool) error {
+	if strings.TrimSpace(in.Schema) == "" {
+		return errors.New("--schema must not be empty")
+	}
+	if in.Wait && !in.Restart {
+		return errors.New("--wait requires --restart; replication setup only takes effect once the database restarts")
+	}
+	return validateHAWaitTimeout(in.Timeout)
+}
+
+func NormalizeReplicateInput(input ReplicateInput) ReplicateInput {
+	input.Database = types.OptionalNonZeroString(input.Database)
+	input.Schema = strings.TrimSpace(input.Schema)
+	input.Publication = types.OptionalNonZeroString(input.Publication)
+	input.Slot = types.OptionalNonZeroString(input.Slot)
+	input.ProjectIDOrName = types.OptionalNonZeroString(input.ProjectIDOrName)
+	input.EnvironmentIDOrName = types.OptionalNonZeroString(input.EnvironmentIDOrName)
+	return input
+}
+
+// HAWaitTimeout converts the --timeout flag, in seconds, to a duration. Zero
+// selects DefaultHAWaitTimeoutSeconds.
+func HAWaitTimeout(seconds int) time.Duration {
+	if seconds <= 0 {
+		seconds = DefaultHAWaitTimeoutSeconds
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+func validateHAWaitTimeout(seconds int) error {
+	if seconds < 0 {
+		return errors.New("--timeout must not be negative")
+	}
+	return nil
+}
@@ -0,0 +1,17 @@
+package postgres
+
+import "github.com/render-oss/cli/pkg/types"
+
+// InsightsInput is the raw command input parsed from Cobra args and flags for
+// viewing a Postgres database's performance insights.
+type InsightsInput struct {
+	IDOrName            string  `cli:"arg:0"`
+	ProjectIDOrName     *string `cli:"project"`
+	EnvironmentIDOrName *string `cli:"environment"`
+}
+
+func NormalizeInsightsInput(input InsightsInput) InsightsInput {
+	input.ProjectIDOrName = types.OptionalNonZeroString(input.ProjectIDOrName)
+	input.EnvironmentIDOrName = types.OptionalNonZeroString(input.EnvironmentIDOrName)
+	return input
+}
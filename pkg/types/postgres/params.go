@@ -0,0 +1,76 @@
+package postgres
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/render-oss/cli/pkg/types"
+)
+
+// ParamsInput is the raw command input parsed from Cobra args and flags for
+// listing a Postgres database's parameter overrides, or the parameters that
+// can be overridden.
+type ParamsInput struct {
+	IDOrName            string  `cli:"arg:0"`
+	ProjectIDOrName     *string `cli:"project"`
+	EnvironmentIDOrName *string `cli:"environment"`
+}
+
+func NormalizeParamsInput(input ParamsInput) ParamsInput {
+	input.ProjectIDOrName = types.OptionalNonZeroString(input.ProjectIDOrName)
+	input.EnvironmentIDOrName = types.OptionalNonZeroString(input.EnvironmentIDOrName)
+	return input
+}
+
+// ParamsSetInput is the raw command input parsed from Cobra args and flags
+// for setting parameter overrides. The NAME=VALUE pairs after the database
+// are parsed separately.
+type ParamsSetInput struct {
+	IDOrName            string  `cli:"arg:0"`
+	File                *string `cli:"file"`
+	ProjectIDOrName     *string `cli:"project"`
+	EnvironmentIDOrName *string `cli:"environment"`
+}
+
+func NormalizeParamsSetInput(input ParamsSetInput) ParamsSetInput {
+	input.File = types.OptionalNonZeroString(input.File)
+	input.ProjectIDOrName = types.OptionalNonZeroString(input.ProjectIDOrName)
+	input.EnvironmentIDOrName = types.OptionalNonZeroString(input.EnvironmentIDOrName)
+	return input
+}
+
+// ParseParamAssignments parses NAME=VALUE pairs. If a name is repeated, the
+// last value wins.
+func ParseParamAssignments(args []string) (map[string]string, error) {
+	params := make(map[string]string, len(args))
+	for _, raw := range args {
+		name, value, hasEquals := strings.Cut(raw, "=")
+		name = strings.TrimSpace(name)
+		if !hasEquals || name == "" {
+			return nil, fmt.Errorf("invalid parameter %q: expected NAME=VALUE", raw)
+		}
+		params[name] = value
+	}
+	return params, nil
+}
+
+// ParseParamNames trims and de-duplicates positional parameter names,
+// preserving their order.
+func ParseParamNames(args []string) ([]string, error) {
+	if len(args) == 0 {
+		return nil, errors.New("at least one parameter name is required")
+	}
+	names := make([]string, 0, len(args))
+	for _, raw := range args {
+		name, ok := types.TrimmedNonEmpty(raw)
+		if !ok {
+			return nil, errors.New("parameter names must not be empty")
+		}
+		if !slices.Contains(names, name) {
+			names = append(names, name)
+		}
+	}
+	return names, nil
+}
@@ -0,0 +1,37 @@
+package postgres
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/render-oss/cli/pkg/command"
+	"github.com/render-oss/cli/pkg/types"
+)
+
+// RecoverPostgresInput is the raw command input parsed from Cobra args and
+// flags for point-in-time recovery of a Postgres database.
+type RecoverPostgresInput struct {
+	IDOrName            string                  `cli:"arg:0"`
+	To                  *command.TimeOrRelative `cli:"to"`
+	Name                string                  `cli:"name"`
+	Plan                *string                 `cli:"plan"`
+	ProjectIDOrName     *string                 `cli:"project"`
+	EnvironmentIDOrName *string                 `cli:"environment"`
+}
+
+func (in RecoverPostgresInput) Validate(_ bool) error {
+	if in.To == nil {
+		return errors.New("--to is required")
+	}
+	if strings.TrimSpace(in.Name) == "" {
+		return errors.New("--name is required")
+	}
+	return nil
+}
+
+func NormalizeRecoverInput(input RecoverPostgresInput) RecoverPostgresInput {
+	input.Plan = types.OptionalNonZeroString(input.Plan)
+	input.ProjectIDOrName = types.OptionalNonZeroString(input.ProjectIDOrName)
+	input.EnvironmentIDOrName = types.OptionalNonZeroString(input.EnvironmentIDOrName)
+	return input
+}
@@ -0,0 +1,32 @@
+package postgres
+
+import "github.com/render-oss/cli/pkg/types"
+
+// ListUsersInput is the raw command input parsed from Cobra args and flags
+// for listing a Postgres database's users.
+type ListUsersInput struct {
+	IDOrName            string  `cli:"arg:0"`
+	ProjectIDOrName     *string `cli:"project"`
+	EnvironmentIDOrName *string `cli:"environment"`
+}
+
+func NormalizeListUsersInput(input ListUsersInput) ListUsersInput {
+	input.ProjectIDOrName = types.OptionalNonZeroString(input.ProjectIDOrName)
+	input.EnvironmentIDOrName = types.OptionalNonZeroString(input.EnvironmentIDOrName)
+	return input
+}
+
+// UserInput is the raw command input parsed from Cobra args and flags for
+// creating or deleting one Postgres user.
+type UserInput struct {
+	IDOrName            string  `cli:"arg:0"`
+	Username            string  `cli:"arg:1"`
+	ProjectIDOrName     *string `cli:"project"`
+	EnvironmentIDOrName *string `cli:"environment"`
+}
+
+func NormalizeUserInput(input UserInput) UserInput {
+	input.ProjectIDOrName = types.OptionalNonZeroString(input.ProjectIDOrName)
+	input.EnvironmentIDOrName = types.OptionalNonZeroString(input.EnvironmentIDOrName)
+	return input
+}
@@ -0,0 +1,57 @@
+package service
+
+import "fmt"
+
+const (
+	// MaxServiceInstances is the most instances a service can run, whether
+	// scaled manually or by autoscaling.
+	MaxServiceInstances = 100
+	// DefaultAutoscalingTargetPercent is the CPU target used when autoscaling
+	// is first enabled without any target flags.
+	DefaultAutoscalingTargetPercent = 70
+)
+
+// AutoscalingGetInput is the raw command input for showing a service's
+// autoscaling config.
+type AutoscalingGetInput struct {
+	ServiceIDOrName string `cli:"arg:0"`
+}
+
+// AutoscalingSetInput is the raw command input for enabling or changing
+// autoscaling. Nil fields keep the current value. A target of 0 turns that
+// criterion off.
+type AutoscalingSetInput struct {
+	ServiceIDOrName string `cli:"arg:0"`
+	Min             *int   `cli:"min"`
+	Max             *int   `cli:"max"`
+	CPUTarget       *int   `cli:"cpu-target"`
+	MemoryTarget    *int   `cli:"memory-target"`
+}
+
+func (in AutoscalingSetInput) Validate(_ bool) error {
+	if in.Min != nil && *in.Min < 1 {
+		return fmt.Errorf("--min must be at least 1")
+	}
+	if in.Max != nil && *in.Max < 1 {
+		return fmt.Errorf("--max must be at least 1")
+	}
+	if in.Min != nil && in.Max != nil && *in.Min > *in.Max {
+		return fmt.Errorf("--min (%d) must not be greater than --max (%d)", *in.Min, *in.Max)
+	}
+	if err := validateTargetPercent("--cpu-target", in.CPUTarget); err != nil {
+		return err
+	}
+	return validateTargetPercent("--memory-target", in.MemoryTarget)
+}
+
+func validateTargetPercent(flag string, target *int) error {
+	if target != nil && (*target < 0 || *target > 100) {
+		return fmt.Errorf("%s must be a percentage between 1 and 100, or 0 to turn it off", flag)
+	}
+	return nil
+}
+
+// AutoscalingDisableInput is the raw command input for turning autoscaling off.
+type AutoscalingDisableInput struct {
+	ServiceIDOrName string `cli:"arg:0"`
+}
@@ -0,0 +1,68 @@
+package service
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// DefaultCustomDomainWaitTimeoutSeconds bounds how long --wait polls for DNS
+// verification when --timeout is not set.
+const DefaultCustomDomainWaitTimeoutSeconds = 600
+
+// CustomDomainListInput is the raw command input for listing custom domains.
+type CustomDomainListInput struct {
+	ServiceIDOrName string `cli:"arg:0"`
+}
+
+// CustomDomainAddInput is the raw command input for attaching a custom domain.
+type CustomDomainAddInput struct {
+	ServiceIDOrName string `cli:"arg:0"`
+	Domain          string `cli:"arg:1"`
+	Wait            bool   `cli:"wait"`
+	Timeout         int    `cli:"timeout"`
+}
+
+// CustomDomainRemoveInput is the raw command input for detaching a custom domain.
+type CustomDomainRemoveInput struct {
+	ServiceIDOrName string `cli:"arg:0"`
+	Domain          string `cli:"arg:1"`
+}
+
+// CustomDomainVerifyInput is the raw command input for re-checking DNS. An
+// empty Domain means every domain on the service.
+type CustomDomainVerifyInput struct {
+	ServiceIDOrName string `cli:"arg:0"`
+	Domain          string `cli:"arg:1"`
+	Wait            bool   `cli:"wait"`
+	Timeout         int    `cli:"timeout"`
+}
+
+// NormalizeCustomDomainName trims and lowercases a domain name and rejects
+// values that are URLs rather than bare hostnames.
+func NormalizeCustomDomainName(raw string) (string, error) {
+	name := strings.ToLower(strings.TrimSpace(raw))
+	name = strings.TrimSuffix(name, ".")
+	if name == "" {
+		return "", fmt.Errorf("domain name is required")
+	}
+	if strings.Contains(name, "://") || strings.ContainsAny(name, "/ :") {
+		return "", fmt.Errorf("invalid domain %q: pass a bare hostname such as app.example.com", raw)
+	}
+	if !strings.Contains(name, ".") {
+		return "", fmt.Errorf("invalid domain %q: expected a fully qualified hostname such as app.example.com", raw)
+	}
+	return name, nil
+}
+
+// CustomDomainWaitTimeout converts the --timeout flag, in seconds, to a
+// duration. Zero selects DefaultCustomDomainWaitTimeoutSeconds.
+func CustomDomainWaitTimeout(seconds int) (time.Duration, error) {
+	if seconds < 0 {
+		return 0, fmt.Errorf("--timeout must not be negative")
+	}
+	if seconds == 0 {
+		seconds = DefaultCustomDomainWaitTimeoutSeconds
+	}
+	return time.Duration(seconds) * time.Second, nil
+}
@@ -0,0 +1,39 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	service "github.com/render-oss/cli/pkg/types/service"
+)
+
+func TestNormalizeCustomDomainName(t *testing.T) {
+	t.Run("trims, lowercases, and drops a trailing dot", func(t *testing.T) {
+		name, err := service.NormalizeCustomDomainName("  App.Example.COM. ")
+		require.NoError(t, err)
+		assert.Equal(t, "app.example.com", name)
+	})
+
+	for _, raw := range []string{"", "   ", "https://app.example.com", "app.example.com/path", "localhost", "app.example.com:443"} {
+		t.Run("rejects "+raw, func(t *testing.T) {
+			_, err := service.NormalizeCustomDomainName(raw)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestCustomDomainWaitTimeout(t *testing.T) {
+	timeout, err := service.CustomDomainWaitTimeout(0)
+	require.NoError(t, err)
+	assert.Equal(t, time.Duration(service.DefaultCustomDomainWaitTimeoutSeconds)*time.Second, timeout)
+
+	timeout, err = service.CustomDomainWaitTimeout(30)
+	require.NoError(t, err)
+	assert.Equal(t, 30*time.Second, timeout)
+
+	_, err = service.CustomDomainWaitTimeout(-1)
+	require.Error(t, err)
+}
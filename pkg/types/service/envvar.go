@@ -0,0 +1,96 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	types "github.com/render-oss/cli/pkg/types"
+)
+
+// EnvVarListInput is the raw command input for listing a service's environment variables.
+type EnvVarListInput struct {
+	ServiceIDOrName string `cli:"arg:0"`
+	ShowValues      bool   `cli:"show-values"`
+}
+
+// EnvVarGetInput is the raw command input for reading one environment variable.
+type EnvVarGetInput struct {
+	ServiceIDOrName string `cli:"arg:0"`
+	Key             string `cli:"arg:1"`
+	ShowValues      bool   `cli:"show-values"`
+}
+
+// EnvVarSetInput is the command input for setting environment variables. The
+// KEY=VALUE pairs are positional and parsed with ParseEnvVarAssignments.
+type EnvVarSetInput struct {
+	ServiceIDOrName string `cli:"arg:0"`
+	EnvVars         []types.EnvVar
+}
+
+// EnvVarUnsetInput is the command input for removing environment variables.
+// The keys are positional and parsed with ParseEnvVarKeys.
+type EnvVarUnsetInput struct {
+	ServiceIDOrName string `cli:"arg:0"`
+	Keys            []string
+}
+
+// EnvVarImportInput is the raw command input for importing a .env file.
+type EnvVarImportInput struct {
+	ServiceIDOrName string `cli:"arg:0"`
+	File            string `cli:"file" validate:"required"`
+	Replace         bool   `cli:"replace"`
+}
+
+// EnvVarExportInput is the raw command input for exporting to a .env file.
+type EnvVarExportInput struct {
+	ServiceIDOrName string `cli:"arg:0"`
+	File            string `cli:"file"`
+}
+
+// ParseEnvVarAssignments parses positional KEY=VALUE arguments. The value is
+// kept verbatim so that intentional leading or trailing whitespace survives.
+// When a key repeats, the last assignment wins.
+func ParseEnvVarAssignments(args []string) ([]types.EnvVar, error) {
+	if len(args) == 0 {
+		return nil, errors.New("at least one KEY=VALUE pair is required")
+	}
+
+	parsed := make([]types.EnvVar, 0, len(args))
+	for _, raw := range args {
+		key, value, hasEquals := strings.Cut(raw, "=")
+		key = strings.TrimSpace(key)
+		if !hasEquals || key == "" {
+			return nil, fmt.Errorf("invalid environment variable %q: expected KEY=VALUE", raw)
+		}
+		if i := slices.IndexFunc(parsed, func(ev types.EnvVar) bool { return ev.Key == key }); i >= 0 {
+			parsed = slices.Delete(parsed, i, i+1)
+		}
+		parsed = append(parsed, types.EnvVar{Key: key, Value: value})
+	}
+	return parsed, nil
+}
+
+// ParseEnvVarKeys trims and de-duplicates positional environment variable
+// keys, preserving their order.
+func ParseEnvVarKeys(args []string) ([]string, error) {
+	if len(args) == 0 {
+		return nil, errors.New("at least one environment variable key is required")
+	}
+
+	keys := make([]string, 0, len(args))
+	for _, raw := range args {
+		key, ok := types.TrimmedNonEmpty(raw)
+		if !ok {
+			return nil, errors.New("environment variable keys cannot be empty")
+		}
+		if strings.Contains(key, "=") {
+			return nil, fmt.Errorf("invalid environment variable key %q: pass only the key, not KEY=VALUE", raw)
+		}
+		if !slices.Contains(keys, key) {
+			keys = append(keys, key)
+		}
+	}
+	return keys, nil
+}
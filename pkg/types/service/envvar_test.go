@@ -0,0 +1,59 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	types "github.com/render-oss/cli/pkg/types"
+	service "github.com/render-oss/cli/pkg/types/service"
+)
+
+func TestParseEnvVarAssignments(t *testing.T) {
+	t.Run("splits on the first equals sign", func(t *testing.T) {
+		result, err := service.ParseEnvVarAssignments([]string{"URL=https://x?a=b", "EMPTY="})
+		require.NoError(t, err)
+		assert.Equal(t, []types.EnvVar{
+			{Key: "URL", Value: "https://x?a=b"},
+			{Key: "EMPTY", Value: ""},
+		}, result)
+	})
+
+	t.Run("last duplicate wins", func(t *testing.T) {
+		result, err := service.ParseEnvVarAssignments([]string{"A=1", "B=2", "A=3"})
+		require.NoError(t, err)
+		assert.Equal(t, []types.EnvVar{{Key: "B", Value: "2"}, {Key: "A", Value: "3"}}, result)
+	})
+
+	t.Run("keeps value whitespace", func(t *testing.T) {
+		result, err := service.ParseEnvVarAssignments([]string{"A= padded "})
+		require.NoError(t, err)
+		assert.Equal(t, " padded ", result[0].Value)
+	})
+
+	t.Run("rejects missing equals and empty keys", func(t *testing.T) {
+		for _, arg := range []string{"NOVALUE", "=value"} {
+			_, err := service.ParseEnvVarAssignments([]string{arg})
+			require.ErrorContains(t, err, "expected KEY=VALUE")
+		}
+	})
+}
+
+func TestParseEnvVarKeys(t *testing.T) {
+	t.Run("trims and de-duplicates", func(t *testing.T) {
+		result, err := service.ParseEnvVarKeys([]string{" A ", "B", "A"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"A", "B"}, result)
+	})
+
+	t.Run("rejects assignments", func(t *testing.T) {
+		_, err := service.ParseEnvVarKeys([]string{"A=1"})
+		require.ErrorContains(t, err, "pass only the key")
+	})
+
+	t.Run("rejects empty keys", func(t *testing.T) {
+		_, err := service.ParseEnvVarKeys([]string{" "})
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,26 @@
+package service
+
+import "fmt"
+
+// ServiceSuspendInput is the raw command input for suspending a service.
+type ServiceSuspendInput struct {
+	ServiceIDOrName string `cli:"arg:0"`
+}
+
+// ServiceResumeInput is the raw command input for resuming a service.
+type ServiceResumeInput struct {
+	ServiceIDOrName string `cli:"arg:0"`
+}
+
+// ServiceScaleInput is the raw command input for manually scaling a service.
+type ServiceScaleInput struct {
+	ServiceIDOrName string `cli:"arg:0"`
+	Instances       int    `cli:"instances"`
+}
+
+func (in ServiceScaleInput) Validate(_ bool) error {
+	if in.Instances < 1 {
+		return fmt.Errorf("--instances must be at least 1")
+	}
+	return nil
+}
@@ -0,0 +1,120 @@
+package webhook
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+
+	eventtypes "github.com/render-oss/cli/pkg/client/eventtypes"
+)
+
+// DefaultEventsLimit is the number of deliveries `webhooks events` shows by
+// default.
+const DefaultEventsLimit = 20
+
+// DefaultListenPort is the port `webhooks listen` receives deliveries on.
+const DefaultListenPort = 4242
+
+// CreateInput is the raw command input for creating a webhook.
+type CreateInput struct {
+	Name     string   `cli:"name"`
+	URL      string   `cli:"url"`
+	Events   []string `cli:"event"`
+	Disabled bool     `cli:"disabled"`
+}
+
+func (in CreateInput) Validate(_ bool) error {
+	if in.Name == "" {
+		return errors.New("--name is required")
+	}
+	if in.URL == "" {
+		return errors.New("--url is required")
+	}
+	if err := validateURL(in.URL); err != nil {
+		return err
+	}
+	return ValidateEventTypes(in.Events)
+}
+
+// UpdateInput is the raw command input for updating a webhook. Nil fields are
+// left unchanged.
+type UpdateInput struct {
+	IDOrName  string   `cli:"arg:0"`
+	Name      *string  `cli:"name"`
+	URL       *string  `cli:"url"`
+	Events    []string `cli:"event"`
+	AllEvents bool     `cli:"all-events"`
+	Enabled   *bool    `cli:"enabled"`
+}
+
+func (in UpdateInput) Validate(_ bool) error {
+	if in.URL != nil {
+		if err := validateURL(*in.URL); err != nil {
+			return err
+		}
+	}
+	if in.AllEvents && len(in.Events) > 0 {
+		return errors.New("--all-events cannot be combined with --event")
+	}
+	if in.Name == nil && in.URL == nil && len(in.Events) == 0 && !in.AllEvents && in.Enabled == nil {
+		return errors.New("nothing to update: pass at least one of --name, --url, --event, --all-events, or --enabled")
+	}
+	return ValidateEventTypes(in.Events)
+}
+
+// DeleteInput is the raw command input for deleting a webhook.
+type DeleteInput struct {
+	IDOrName string `cli:"arg:0"`
+}
+
+// EventsInput is the raw command input for listing a webhook's deliveries.
+type EventsInput struct {
+	IDOrName string `cli:"arg:0"`
+	Limit    int    `cli:"limit"`
+}
+
+func (in EventsInput) Validate(_ bool) error {
+	if in.Limit < 1 || in.Limit > 100 {
+		return errors.New("--limit must be between 1 and 100")
+	}
+	return nil
+}
+
+// ListenInput is the raw command input for the local webhook receiver.
+type ListenInput struct {
+	Port      int     `cli:"port"`
+	ForwardTo *string `cli:"forward-to"`
+	Webhook   *string `cli:"webhook"`
+	Secret    *string `cli:"secret"`
+}
+
+func (in ListenInput) Validate(_ bool) error {
+	if in.Port < 0 || in.Port > 65535 {
+		return errors.New("--port must be between 0 and 65535")
+	}
+	if in.Webhook != nil && in.Secret != nil {
+		return errors.New("--webhook and --secret cannot be combined")
+	}
+	if in.ForwardTo != nil {
+		return validateURL(*in.ForwardTo)
+	}
+	return nil
+}
+
+// ValidateEventTypes rejects event types the API does not recognize.
+func ValidateEventTypes(events []string) error {
+	for _, e := range events {
+		if !eventtypes.EventType(e).Valid() {
+			return fmt.Errorf("unknown event type %q", e)
+		}
+	}
+	return nil
+}
+
+func validateURL(raw string) error {
+	u, err := url.Parse(raw)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("invalid URL %q: must be an http or https URL", raw)
+	}
+	return nil
+}
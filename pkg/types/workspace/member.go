@@ -0,0 +1,58 @@
+package workspace
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/render-oss/cli/pkg/client"
+)
+
+var roles = []client.TeamMemberRole{
+	client.ADMIN,
+	client.DEVELOPER,
+	client.WORKSPACECONTRIBUTOR,
+	client.WORKSPACEVIEWER,
+	client.WORKSPACEBILLING,
+}
+
+// RoleValues lists the role names accepted on the command line.
+func RoleValues() []string {
+	values := make([]string, 0, len(roles))
+	for _, r := range roles {
+		values = append(values, RoleName(r))
+	}
+	return values
+}
+
+// RoleName returns the command-line name for a role: lowercase, without the
+// WORKSPACE_ prefix.
+func RoleName(role client.TeamMemberRole) string {
+	return strings.ToLower(strings.TrimPrefix(string(role), "WORKSPACE_"))
+}
+
+// ParseRole maps a role name to its API value. Both the command-line name
+// (viewer) and the API value (WORKSPACE_VIEWER) are accepted, in any case.
+func ParseRole(name string) (client.TeamMemberRole, error) {
+	for _, r := range roles {
+		if strings.EqualFold(name, string(r)) || strings.EqualFold(name, RoleName(r)) {
+			return r, nil
+		}
+	}
+	return "", fmt.Errorf("unknown role %q; must be one of: %s", name, strings.Join(RoleValues(), ", "))
+}
+
+// UpdateRoleInput is the raw command input for changing a member's role.
+type UpdateRoleInput struct {
+	Member string `cli:"arg:0"`
+	Role   string `cli:"arg:1"`
+}
+
+func (in UpdateRoleInput) Validate(_ bool) error {
+	_, err := ParseRole(in.Role)
+	return err
+}
+
+// RemoveInput is the raw command input for removing a member.
+type RemoveInput struct {
+	Member string `cli:"arg:0"`
+}
@@ -0,0 +1,35 @@
+package workspace_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/render-oss/cli/pkg/client"
+	"github.com/render-oss/cli/pkg/types/workspace"
+)
+
+func TestParseRole(t *testing.T) {
+	tests := []struct {
+		in   string
+		want client.TeamMemberRole
+	}{
+		{"admin", client.ADMIN},
+		{"Developer", client.DEVELOPER},
+		{"viewer", client.WORKSPACEVIEWER},
+		{"WORKSPACE_VIEWER", client.WORKSPACEVIEWER},
+		{"workspace_billing", client.WORKSPACEBILLING},
+		{"contributor", client.WORKSPACECONTRIBUTOR},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := workspace.ParseRole(tt.in)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	_, err := workspace.ParseRole("owner")
+	require.ErrorContains(t, err, `unknown role "owner"; must be one of: admin, developer, contributor, viewer, billing`)
+}
@@ -55,3 +55,16 @@ func EnvMapToKVStrings(vars map[string]string) []string {
 	sort.Strings(out)
 	return out
 }
+
+// FormatEnvFile renders vars in .env format, one KEY="VALUE" line per variable
+// sorted by key, so the output round-trips through LoadEnvFiles.
+func FormatEnvFile(vars map[string]string) (string, error) {
+	if len(vars) == 0 {
+		return "", nil
+	}
+	content, err := godotenv.Marshal(vars)
+	if err != nil {
+		return "", err
+	}
+	return content + "\n", nil
+}
@@ -102,3 +102,29 @@ func TestEnvMapToKVStrings(t *testing.T) {
 		})
 	}
 }
+
+func TestFormatEnvFile_RoundTripsThroughLoadEnvFiles(t *testing.T) {
+	vars := map[string]string{
+		"PLAIN":     "value",
+		"SPACES":    "has spaces",
+		"QUOTES":    `say "hi" twice`,
+		"MULTILINE": "line1\nline2",
+		"EMPTY":     "",
+	}
+
+	content, err := FormatEnvFile(vars)
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), ".env")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+
+	loaded, _, err := LoadEnvFiles([]string{path}, true)
+	require.NoError(t, err)
+	assert.Equal(t, vars, loaded)
+}
+
+func TestFormatEnvFile_Empty(t *testing.T) {
+	content, err := FormatEnvFile(nil)
+	require.NoError(t, err)
+	assert.Empty(t, content)
+}
@@ -48,6 +48,21 @@ func IsKeyValueID(s string) bool {
 	return IsObjectID("red", s)
 }
 
+// IsEnvGroupID checks if the string is a valid env group ID (evg-[a-z0-9]{20}).
+func IsEnvGroupID(s string) bool {
+	return IsObjectID("evg", s)
+}
+
+// IsDiskID checks if the string is a valid disk ID (dsk-[a-z0-9]{20}).
+func IsDiskID(s string) bool {
+	return IsObjectID("dsk", s)
+}
+
+// IsWebhookID checks if the string is a valid webhook ID (whk-[a-z0-9]{20}).
+func IsWebhookID(s string) bool {
+	return IsObjectID("whk", s)
+}
+
 // IsPostgresID checks if the string is a valid Postgres ID. Primary Postgres
 // databases and replicas append a lowercase letter suffix to the base xid
 // (for example, dpg-12345678901234567890-a).
@@ -151,6 +151,68 @@ func TestIsEnvironmentID(t *testing.T) {
 	}
 }
 
+func TestIsEnvGroupID(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected bool
+	}{
+		{"valid env group ID", "evg-12345678901234567890", true},
+		{"environment ID rejected", "evm-12345678901234567890", false},
+		{"correct prefix but xid too short rejected", "evg-shared", false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := validate.IsEnvGroupID(tc.input)
+			if got != tc.expected {
+				t.Errorf("IsEnvGroupID(%q) = %v, want %v", tc.input, got, tc.expected)
+			}
+		})
+	}
+}
+
+func TestIsDiskID(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected bool
+	}{
+		{"valid disk ID", "dsk-12345678901234567890", true},
+		{"service ID rejected", "srv-12345678901234567890", false},
+		{"disk name rejected", "data", false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := validate.IsDiskID(tc.input)
+			if got != tc.expected {
+				t.Errorf("IsDiskID(%q) = %v, want %v", tc.input, got, tc.expected)
+			}
+		})
+	}
+}
+
+func TestIsWebhookID(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected bool
+	}{
+		{"valid webhook ID", "whk-12345678901234567890", true},
+		{"webhook name rejected", "deploy-alerts", false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := validate.IsWebhookID(tc.input)
+			if got != tc.expected {
+				t.Errorf("IsWebhookID(%q) = %v, want %v", tc.input, got, tc.expected)
+			}
+		})
+	}
+}
+
 func TestIsPostgresID(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -0,0 +1,246 @@
+package webhook
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// maxDeliveryBytes caps the size of a request body the receiver accepts. Render
+// webhook payloads are small, so larger requests are rejected with 413 rather
+// than forwarded truncated.
+const maxDeliveryBytes = 1 << 20
+
+// forwardTimeout bounds how long the receiver waits for the --forward-to
+// endpoint before reporting the delivery as failed.
+const forwardTimeout = 30 * time.Second
+
+// SignatureStatus reports whether a delivery's signature was checked and
+// whether it matched.
+type SignatureStatus string
+
+const (
+	SignatureUnverified SignatureStatus = "unverified"
+	SignatureValid      SignatureStatus = "valid"
+	SignatureInvalid    SignatureStatus = "invalid"
+)
+
+// Delivery is one request received by the local webhook receiver.
+type Delivery struct {
+	ReceivedAt time.Time       `json:"receivedAt"`
+	Method     string          `json:"method"`
+	Path       string          `json:"path"`
+	EventType  string          `json:"eventType,omitempty"`
+	Headers    http.Header     `json:"headers"`
+	Payload    json.RawMessage `json:"payload,omitempty"`
+	Body       string          `json:"body,omitempty"`
+	Signature  SignatureStatus `json:"signature"`
+	Forward    *ForwardResult  `json:"forward,omitempty"`
+}
+
+// ForwardResult is the outcome of relaying a delivery to the --forward-to URL.
+type ForwardResult struct {
+	URL        string `json:"url"`
+	StatusCode int    `json:"statusCode,omitempty"`
+	DurationMs int64  `json:"durationMs"`
+	Error      string `json:"error,omitempty"`
+}
+
+// ReceiverOptions configures a Receiver.
+type ReceiverOptions struct {
+	// ForwardTo, when set, is the URL each delivery is relayed to. The
+	// receiver answers the sender with the forwarded response.
+	ForwardTo string
+	// Secret, when set, is used to verify each delivery's signature.
+	Secret string
+	// OnDelivery is called once per delivery after it has been forwarded.
+	OnDelivery func(Delivery)
+}
+
+// Receiver is an http.Handler that records webhook deliveries, verifies their
+// signatures, and optionally forwards them to a local endpoint.
+type Receiver struct {
+	forwardTo  *url.URL
+	secret     string
+	onDelivery func(Delivery)
+	client     *http.Client
+	now        func() time.Time
+}
+
+func NewReceiver(opts ReceiverOptions) (*Receiver, error) {
+	r := &Receiver{
+		secret:     opts.Secret,
+		onDelivery: opts.OnDelivery,
+		client:     &http.Client{Timeout: forwardTimeout},
+		now:        time.Now,
+	}
+	if opts.ForwardTo != "" {
+		u, err := url.Parse(opts.ForwardTo)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return nil, fmt.Errorf("--forward-to must be an http or https URL, got %q", opts.ForwardTo)
+		}
+		r.forwardTo = u
+	}
+	return r, nil
+}
+
+func (r *Receiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	body, err := io.ReadAll(io.LimitReader(req.Body, maxDeliveryBytes+1))
+	if err != nil {
+		http.Error(w, "failed to read request body", http.StatusBadRequest)
+		return
+	}
+	if len(body) > maxDeliveryBytes {
+		http.Error(w, fmt.Sprintf("request body exceeds %d bytes", maxDeliveryBytes), http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	d := Delivery{
+		ReceivedAt: r.now(),
+		Method:     req.Method,
+		Path:       req.URL.RequestURI(),
+		Headers:    req.Header.Clone(),
+		Signature:  SignatureUnverified,
+	}
+	if json.Valid(body) {
+		d.Payload = body
+		d.EventType = eventType(body)
+	} else {
+		d.Body = string(body)
+	}
+	if r.secret != "" {
+		d.Signature = SignatureInvalid
+		if VerifySignature(r.secret, req.Header, body) {
+			d.Signature = SignatureValid
+		}
+	}
+
+	status, respBody, respHeader := http.StatusOK, []byte(nil), http.Header(nil)
+	if r.forwardTo != nil {
+		var result ForwardResult
+		status, respBody, respHeader, result = r.forward(req, body)
+		d.Forward = &result
+	}
+
+	if r.onDelivery != nil {
+		r.onDelivery(d)
+	}
+
+	if ct := respHeader.Get("Content-Type"); ct != "" {
+		w.Header().Set("Content-Type", ct)
+	}
+	w.WriteHeader(status)
+	_, _ = w.Write(respBody)
+}
+
+// forward relays a delivery to the --forward-to URL and returns the response
+// to send back to the original sender. Connection failures are answered with
+// 502 so the sender records the delivery as failed.
+func (r *Receiver) forward(req *http.Request, body []byte) (int, []byte, http.Header, ForwardResult) {
+	target := r.forwardURL(req.URL)
+	result := ForwardResult{URL: target}
+	start := r.now()
+
+	out, err := http.NewRequestWithContext(req.Context(), req.Method, target, bytes.NewReader(body))
+	if err != nil {
+		result.Error = err.Error()
+		return http.StatusBadGateway, nil, nil, result
+	}
+	for key, values := range req.Header {
+		if isHopByHopHeader(key) {
+			continue
+		}
+		out.Header[key] = values
+	}
+
+	resp, err := r.client.Do(out)
+	result.DurationMs = r.now().Sub(start).Milliseconds()
+	if err != nil {
+		result.Error = err.Error()
+		return http.StatusBadGateway, nil, nil, result
+	}
+	defer resp.Body.Close()
+
+	respBody, _ := io.ReadAll(io.LimitReader(resp.Body, maxDeliveryBytes))
+	result.StatusCode = resp.StatusCode
+	return resp.StatusCode, respBody, resp.Header, result
+}
+
+// forwardURL appends the path and query a delivery was received on to the
+// --forward-to URL, so a delivery to /deploys?env=dev sent to a receiver
+// forwarding to http://localhost:3000/webhooks is relayed to
+// http://localhost:3000/webhooks/deploys?env=dev.
+func (r *Receiver) forwardURL(received *url.URL) string {
+	u := *r.forwardTo
+	if received.Path != "" && received.Path != "/" {
+		u.Path = strings.TrimSuffix(u.Path, "/") + received.Path
+		u.RawPath = ""
+	}
+	switch {
+	case received.RawQuery == "":
+	case u.RawQuery == "":
+		u.RawQuery = received.RawQuery
+	default:
+		u.RawQuery += "&" + received.RawQuery
+	}
+	return u.String()
+}
+
+// VerifySignature checks a delivery against the signing secret using the
+// Standard Webhooks scheme: an HMAC-SHA256 over "id.timestamp.body", sent
+// base64-encoded in the webhook-signature header as one or more "v1,<sig>"
+// entries.
+func VerifySignature(secret string, header http.Header, body []byte) bool {
+	id, timestamp := header.Get("webhook-id"), header.Get("webhook-timestamp")
+	if id == "" || timestamp == "" {
+		return false
+	}
+
+	key := []byte(secret)
+	if encoded, ok := strings.CutPrefix(secret, "whsec_"); ok {
+		decoded, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return false
+		}
+		key = decoded
+	}
+
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(id + "." + timestamp + "."))
+	mac.Write(body)
+	expected := base64.StdEncoding.EncodeToString(mac.Sum(nil))
+
+	for _, sig := range strings.Fields(header.Get("webhook-signature")) {
+		if v, ok := strings.CutPrefix(sig, "v1,"); ok && hmac.Equal([]byte(v), []byte(expected)) {
+			return true
+		}
+	}
+	return false
+}
+
+// eventType extracts the "type" field Render sets on every webhook payload.
+func eventType(body []byte) string {
+	var payload struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return ""
+	}
+	return payload.Type
+}
+
+func isHopByHopHeader(key string) bool {
+	switch http.CanonicalHeaderKey(key) {
+	case "Connection", "Keep-Alive", "Proxy-Connection", "Te", "Trailer", "Transfer-Encoding", "Upgrade", "Content-Length":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,176 @@
+package webhook_test
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/render-oss/cli/pkg/webhook"
+)
+
+const testSecret = "whsec_c2VjcmV0LWtleQ=="
+
+func sign(t *testing.T, id, timestamp, body string) string {
+	t.Helper()
+	key, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(testSecret, "whsec_"))
+	require.NoError(t, err)
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(id + "." + timestamp + "." + body))
+	return "v1," + base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func newDelivery(t *testing.T, body string, signature string) *http.Request {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodPost, "/hooks?source=render", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("webhook-id", "msg_1")
+	req.Header.Set("webhook-timestamp", "1760000000")
+	req.Header.Set("webhook-signature", signature)
+	return req
+}
+
+func TestVerifySignature(t *testing.T) {
+	body := `{"type":"deploy_ended"}`
+	header := newDelivery(t, body, sign(t, "msg_1", "1760000000", body)).Header
+
+	assert.True(t, webhook.VerifySignature(testSecret, header, []byte(body)))
+	assert.False(t, webhook.VerifySignature(testSecret, header, []byte(`{"type":"tampered"}`)))
+	assert.False(t, webhook.VerifySignature("whsec_b3RoZXI=", header, []byte(body)))
+
+	t.Run("accepts any of several signatures", func(t *testing.T) {
+		h := header.Clone()
+		h.Set("webhook-signature", "v1,bm90LWl0 "+h.Get("webhook-signature"))
+		assert.True(t, webhook.VerifySignature(testSecret, h, []byte(body)))
+	})
+
+	t.Run("rejects missing headers", func(t *testing.T) {
+		h := header.Clone()
+		h.Del("webhook-id")
+		assert.False(t, webhook.VerifySignature(testSecret, h, []byte(body)))
+	})
+}
+
+func TestReceiver(t *testing.T) {
+	body := `{"type":"deploy_ended","data":{"serviceId":"srv-1"}}`
+
+	t.Run("records the delivery and answers 200", func(t *testing.T) {
+		var got []webhook.Delivery
+		r, err := webhook.NewReceiver(webhook.ReceiverOptions{
+			Secret:     testSecret,
+			OnDelivery: func(d webhook.Delivery) { got = append(got, d) },
+		})
+		require.NoError(t, err)
+
+		rec := httptest.NewRecorder()
+		r.ServeHTTP(rec, newDelivery(t, body, sign(t, "msg_1", "1760000000", body)))
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		require.Len(t, got, 1)
+		assert.Equal(t, "/hooks?source=render", got[0].Path)
+		assert.Equal(t, "deploy_ended", got[0].EventType)
+		assert.JSONEq(t, body, string(got[0].Payload))
+		assert.Equal(t, webhook.SignatureValid, got[0].Signature)
+		assert.Nil(t, got[0].Forward)
+	})
+
+	t.Run("flags invalid signatures", func(t *testing.T) {
+		var got webhook.Delivery
+		r, err := webhook.NewReceiver(webhook.ReceiverOptions{
+			Secret:     testSecret,
+			OnDelivery: func(d webhook.Delivery) { got = d },
+		})
+		require.NoError(t, err)
+
+		r.ServeHTTP(httptest.NewRecorder(), newDelivery(t, body, "v1,bm9wZQ=="))
+
+		assert.Equal(t, webhook.SignatureInvalid, got.Signature)
+	})
+
+	t.Run("forwards and relays the response", func(t *testing.T) {
+		var forwarded *http.Request
+		var forwardedBody string
+		app := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			forwarded = r
+			b, _ := io.ReadAll(r.Body)
+			forwardedBody = string(b)
+			w.WriteHeader(http.StatusAccepted)
+			_, _ = w.Write([]byte("queued"))
+		}))
+		defer app.Close()
+
+		var got webhook.Delivery
+		r, err := webhook.NewReceiver(webhook.ReceiverOptions{
+			ForwardTo:  app.URL + "/webhooks",
+			OnDelivery: func(d webhook.Delivery) { got = d },
+		})
+		require.NoError(t, err)
+
+		rec := httptest.NewRecorder()
+		r.ServeHTTP(rec, newDelivery(t, body, "v1,x"))
+
+		assert.Equal(t, http.StatusAccepted, rec.Code)
+		assert.Equal(t, "queued", rec.Body.String())
+		require.NotNil(t, forwarded)
+		assert.Equal(t, "/webhooks/hooks", forwarded.URL.Path)
+		assert.Equal(t, "source=render", forwarded.URL.RawQuery)
+		assert.Equal(t, "msg_1", forwarded.Header.Get("webhook-id"))
+		assert.Equal(t, body, forwardedBody)
+		require.NotNil(t, got.Forward)
+		assert.Equal(t, app.URL+"/webhooks/hooks?source=render", got.Forward.URL)
+		assert.Equal(t, http.StatusAccepted, got.Forward.StatusCode)
+		assert.Empty(t, got.Forward.Error)
+	})
+
+	t.Run("rejects oversized deliveries with 413", func(t *testing.T) {
+		forwarded := false
+		app := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { forwarded = true }))
+		defer app.Close()
+
+		delivered := false
+		r, err := webhook.NewReceiver(webhook.ReceiverOptions{
+			ForwardTo:  app.URL,
+			OnDelivery: func(webhook.Delivery) { delivered = true },
+		})
+		require.NoError(t, err)
+
+		rec := httptest.NewRecorder()
+		r.ServeHTTP(rec, newDelivery(t, strings.Repeat("x", 1<<20+1), "v1,x"))
+
+		assert.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+		assert.False(t, forwarded)
+		assert.False(t, delivered)
+	})
+
+	t.Run("answers 502 when the forward target is down", func(t *testing.T) {
+		app := httptest.NewServer(http.NotFoundHandler())
+		target := app.URL
+		app.Close()
+
+		var got webhook.Delivery
+		r, err := webhook.NewReceiver(webhook.ReceiverOptions{
+			ForwardTo:  target,
+			OnDelivery: func(d webhook.Delivery) { got = d },
+		})
+		require.NoError(t, err)
+
+		rec := httptest.NewRecorder()
+		r.ServeHTTP(rec, newDelivery(t, body, "v1,x"))
+
+		assert.Equal(t, http.StatusBadGateway, rec.Code)
+		require.NotNil(t, got.Forward)
+		assert.NotEmpty(t, got.Forward.Error)
+	})
+
+	t.Run("rejects non-http forward targets", func(t *testing.T) {
+		_, err := webhook.NewReceiver(webhook.ReceiverOptions{ForwardTo: "localhost:3000"})
+		require.ErrorContains(t, err, "--forward-to must be an http or https URL")
+	})
+}
@@ -0,0 +1,35 @@
+package webhook
+
+import (
+	webhooks "github.com/render-oss/cli/pkg/client/webhooks"
+)
+
+type ListOut struct {
+	Data []*webhooks.Webhook `json:"data"`
+}
+
+// ChangeOut is the result of create or update. The signing secret is included
+// so consumers can verify deliveries.
+type ChangeOut struct {
+	Data *webhooks.Webhook `json:"data"`
+}
+
+type DeleteOut struct {
+	Data *webhooks.Webhook `json:"data"`
+	Meta DeleteOutMeta     `json:"meta"`
+}
+
+type DeleteOutMeta struct {
+	Deleted bool   `json:"deleted"`
+	Message string `json:"message,omitempty"`
+}
+
+type EventsOut struct {
+	Data []webhooks.WebhookEvent `json:"data"`
+	Meta EventsOutMeta           `json:"meta"`
+}
+
+type EventsOutMeta struct {
+	WebhookID   string `json:"webhookId"`
+	WebhookName string `json:"webhookName"`
+}
@@ -0,0 +1,138 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/render-oss/cli/pkg/client"
+	webhooks "github.com/render-oss/cli/pkg/client/webhooks"
+	"github.com/render-oss/cli/pkg/config"
+	"github.com/render-oss/cli/pkg/tui"
+	"github.com/render-oss/cli/pkg/validate"
+)
+
+// Repo wraps the generated OpenAPI client for webhook endpoints.
+type Repo struct {
+	client *client.ClientWithResponses
+}
+
+func NewRepo(c *client.ClientWithResponses) *Repo {
+	return &Repo{client: c}
+}
+
+// ListWebhooks returns the webhooks in the active workspace.
+func (r *Repo) ListWebhooks(ctx context.Context) ([]*webhooks.Webhook, error) {
+	workspace, err := config.WorkspaceID()
+	if err != nil {
+		return nil, err
+	}
+
+	params := &client.ListWebhooksParams{OwnerId: &client.OwnerIdParam{workspace}}
+	return client.ListAll(ctx, params, r.listPage)
+}
+
+func (r *Repo) listPage(ctx context.Context, params *client.ListWebhooksParams) ([]*webhooks.Webhook, *client.Cursor, error) {
+	resp, err := r.client.ListWebhooksWithResponse(ctx, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, nil, err
+	}
+	if resp.JSON200 == nil || len(*resp.JSON200) == 0 {
+		return nil, nil, nil
+	}
+
+	res := *resp.JSON200
+	out := make([]*webhooks.Webhook, 0, len(res))
+	for _, w := range res {
+		out = append(out, &w.Webhook)
+	}
+
+	return out, &res[len(res)-1].Cursor, nil
+}
+
+// ResolveWebhook looks up a webhook by ID (whk-...) or exact name in the
+// active workspace.
+func (r *Repo) ResolveWebhook(ctx context.Context, idOrName string) (*webhooks.Webhook, error) {
+	all, err := r.ListWebhooks(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []*webhooks.Webhook
+	for _, w := range all {
+		if w.Id == idOrName || (!validate.IsWebhookID(idOrName) && w.Name == idOrName) {
+			matches = append(matches, w)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, tui.UserFacingError{Message: fmt.Sprintf("webhook '%s' not found in the active workspace", idOrName)}
+	case 1:
+		return matches[0], nil
+	default:
+		return nil, tui.UserFacingError{Message: fmt.Sprintf(
+			"Multiple webhooks found with name '%s'. Pass the webhook ID to disambiguate.", idOrName,
+		)}
+	}
+}
+
+func (r *Repo) CreateWebhook(ctx context.Context, body webhooks.WebhookPOSTInput) (*webhooks.Webhook, error) {
+	if err := validate.WorkspaceMatches(body.OwnerId); err != nil {
+		return nil, err
+	}
+
+	resp, err := r.client.CreateWebhookWithResponse(ctx, body)
+	if err != nil {
+		return nil, err
+	}
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+	return resp.JSON201, nil
+}
+
+// The methods below do not validate workspace ownership, so callers must pass
+// an ID already resolved against the active workspace (see ResolveWebhook).
+
+func (r *Repo) UpdateWebhook(ctx context.Context, id string, body webhooks.WebhookPATCHInput) (*webhooks.Webhook, error) {
+	resp, err := r.client.UpdateWebhookWithResponse(ctx, id, body)
+	if err != nil {
+		return nil, err
+	}
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+	return resp.JSON200, nil
+}
+
+func (r *Repo) DeleteWebhook(ctx context.Context, id string) error {
+	resp, err := r.client.DeleteWebhookWithResponse(ctx, id)
+	if err != nil {
+		return err
+	}
+	return client.ErrorFromResponse(resp)
+}
+
+// ListEvents returns up to params.Limit delivery attempts for a webhook, most
+// recent first. The returned slice is always non-nil.
+func (r *Repo) ListEvents(ctx context.Context, id string, params *client.ListWebhookEventsParams) ([]webhooks.WebhookEvent, error) {
+	resp, err := r.client.ListWebhookEventsWithResponse(ctx, id, params)
+	if err != nil {
+		return nil, err
+	}
+	if err := client.ErrorFromResponse(resp); err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return []webhooks.WebhookEvent{}, nil
+	}
+	out := make([]webhooks.WebhookEvent, 0, len(*resp.JSON200))
+	for _, e := range *resp.JSON200 {
+		out = append(out, e.WebhookEvent)
+	}
+	return out, nil
+}
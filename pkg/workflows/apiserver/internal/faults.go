@@ -0,0 +1,63 @@
+package internal
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/render-oss/cli/pkg/workflows/orchestrator"
+)
+
+// Fault is an injected fault as read and written by the faults endpoints.
+// Delay is a Go duration such as "2s".
+type Fault struct {
+	ID      string `json:"id,omitempty"`
+	Task    string `json:"task"`
+	Kind    string `json:"kind"`
+	Attempt int    `json:"attempt,omitempty"`
+	Delay   string `json:"delay,omitempty"`
+}
+
+func MapFault(fault orchestrator.Fault) Fault {
+	mapped := Fault{
+		ID:      fault.ID,
+		Task:    fault.Task,
+		Kind:    string(fault.Kind),
+		Attempt: fault.Attempt,
+	}
+	if fault.Delay > 0 {
+		mapped.Delay = fault.Delay.String()
+	}
+	return mapped
+}
+
+func ListFaults(faults *orchestrator.Faults) []Fault {
+	list := faults.List()
+	mapped := make([]Fault, len(list))
+	for i, fault := range list {
+		mapped[i] = MapFault(fault)
+	}
+	return mapped
+}
+
+// AddFault validates body and injects it, returning the fault with its
+// assigned ID.
+func AddFault(faults *orchestrator.Faults, body Fault) (Fault, error) {
+	fault := orchestrator.Fault{
+		Task:    body.Task,
+		Kind:    orchestrator.FaultKind(body.Kind),
+		Attempt: body.Attempt,
+	}
+	if body.Delay != "" {
+		delay, err := time.ParseDuration(body.Delay)
+		if err != nil {
+			return Fault{}, fmt.Errorf("invalid fault delay: %w", err)
+		}
+		fault.Delay = delay
+	}
+
+	added, err := faults.Add(fault)
+	if err != nil {
+		return Fault{}, err
+	}
+	return MapFault(added), nil
+}
@@ -0,0 +1,32 @@
+package internal_test
+
+import (
+	"testing"
+
+	"github.com/render-oss/cli/pkg/workflows/apiserver/internal"
+	"github.com/render-oss/cli/pkg/workflows/orchestrator"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddFault(t *testing.T) {
+	faults, err := orchestrator.NewFaults()
+	require.NoError(t, err)
+
+	t.Run("valid fault", func(t *testing.T) {
+		got, err := internal.AddFault(faults, internal.Fault{Task: "sync", Kind: "kill", Attempt: 2, Delay: "1500ms"})
+		require.NoError(t, err)
+		require.Equal(t, internal.Fault{ID: "fault-1", Task: "sync", Kind: "kill", Attempt: 2, Delay: "1.5s"}, got)
+		require.Equal(t, []internal.Fault{got}, internal.ListFaults(faults))
+	})
+
+	t.Run("invalid delay", func(t *testing.T) {
+		_, err := internal.AddFault(faults, internal.Fault{Task: "sync", Kind: "kill", Delay: "soon"})
+		require.ErrorContains(t, err, "invalid fault delay")
+	})
+
+	t.Run("invalid kind", func(t *testing.T) {
+		_, err := internal.AddFault(faults, internal.Fault{Task: "sync", Kind: "explode"})
+		require.ErrorContains(t, err, `unknown fault kind "explode"`)
+		require.Len(t, internal.ListFaults(faults), 1)
+	})
+}
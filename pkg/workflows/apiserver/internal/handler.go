@@ -9,6 +9,7 @@ import (
 	logclient "github.com/render-oss/cli/pkg/client/logs"
 	workflowclient "github.com/render-oss/cli/pkg/client/workflows"
 	"github.com/render-oss/cli/pkg/pointers"
+	"github.com/render-oss/cli/pkg/tasks"
 	"github.com/render-oss/cli/pkg/workflows/apiserver/internal/serversideevents"
 	"github.com/render-oss/cli/pkg/workflows/logs"
 	"github.com/render-oss/cli/pkg/workflows/store"
@@ -42,18 +43,33 @@ func GetTask(store *store.TaskStore, taskID string) *workflowclient.Task {
 	return mapTask(task)
 }
 
-func ListTaskRuns(s *store.TaskStore, taskNameOrID string) []*client.TaskRunWithCursor {
+// TaskRunWithCursor is a listed task run with its pagination cursor. Unlike
+// client.TaskRunWithCursor, it carries the local server's re-run link.
+type TaskRunWithCursor struct {
+	Cursor  client.Cursor `json:"cursor"`
+	TaskRun tasks.TaskRun `json:"taskRun"`
+}
+
+// ListTaskRuns lists the runs of taskNameOrID, or of every task when it is
+// empty. When rootTaskRunIDs are given, only runs belonging to one of those
+// root runs are listed.
+func ListTaskRuns(s *store.TaskStore, taskNameOrID string, rootTaskRunIDs ...string) []*TaskRunWithCursor {
 	var taskRuns []*store.TaskRun
 	if taskNameOrID == "" {
 		taskRuns = s.GetAllTaskRuns()
 	} else {
 		taskRuns = s.GetTaskRuns(taskNameOrID)
 	}
+	if len(rootTaskRunIDs) > 0 {
+		taskRuns = slices.DeleteFunc(taskRuns, func(taskRun *store.TaskRun) bool {
+			return !slices.Contains(rootTaskRunIDs, taskRun.RootTaskRunID)
+		})
+	}
 
-	taskRunList := make([]*client.TaskRunWithCursor, len(taskRuns))
+	taskRunList := make([]*TaskRunWithCursor, len(taskRuns))
 	for i, taskRun := range taskRuns {
 		mapped := MapTaskRun(s, taskRun)
-		taskRunList[i] = &client.TaskRunWithCursor{
+		taskRunList[i] = &TaskRunWithCursor{
 			TaskRun: *mapped,
 			Cursor:  taskRun.ID,
 		}
@@ -62,7 +78,7 @@ func ListTaskRuns(s *store.TaskStore, taskNameOrID string) []*client.TaskRunWith
 	return taskRunList
 }
 
-func GetTaskRun(store *store.TaskStore, taskRunID string) *workflowclient.TaskRunDetails {
+func GetTaskRun(store *store.TaskStore, taskRunID string) *tasks.TaskRunDetails {
 	taskRun := store.GetTaskRun(taskRunID)
 
 	return mapTaskRunDetails(store, taskRun)
@@ -74,28 +90,29 @@ func ListLogs(logStore *logs.LogStore, input client.ListLogsParams) []logclient.
 	return mapLogs(logs)
 }
 
-func sendResultToChannel(s *store.TaskStore, taskRun *store.TaskRun, outputCh chan serversideevents.Message[workflowclient.TaskRunDetails], taskRunIDs []string) {
+func sendResultToChannel(ctx context.Context, s *store.TaskStore, taskRun *store.TaskRun, outputCh chan serversideevents.Message[tasks.TaskRunDetails], taskRunIDs []string) {
 	taskRunDetails := mapTaskRunDetails(s, taskRun)
 	if taskRunDetails == nil || !slices.Contains(taskRunIDs, taskRunDetails.Id) {
 		return
 	}
-	outputCh <- serversideevents.Message[workflowclient.TaskRunDetails]{
+	// The SSE handler stops receiving once the client disconnects.
+	select {
+	case outputCh <- serversideevents.Message[tasks.TaskRunDetails]{
 		Event: pointers.From("task.completed"),
 		Data:  *taskRunDetails,
+	}:
+	case <-ctx.Done():
 	}
 }
 
-func GetTaskRunEvents(ctx context.Context, s *store.TaskStore, taskRunIDs []string) (chan serversideevents.Message[workflowclient.TaskRunDetails], error) {
+func GetTaskRunEvents(ctx context.Context, s *store.TaskStore, taskRunIDs []string) (chan serversideevents.Message[tasks.TaskRunDetails], error) {
 	ch := make(chan *store.TaskRun)
 	s.AddTaskRunChan(ch)
 
-	outputCh := make(chan serversideevents.Message[workflowclient.TaskRunDetails])
+	outputCh := make(chan serversideevents.Message[tasks.TaskRunDetails])
 
 	go func() {
-		defer func() {
-			s.RemoveTaskRunChan(ch)
-			close(ch)
-		}()
+		defer s.RemoveTaskRunChan(ch)
 
 		// Send existing task run events
 		for _, taskRunID := range taskRunIDs {
@@ -103,7 +120,7 @@ func GetTaskRunEvents(ctx context.Context, s *store.TaskStore, taskRunIDs []stri
 			if taskRun == nil || (taskRun.Status != store.TaskRunStatusComplete && taskRun.Status != store.TaskRunStatusFailed) {
 				continue
 			}
-			sendResultToChannel(s, taskRun, outputCh, taskRunIDs)
+			sendResultToChannel(ctx, s, taskRun, outputCh, taskRunIDs)
 		}
 
 		// Send subsequent task run events
@@ -112,7 +129,7 @@ func GetTaskRunEvents(ctx context.Context, s *store.TaskStore, taskRunIDs []stri
 			case <-ctx.Done():
 				return
 			case taskRun := <-ch:
-				sendResultToChannel(s, taskRun, outputCh, taskRunIDs)
+				sendResultToChannel(ctx, s, taskRun, outputCh, taskRunIDs)
 			}
 		}
 	}()
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"testing"
+	"time"
 
 	workflowclient "github.com/render-oss/cli/pkg/client/workflows"
 	"github.com/render-oss/cli/pkg/workflows/apiserver/internal"
@@ -105,6 +106,30 @@ func TestGetTaskRunDetailsAttempts(t *testing.T) {
 	require.Equal(t, []interface{}{"def"}, *got.Attempts[0].Results)
 }
 
+func TestMapTaskRunRerunOf(t *testing.T) {
+	s := store.NewTaskStore()
+	s.SetTasks([]taskserver.Task{{Name: "test"}})
+	original := s.StartTaskRun("test", []byte(`[1]`), nil)
+	rerun := s.StartRerunTaskRun(original, original.Input)
+
+	t.Run("original run has no link", func(t *testing.T) {
+		require.Nil(t, internal.MapTaskRun(s, original).RerunOfTaskRunId)
+		require.Nil(t, internal.GetTaskRun(s, original.ID).RerunOfTaskRunId)
+	})
+
+	t.Run("re-run links to the original", func(t *testing.T) {
+		require.Equal(t, &original.ID, internal.MapTaskRun(s, rerun).RerunOfTaskRunId)
+		require.Equal(t, &original.ID, internal.GetTaskRun(s, rerun.ID).RerunOfTaskRunId)
+
+		body, err := json.Marshal(internal.GetTaskRun(s, rerun.ID))
+		require.NoError(t, err)
+		var decoded map[string]any
+		require.NoError(t, json.Unmarshal(body, &decoded))
+		require.Equal(t, original.ID, decoded["rerunOfTaskRunId"])
+		require.Equal(t, rerun.ID, decoded["id"])
+	})
+}
+
 func TestListTaskRuns(t *testing.T) {
 	t.Run("by task ID", func(t *testing.T) {
 		store := store.NewTaskStore()
@@ -153,6 +178,41 @@ func TestListTaskRuns(t *testing.T) {
 
 		require.Equal(t, 2, len(got))
 	})
+
+	t.Run("by root task run ID", func(t *testing.T) {
+		store := store.NewTaskStore()
+
+		store.SetTasks([]taskserver.Task{
+			{Name: "parent"},
+			{Name: "child"},
+		})
+
+		root := store.StartTaskRun("parent", []byte("abc"), nil)
+		child := store.StartTaskRun("child", []byte("def"), &root.ID)
+		store.StartTaskRun("parent", []byte("ghi"), nil)
+
+		got := internal.ListTaskRuns(store, "", root.ID)
+
+		require.Equal(t, 2, len(got))
+		ids := []string{got[0].TaskRun.Id, got[1].TaskRun.Id}
+		require.ElementsMatch(t, []string{root.ID, child.ID}, ids)
+	})
+
+	t.Run("task runs whose task is no longer registered", func(t *testing.T) {
+		store := store.NewTaskStore()
+
+		store.SetTasks([]taskserver.Task{
+			{Name: "removed"},
+		})
+		run := store.StartTaskRun("removed", []byte("abc"), nil)
+		store.SetTasks(nil)
+
+		got := internal.ListTaskRuns(store, "")
+
+		require.Equal(t, 1, len(got))
+		require.Equal(t, "", got[0].TaskRun.TaskId)
+		require.Equal(t, run.ID, internal.GetTaskRun(store, run.ID).Id)
+	})
 }
 
 func TestGetTaskRun(t *testing.T) {
@@ -235,4 +295,31 @@ func TestGetTaskRunEvents(t *testing.T) {
 		require.NotNil(t, result.Data.CompletedAt)
 		require.Equal(t, []interface{}{"result"}, result.Data.Results)
 	})
+
+	t.Run("a disconnected subscriber doesn't block the store", func(t *testing.T) {
+		store := store.NewTaskStore()
+		store.SetTasks([]taskserver.Task{{Name: "test"}})
+
+		first := store.StartTaskRun("test", []byte("test"), nil)
+		second := store.StartTaskRun("test", []byte("test"), nil)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		_, err := internal.GetTaskRunEvents(ctx, store, []string{first.ID, second.ID})
+		require.NoError(t, err)
+
+		// Nobody receives the events, as when the client has gone away.
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			store.CompleteTaskRun(first.ID, result)
+			store.CompleteTaskRun(second.ID, result)
+		}()
+		cancel()
+
+		select {
+		case <-done:
+		case <-time.After(5 * time.Second):
+			t.Fatal("completing a task run blocked on a disconnected subscriber")
+		}
+	})
 }
@@ -12,6 +12,7 @@ import (
 	logClient "github.com/render-oss/cli/pkg/client/logs"
 	workflowClient "github.com/render-oss/cli/pkg/client/workflows"
 	"github.com/render-oss/cli/pkg/pointers"
+	"github.com/render-oss/cli/pkg/tasks"
 	"github.com/render-oss/cli/pkg/workflows/logs"
 	"github.com/render-oss/cli/pkg/workflows/store"
 )
@@ -109,24 +110,32 @@ func mapTaskAttemptDetails(taskRun *store.TaskRun) []workflowClient.TaskAttemptD
 	return details
 }
 
-func MapTaskRun(store *store.TaskStore, taskRun *store.TaskRun) *workflowClient.TaskRun {
-	task := store.GetTaskByName(taskRun.TaskName)
-
-	return &workflowClient.TaskRun{
-		Id:              taskRun.ID,
-		TaskId:          task.ID,
-		Status:          mapTaskRunStatus(taskRun.Status),
-		StartedAt:       taskRun.StartedAt,
-		CompletedAt:     taskRun.CompletedAt,
-		ParentTaskRunId: parentTaskRunID(taskRun),
-		RootTaskRunId:   taskRun.RootTaskRunID,
-		Attempts:        mapTaskAttempt(taskRun),
+// taskIDForRun returns the ID of the task a run belongs to, or "" if that task
+// is no longer registered, e.g. for runs reloaded from a previous session.
+func taskIDForRun(store *store.TaskStore, taskRun *store.TaskRun) string {
+	if task := store.GetTaskByName(taskRun.TaskName); task != nil {
+		return task.ID
 	}
+	return ""
 }
 
-func mapTaskRunDetails(store *store.TaskStore, taskRun *store.TaskRun) *workflowClient.TaskRunDetails {
-	task := store.GetTaskByName(taskRun.TaskName)
+func MapTaskRun(store *store.TaskStore, taskRun *store.TaskRun) *tasks.TaskRun {
+	return &tasks.TaskRun{
+		TaskRun: workflowClient.TaskRun{
+			Id:              taskRun.ID,
+			TaskId:          taskIDForRun(store, taskRun),
+			Status:          mapTaskRunStatus(taskRun.Status),
+			StartedAt:       taskRun.StartedAt,
+			CompletedAt:     taskRun.CompletedAt,
+			ParentTaskRunId: parentTaskRunID(taskRun),
+			RootTaskRunId:   taskRun.RootTaskRunID,
+			Attempts:        mapTaskAttempt(taskRun),
+		},
+		RerunOfTaskRunId: taskRun.RerunOfTaskRunID,
+	}
+}
 
+func mapTaskRunDetails(store *store.TaskStore, taskRun *store.TaskRun) *tasks.TaskRunDetails {
 	var results workflowClient.TaskRunResult
 	// ignore error, we will just return empty results
 	_ = json.Unmarshal(taskRun.Output, &results)
@@ -134,18 +143,21 @@ func mapTaskRunDetails(store *store.TaskStore, taskRun *store.TaskRun) *workflow
 	var input workflowClient.TaskData
 	_ = json.Unmarshal(taskRun.Input, &input)
 
-	return &workflowClient.TaskRunDetails{
-		Id:              taskRun.ID,
-		TaskId:          task.ID,
-		Status:          mapTaskRunStatus(taskRun.Status),
-		StartedAt:       taskRun.StartedAt,
-		CompletedAt:     taskRun.CompletedAt,
-		Results:         results,
-		Input:           input,
-		Error:           taskRun.Error,
-		ParentTaskRunId: parentTaskRunID(taskRun),
-		RootTaskRunId:   taskRun.RootTaskRunID,
-		Attempts:        mapTaskAttemptDetails(taskRun),
+	return &tasks.TaskRunDetails{
+		TaskRunDetails: workflowClient.TaskRunDetails{
+			Id:              taskRun.ID,
+			TaskId:          taskIDForRun(store, taskRun),
+			Status:          mapTaskRunStatus(taskRun.Status),
+			StartedAt:       taskRun.StartedAt,
+			CompletedAt:     taskRun.CompletedAt,
+			Results:         results,
+			Input:           input,
+			Error:           taskRun.Error,
+			ParentTaskRunId: parentTaskRunID(taskRun),
+			RootTaskRunId:   taskRun.RootTaskRunID,
+			Attempts:        mapTaskAttemptDetails(taskRun),
+		},
+		RerunOfTaskRunId: taskRun.RerunOfTaskRunID,
 	}
 }
 
@@ -6,6 +6,7 @@ import (
 	"log"
 	"net"
 	"net/http"
+	"strconv"
 	"strings"
 	"time"
 
@@ -14,11 +15,13 @@ import (
 	"github.com/render-oss/cli/pkg/client"
 	workflows "github.com/render-oss/cli/pkg/client/workflows"
 	"github.com/render-oss/cli/pkg/pointers"
+	"github.com/render-oss/cli/pkg/tasks"
 	"github.com/render-oss/cli/pkg/workflows/apiserver/internal"
 	"github.com/render-oss/cli/pkg/workflows/apiserver/internal/serversideevents"
 	"github.com/render-oss/cli/pkg/workflows/logs"
 	"github.com/render-oss/cli/pkg/workflows/orchestrator"
 	"github.com/render-oss/cli/pkg/workflows/store"
+	"github.com/render-oss/cli/pkg/workflows/webui"
 )
 
 type ServerHandler struct {
@@ -55,12 +58,19 @@ func Start(handler *ServerHandler, port int) (*http.Server, error) {
 			r.Route("/{taskRunID}", func(r chi.Router) {
 				r.Get("/", handler.GetTaskRun)
 				r.Delete("/", handler.CancelTaskRun)
+				r.Post("/rerun", handler.RerunTaskRun)
 			})
 			r.Get("/", handler.ListTaskRuns)
 			r.Route("/events", func(r chi.Router) {
 				r.Get("/", handler.TaskEvents)
 			})
 		})
+		r.Route("/faults", func(r chi.Router) {
+			r.Get("/", handler.ListFaults)
+			r.Post("/", handler.AddFault)
+			r.Delete("/", handler.ClearFaults)
+			r.Delete("/{faultID}", handler.RemoveFault)
+		})
 		r.Route("/logs", func(r chi.Router) {
 			r.Route("/subscribe", func(r chi.Router) {
 				r.Get("/", handler.SubscribeLogs)
@@ -68,6 +78,7 @@ func Start(handler *ServerHandler, port int) (*http.Server, error) {
 			r.Get("/", handler.GetLogs)
 		})
 	})
+	mux.Handle("/*", webui.Handler())
 
 	listener, err := net.Listen("tcp", fmt.Sprintf("localhost:%d", port))
 	if err != nil {
@@ -172,11 +183,56 @@ func (h *ServerHandler) RunTask(w http.ResponseWriter, r *http.Request) {
 	json.NewEncoder(w).Encode(taskRun)
 }
 
+func (h *ServerHandler) RerunTaskRun(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	taskRunID := chi.URLParam(r, "taskRunID")
+
+	var body tasks.RerunTaskRunBody
+	if r.ContentLength != 0 {
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			handleError(w, err, http.StatusBadRequest)
+			return
+		}
+	}
+
+	var inputJSON []byte
+	if body.Input != nil {
+		var err error
+		inputJSON, err = json.Marshal(body.Input)
+		if err != nil {
+			handleError(w, err, http.StatusBadRequest)
+			return
+		}
+	}
+
+	run, err := h.coordinator.RerunTask(r.Context(), taskRunID, inputJSON)
+	if err != nil {
+		if _, ok := err.(*orchestrator.TaskNotFoundError); ok {
+			handleError(w, err, http.StatusNotFound)
+			return
+		}
+
+		handleError(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+	json.NewEncoder(w).Encode(internal.MapTaskRun(h.taskStore, run))
+}
+
 func (h *ServerHandler) ListTaskRuns(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json")
+	// Runs waiting for a concurrency slot are listed as pending; the header
+	// reports how many of them there are across all tasks.
+	w.Header().Set("X-Queue-Depth", strconv.Itoa(h.coordinator.QueueDepth()))
 
 	taskName := r.URL.Query().Get("taskSlug")
-	json.NewEncoder(w).Encode(internal.ListTaskRuns(h.taskStore, taskName))
+	var rootTaskRunIDs []string
+	for _, value := range r.URL.Query()["rootTaskRunId"] {
+		rootTaskRunIDs = append(rootTaskRunIDs, strings.Split(value, ",")...)
+	}
+	json.NewEncoder(w).Encode(internal.ListTaskRuns(h.taskStore, taskName, rootTaskRunIDs...))
 }
 
 func (h *ServerHandler) CancelTaskRun(w http.ResponseWriter, r *http.Request) {
@@ -209,6 +265,48 @@ func (h *ServerHandler) GetTaskRun(w http.ResponseWriter, r *http.Request) {
 	json.NewEncoder(w).Encode(taskRun)
 }
 
+func (h *ServerHandler) ListFaults(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	json.NewEncoder(w).Encode(internal.ListFaults(h.coordinator.Faults()))
+}
+
+func (h *ServerHandler) AddFault(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	var body internal.Fault
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		handleError(w, err, http.StatusBadRequest)
+		return
+	}
+
+	fault, err := internal.AddFault(h.coordinator.Faults(), body)
+	if err != nil {
+		handleError(w, err, http.StatusBadRequest)
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(fault)
+}
+
+func (h *ServerHandler) RemoveFault(w http.ResponseWriter, r *http.Request) {
+	faultID := chi.URLParam(r, "faultID")
+
+	if !h.coordinator.Faults().Remove(faultID) {
+		handleError(w, fmt.Errorf("fault not found"), http.StatusNotFound)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *ServerHandler) ClearFaults(w http.ResponseWriter, r *http.Request) {
+	h.coordinator.Faults().Clear()
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
 func (h *ServerHandler) SubscribeLogs(w http.ResponseWriter, r *http.Request) {
 	input, err := internal.ParseLogSearchQueryParams(r)
 	if err != nil {
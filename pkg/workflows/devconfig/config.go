@@ -0,0 +1,104 @@
+// Package devconfig reads the workflow config file that lets
+// `render workflows dev` host several task sources, such as a Python and a
+// TypeScript service, in one session.
+package devconfig
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Config lists the task sources of a local workflow.
+type Config struct {
+	Sources []Source `yaml:"sources"`
+}
+
+// Source is a named command that serves workflow tasks. Each source
+// registers its own tasks, and runs of a task are started with the command
+// of the source that registered it. The command is run directly, not through
+// a shell; see Command for how a string command is split.
+type Source struct {
+	Name    string  `yaml:"name"`
+	Command Command `yaml:"command"`
+	// Dir is the directory the command runs in. Relative paths are resolved
+	// against the config file's directory, which is also the default.
+	Dir string `yaml:"dir"`
+	// Env holds environment variables set for this source only. They
+	// override values loaded from env files.
+	Env map[string]string `yaml:"env"`
+}
+
+// Command is a program and its arguments. In YAML it is either a list, or a
+// string that is split on whitespace. The string form has no shell quoting,
+// so a string containing quotes or backslashes is rejected; use the list form
+// for arguments with spaces, such as [python, -c, "print(1)"].
+type Command []string
+
+func (c *Command) UnmarshalYAML(value *yaml.Node) error {
+	if value.Kind == yaml.ScalarNode {
+		if strings.ContainsAny(value.Value, `"'\`) {
+			return fmt.Errorf("line %d: command %q contains quotes or backslashes, which aren't supported in a string command; write it as a list, e.g. [python, -c, \"print(1)\"]", value.Line, value.Value)
+		}
+		*c = strings.Fields(value.Value)
+		return nil
+	}
+	var args []string
+	if err := value.Decode(&args); err != nil {
+		return errors.New("command must be a string or a list of strings")
+	}
+	*c = args
+	return nil
+}
+
+func (c Command) String() string {
+	return strings.Join(c, " ")
+}
+
+// Load reads and validates the config in path.
+func Load(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read workflow config: %w", err)
+	}
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return nil, err
+	}
+	return Parse(data, filepath.Dir(absPath))
+}
+
+// Parse parses and validates a config, resolving source directories against
+// baseDir.
+func Parse(data []byte, baseDir string) (*Config, error) {
+	var config Config
+	if err := yaml.Unmarshal(data, &config); err != nil {
+		return nil, fmt.Errorf("failed to parse workflow config: %w", err)
+	}
+	if len(config.Sources) == 0 {
+		return nil, errors.New("workflow config has no sources")
+	}
+
+	names := map[string]bool{}
+	for i := range config.Sources {
+		source := &config.Sources[i]
+		if source.Name == "" {
+			return nil, fmt.Errorf("source %d: name is required", i+1)
+		}
+		if names[source.Name] {
+			return nil, fmt.Errorf("source %d: duplicate name %q", i+1, source.Name)
+		}
+		names[source.Name] = true
+		if len(source.Command) == 0 {
+			return nil, fmt.Errorf("source %q: command is required", source.Name)
+		}
+		if !filepath.IsAbs(source.Dir) {
+			source.Dir = filepath.Join(baseDir, source.Dir)
+		}
+	}
+	return &config, nil
+}
@@ -0,0 +1,87 @@
+package devconfig_test
+
+import (
+	"testing"
+
+	"github.com/render-oss/cli/pkg/workflows/devconfig"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	t.Run("sources", func(t *testing.T) {
+		config, err := devconfig.Parse([]byte(`
+sources:
+  - name: python
+    command: python main.py
+    dir: py
+    env:
+      LOG_LEVEL: debug
+  - name: node
+    command: [npx, tsx, "src/main.ts"]
+    dir: /srv/node
+`), "/work")
+		require.NoError(t, err)
+		require.Equal(t, []devconfig.Source{
+			{
+				Name:    "python",
+				Command: devconfig.Command{"python", "main.py"},
+				Dir:     "/work/py",
+				Env:     map[string]string{"LOG_LEVEL": "debug"},
+			},
+			{
+				Name:    "node",
+				Command: devconfig.Command{"npx", "tsx", "src/main.ts"},
+				Dir:     "/srv/node",
+			},
+		}, config.Sources)
+	})
+
+	t.Run("dir defaults to the config directory", func(t *testing.T) {
+		config, err := devconfig.Parse([]byte("sources:\n  - name: python\n    command: python main.py\n"), "/work")
+		require.NoError(t, err)
+		require.Equal(t, "/work", config.Sources[0].Dir)
+	})
+
+	tests := []struct {
+		name    string
+		config  string
+		wantErr string
+	}{
+		{
+			name:    "no sources",
+			config:  "sources: []",
+			wantErr: "workflow config has no sources",
+		},
+		{
+			name:    "missing name",
+			config:  "sources:\n  - command: python main.py\n",
+			wantErr: "source 1: name is required",
+		},
+		{
+			name:    "duplicate name",
+			config:  "sources:\n  - name: py\n    command: python a.py\n  - name: py\n    command: python b.py\n",
+			wantErr: `source 2: duplicate name "py"`,
+		},
+		{
+			name:    "missing command",
+			config:  "sources:\n  - name: py\n",
+			wantErr: `source "py": command is required`,
+		},
+		{
+			name:    "quoted string command",
+			config:  "sources:\n  - name: py\n    command: python -c \"print(1)\"\n",
+			wantErr: `failed to parse workflow config: line 3: command "python -c \"print(1)\"" contains quotes or backslashes, which aren't supported in a string command; write it as a list, e.g. [python, -c, "print(1)"]`,
+		},
+		{
+			name:    "invalid command",
+			config:  "sources:\n  - name: py\n    command: {run: python}\n",
+			wantErr: "failed to parse workflow config: command must be a string or a list of strings",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := devconfig.Parse([]byte(tt.config), "/work")
+			require.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"os"
 	"regexp"
 	"slices"
 	"strings"
@@ -76,6 +77,11 @@ type LogStore struct {
 	logChan      chan *Log
 	readChanLock sync.Mutex
 	readChans    []*LogStreamer
+
+	// file receives every log when the store is persistent; nil otherwise.
+	// fileMu guards it, since Close can run while Start is persisting a log.
+	fileMu sync.Mutex
+	file   *os.File
 }
 
 func (l *LogStore) AddLog(log *Log) {
@@ -144,6 +150,7 @@ func (l *LogStore) Start(ctx context.Context) {
 				return
 			case log := <-l.logChan:
 				l.sendLogs(log)
+				l.persist(log)
 				l.logs = append(l.logs, log)
 			}
 		}
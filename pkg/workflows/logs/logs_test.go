@@ -1,8 +1,13 @@
 package logs_test
 
 import (
+	"bufio"
+	"bytes"
 	"context"
+	"encoding/json"
+	"fmt"
 	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -128,3 +133,94 @@ func TestRemoveLogChan(t *testing.T) {
 		require.Equal(t, "Hello, world!", (<-ch2).Message)
 	})
 }
+
+func TestPersistentLogStore(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	dir := t.TempDir()
+
+	ls, err := logs.NewPersistentLogStore(dir)
+	require.NoError(t, err)
+	ls.Start(ctx)
+
+	interceptor := logs.NewLogInterceptor("run-1", nil, ls)
+	interceptor.Write([]byte("first\n"))
+	interceptor.Write([]byte("second\n"))
+
+	require.Eventually(t, func() bool {
+		return len(ls.GetLogs(logs.LogSearch{})) == 2
+	}, time.Second*3, time.Millisecond*10)
+	cancel()
+	require.NoError(t, ls.Close())
+
+	reloaded, err := logs.NewPersistentLogStore(dir)
+	require.NoError(t, err)
+	defer reloaded.Close()
+
+	got := reloaded.GetLogs(logs.LogSearch{TaskRunID: []string{"run-1"}})
+	require.Len(t, got, 2)
+	require.Equal(t, "first\n", got[0].Message)
+	require.Equal(t, "second\n", got[1].Message)
+}
+
+func TestPersistentLogStoreCompactsOnStartup(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "logs.jsonl")
+
+	var buf bytes.Buffer
+	for i := range logs.MaxPersistedLogs + 5 {
+		data, err := json.Marshal(logs.Log{TaskRunID: "run-1", Message: fmt.Sprintf("line %d", i)})
+		require.NoError(t, err)
+		buf.Write(append(data, '\n'))
+	}
+	buf.WriteString(`{"TaskRunID":"run-1","Mess`)
+	require.NoError(t, os.WriteFile(path, buf.Bytes(), 0o644))
+
+	ls, err := logs.NewPersistentLogStore(dir)
+	require.NoError(t, err)
+	defer ls.Close()
+
+	got := ls.GetLogs(logs.LogSearch{})
+	require.Len(t, got, logs.MaxPersistedLogs)
+	require.Equal(t, "line 5", got[0].Message)
+
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	defer file.Close()
+	lines := 0
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		lines++
+	}
+	require.NoError(t, scanner.Err())
+	require.Equal(t, logs.MaxPersistedLogs, lines)
+}
+
+func TestPersistentLogStoreCloseWhileLogging(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	dir := t.TempDir()
+	ls, err := logs.NewPersistentLogStore(dir)
+	require.NoError(t, err)
+	ls.Start(ctx)
+
+	interceptor := logs.NewLogInterceptor("run-1", nil, ls)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for range 100 {
+			interceptor.Write([]byte("line\n"))
+		}
+	}()
+
+	// Close once some logs are on disk, while the rest are still arriving.
+	require.Eventually(t, func() bool {
+		info, err := os.Stat(filepath.Join(dir, "logs.jsonl"))
+		return err == nil && info.Size() > 0
+	}, time.Second*3, time.Millisecond)
+	require.NoError(t, ls.Close())
+	<-done
+	require.NoError(t, ls.Close())
+}
@@ -0,0 +1,130 @@
+package logs
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+const logsFileName = "logs.jsonl"
+
+// MaxPersistedLogs is how many of the most recent logs a persistent store
+// keeps when it starts. Older logs are dropped from the log file.
+const MaxPersistedLogs = 100_000
+
+// NewPersistentLogStore returns a LogStore that appends every log to a JSONL
+// file in stateDir and reloads previously written logs on startup. The file
+// is compacted on startup to the most recent MaxPersistedLogs logs.
+func NewPersistentLogStore(stateDir string) (*LogStore, error) {
+	if err := os.MkdirAll(stateDir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create state directory: %w", err)
+	}
+
+	path := filepath.Join(stateDir, logsFileName)
+	previous, err := loadLogs(path)
+	if err != nil {
+		return nil, err
+	}
+	if len(previous) > MaxPersistedLogs {
+		previous = previous[len(previous)-MaxPersistedLogs:]
+	}
+
+	if err := writeLogs(path, previous); err != nil {
+		return nil, err
+	}
+
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open log file: %w", err)
+	}
+
+	l := NewLogStore()
+	l.logs = previous
+	l.file = file
+	return l, nil
+}
+
+func loadLogs(path string) (Logs, error) {
+	file, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return Logs{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read logs: %w", err)
+	}
+	defer file.Close()
+
+	logs := Logs{}
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		var log Log
+		// A partially written last line is expected if the server was
+		// killed mid-write; skip it rather than refusing to start.
+		if err := json.Unmarshal(scanner.Bytes(), &log); err != nil {
+			continue
+		}
+		logs = append(logs, &log)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read logs: %w", err)
+	}
+	return logs, nil
+}
+
+// writeLogs replaces the log file with logs.
+func writeLogs(path string, logs Logs) error {
+	var buf bytes.Buffer
+	for _, log := range logs {
+		data, err := json.Marshal(log)
+		if err != nil {
+			return fmt.Errorf("failed to encode logs: %w", err)
+		}
+		buf.Write(append(data, '\n'))
+	}
+
+	// Write to a temporary file and rename it so a crash mid-write never
+	// leaves a truncated log file behind.
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, buf.Bytes(), 0o644); err != nil {
+		return fmt.Errorf("failed to write logs: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return fmt.Errorf("failed to write logs: %w", err)
+	}
+	return nil
+}
+
+// persist appends log to the log file. It is a no-op for in-memory stores and
+// after Close.
+func (l *LogStore) persist(log *Log) {
+	l.fileMu.Lock()
+	defer l.fileMu.Unlock()
+	if l.file == nil {
+		return
+	}
+	data, err := json.Marshal(log)
+	if err == nil {
+		_, err = l.file.Write(append(data, '\n'))
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "warning: failed to write log: %v\n", err)
+	}
+}
+
+// Close closes the log file of a persistent store. Logs that arrive after
+// Close are kept in memory only. It is a no-op for in-memory stores.
+func (l *LogStore) Close() error {
+	l.fileMu.Lock()
+	defer l.fileMu.Unlock()
+	if l.file == nil {
+		return nil
+	}
+	err := l.file.Close()
+	l.file = nil
+	return err
+}
@@ -2,8 +2,10 @@ package orchestrator
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net"
+	"slices"
 	"sync"
 	"time"
 
@@ -25,6 +27,9 @@ func (e *TaskNotFoundError) Error() string {
 
 type StatusReporter interface {
 	TaskEnqueued(taskRun *store.TaskRun)
+	// TaskQueued reports a task run waiting for a concurrency slot at the
+	// given 1-indexed position in the queue.
+	TaskQueued(taskRun *store.TaskRun, position int)
 	TaskRunning(taskRun *store.TaskRun)
 	TaskCompleted(taskRun *store.TaskRun)
 	TaskFailed(taskRun *store.TaskRun)
@@ -33,8 +38,13 @@ type StatusReporter interface {
 }
 
 type Coordinator struct {
-	store   *store.TaskStore
-	sdkExec sdkExec
+	store *store.TaskStore
+
+	// sources are the processes that serve tasks. taskSources maps each
+	// registered task name to the index of the source that registered it.
+	sources     []Source
+	taskSources map[string]int
+	sourcesMu   sync.Mutex
 
 	callbackURL string
 
@@ -46,6 +56,80 @@ type Coordinator struct {
 
 	activeRuns   map[string]*activeRunEntry
 	activeRunsMu sync.Mutex
+
+	// maxConcurrency and taskConcurrency cap the number of task runs that can
+	// execute at once, globally and per task name. Zero means no limit. Runs
+	// over the limit wait in queue, in TaskRunStatusPending, until a slot
+	// frees up. A run waiting on an unfinished subtask gives up its slot, so
+	// a parent can't starve its own subtasks, and is tracked in suspended
+	// until it takes a slot back to receive the result. Suspended runs whose
+	// result is ready are listed in resumable, and take freed slots ahead of
+	// the queue so a steady stream of new runs can't starve them.
+	maxConcurrency  int
+	taskConcurrency map[string]int
+	queue           []*store.TaskRun
+	running         int
+	runningByTask   map[string]int
+	suspended       map[string]string
+	resumable       []string
+	queueMu         sync.Mutex
+
+	// defaultTimeout bounds attempts of tasks that don't register their own
+	// timeout. Zero means no limit.
+	defaultTimeout time.Duration
+
+	faults *Faults
+}
+
+type CoordinatorOption func(*Coordinator)
+
+// WithMaxConcurrency limits how many task runs execute at once across all
+// tasks. Zero means no limit.
+func WithMaxConcurrency(n int) CoordinatorOption {
+	return func(c *Coordinator) {
+		c.maxConcurrency = n
+	}
+}
+
+// WithDefaultTimeout sets the maximum run time of a single attempt for tasks
+// that don't register a timeout of their own. Zero means no limit.
+func WithDefaultTimeout(timeout time.Duration) CoordinatorOption {
+	return func(c *Coordinator) {
+		c.defaultTimeout = timeout
+	}
+}
+
+// WithTaskConcurrency limits how many runs of each named task execute at
+// once. Tasks without an entry are only subject to the global limit.
+func WithTaskConcurrency(limits map[string]int) CoordinatorOption {
+	return func(c *Coordinator) {
+		c.taskConcurrency = limits
+	}
+}
+
+// Source is a named process type that serves workflow tasks, such as a
+// Python or a TypeScript service.
+type Source struct {
+	Name string
+	Exec sdkExec
+}
+
+// WithSources hosts tasks from several sources instead of the single sdkExec
+// passed to NewCoordinator. Every source registers its own tasks, and runs of
+// a task are started by the source that registered it. Task names must be
+// unique across sources.
+func WithSources(sources ...Source) CoordinatorOption {
+	return func(c *Coordinator) {
+		c.sources = sources
+	}
+}
+
+// WithFaults injects the given faults into task runs. Faults can still be
+// added and removed through Faults afterwards.
+func WithFaults(faults *Faults) CoordinatorOption {
+	return func(c *Coordinator) {
+		c.faults = faults
+	}
 }
 
 type activeRunEntry struct {
@@ -66,26 +150,88 @@ type serverFactory interface {
 	) *taskserver.ServerHandler
 }
 
-func NewCoordinator(ctx context.Context, store *store.TaskStore, sdkExec sdkExec, socketTracker *SocketTracker, serverFactory serverFactory, statusReporter StatusReporter) *Coordinator {
+func NewCoordinator(ctx context.Context, store *store.TaskStore, sdkExec sdkExec, socketTracker *SocketTracker, serverFactory serverFactory, statusReporter StatusReporter, opts ...CoordinatorOption) *Coordinator {
 	coordinator := &Coordinator{
 		store:           store,
-		sdkExec:         sdkExec,
 		socketTracker:   socketTracker,
 		serverFactory:   serverFactory,
 		statusReporter:  statusReporter,
 		topLevelContext: ctx,
 		activeRuns:      make(map[string]*activeRunEntry),
+		runningByTask:   make(map[string]int),
+		suspended:       make(map[string]string),
+		faults:          &Faults{},
+	}
+	for _, opt := range opts {
+		opt(coordinator)
+	}
+	if len(coordinator.sources) == 0 {
+		coordinator.sources = []Source{{Exec: sdkExec}}
 	}
 
 	return coordinator
 }
 
+// SourceNames returns the names of the coordinator's sources, in order. A
+// coordinator created without WithSources has a single unnamed source.
+func (c *Coordinator) SourceNames() []string {
+	names := make([]string, len(c.sources))
+	for i, source := range c.sources {
+		names[i] = source.Name
+	}
+	return names
+}
+
+// TaskSource returns the name of the source that registered taskName, or ""
+// if no source has.
+func (c *Coordinator) TaskSource(taskName string) string {
+	c.sourcesMu.Lock()
+	defer c.sourcesMu.Unlock()
+	if i, ok := c.taskSources[taskName]; ok {
+		return c.sources[i].Name
+	}
+	return ""
+}
+
+// execFor returns the exec of the source that registered taskName, falling
+// back to the first source.
+func (c *Coordinator) execFor(taskName string) sdkExec {
+	c.sourcesMu.Lock()
+	defer c.sourcesMu.Unlock()
+	return c.sources[c.taskSources[taskName]].Exec
+}
+
+// Faults returns the faults injected into task runs.
+func (c *Coordinator) Faults() *Faults {
+	return c.faults
+}
+
 func (c *Coordinator) GetSubtaskResult(taskRunID string) (taskserver.PostGetSubtaskResultResponseObject, error) {
 	taskRun := c.store.GetTaskRun(taskRunID)
 	if taskRun == nil {
 		return taskserver.PostGetSubtaskResult500Response{}, nil
 	}
 
+	result := c.subtaskResult(taskRun)
+	if taskRun.ParentTaskRunID == nil {
+		return result, nil
+	}
+	// The parent is blocked on this result, so it doesn't need its slot
+	// until the result is ready.
+	if result.StillRunning {
+		c.suspendRun(*taskRun.ParentTaskRunID)
+	} else if !c.resumeRun(*taskRun.ParentTaskRunID) {
+		return taskserver.PostGetSubtaskResult200JSONResponse{StillRunning: true}, nil
+	}
+	return result, nil
+}
+
+// subtaskResult reports the result of taskRun as its parent sees it.
+func (c *Coordinator) subtaskResult(taskRun *store.TaskRun) taskserver.PostGetSubtaskResult200JSONResponse {
+	if c.resultDelayed(taskRun) {
+		return taskserver.PostGetSubtaskResult200JSONResponse{StillRunning: true}
+	}
+
 	var complete *taskserver.TaskComplete
 	var taskError *taskserver.TaskError
 
@@ -100,7 +246,7 @@ func (c *Coordinator) GetSubtaskResult(taskRunID string) (taskserver.PostGetSubt
 		// waiting so it doesn't fail before the retry has a chance to run.
 		task := c.store.GetTaskByName(taskRun.TaskName)
 		if task != nil && task.RetryConfig.ShouldRetry(len(taskRun.Attempts)) {
-			return taskserver.PostGetSubtaskResult200JSONResponse{StillRunning: true}, nil
+			return taskserver.PostGetSubtaskResult200JSONResponse{StillRunning: true}
 		}
 		taskError = &taskserver.TaskError{
 			Details: *taskRun.Error,
@@ -114,7 +260,79 @@ func (c *Coordinator) GetSubtaskResult(taskRunID string) (taskserver.PostGetSubt
 		StillRunning: taskRun.Status == store.TaskRunStatusRunning ||
 			taskRun.Status == store.TaskRunStatusPending,
 		Error: taskError,
-	}, nil
+	}
+}
+
+// suspendRun frees the concurrency slot of a running task run that is
+// waiting on a subtask and launches whichever queued runs now fit. It is a
+// no-op if the run is already suspended or its attempt has ended.
+func (c *Coordinator) suspendRun(taskRunID string) {
+	c.queueMu.Lock()
+	if _, ok := c.suspended[taskRunID]; ok {
+		c.queueMu.Unlock()
+		return
+	}
+	c.activeRunsMu.Lock()
+	_, active := c.activeRuns[taskRunID]
+	c.activeRunsMu.Unlock()
+	taskRun := c.store.GetTaskRun(taskRunID)
+	if !active || taskRun == nil || taskRun.Status != store.TaskRunStatusRunning {
+		c.queueMu.Unlock()
+		return
+	}
+
+	c.releaseSlot(taskRun.TaskName)
+	c.suspended[taskRunID] = taskRun.TaskName
+	ready := c.dequeueReady()
+	c.queueMu.Unlock()
+
+	c.launchAll(ready)
+}
+
+// resumeRun takes a concurrency slot back for a suspended task run before it
+// receives a subtask result. It reports false if no slot is free, in which
+// case the run should keep waiting and is marked resumable so it gets the
+// next free slot.
+func (c *Coordinator) resumeRun(taskRunID string) bool {
+	c.queueMu.Lock()
+	defer c.queueMu.Unlock()
+
+	taskName, ok := c.suspended[taskRunID]
+	if !ok {
+		return true
+	}
+	if !c.hasCapacity(taskName) {
+		c.markResumable(taskRunID)
+		return false
+	}
+	c.acquireSlot(taskName)
+	c.unsuspend(taskRunID)
+	return true
+}
+
+// markResumable gives a suspended task run first claim on the next free
+// slot. Caller must hold queueMu.
+func (c *Coordinator) markResumable(taskRunID string) {
+	if _, ok := c.suspended[taskRunID]; !ok || slices.Contains(c.resumable, taskRunID) {
+		return
+	}
+	c.resumable = append(c.resumable, taskRunID)
+}
+
+// unsuspend stops tracking taskRunID as suspended. Caller must hold queueMu.
+func (c *Coordinator) unsuspend(taskRunID string) {
+	delete(c.suspended, taskRunID)
+	c.resumable = slices.DeleteFunc(c.resumable, func(id string) bool { return id == taskRunID })
+}
+
+// resultDelayed reports whether a FaultDelayResult fault is still withholding
+// the finished taskRun's result from its parent.
+func (c *Coordinator) resultDelayed(taskRun *store.TaskRun) bool {
+	if taskRun.CompletedAt == nil {
+		return false
+	}
+	fault, ok := c.faults.match(taskRun.TaskName, FaultDelayResult, attemptNumber(taskRun))
+	return ok && time.Since(*taskRun.CompletedAt) < fault.Delay
 }
 
 // StartSubtaskFunc builds the taskserver.StartSubtaskFunc callback that
@@ -155,7 +373,196 @@ func (c *Coordinator) StartTask(ctx context.Context, taskSlug string, input []by
 		parentTaskRunID = &parent.ID
 	}
 	taskRun := c.store.StartTaskRun(task.Name, input, parentTaskRunID)
-	return c.launchTask(taskRun)
+	return c.enqueueTask(taskRun)
+}
+
+// RerunTask starts a new root run of the same task as taskRunID, linked to
+// the original. If input is nil, the original run's input is reused.
+func (c *Coordinator) RerunTask(ctx context.Context, taskRunID string, input []byte) (*store.TaskRun, error) {
+	original := c.store.GetTaskRun(taskRunID)
+	if original == nil {
+		return nil, &TaskNotFoundError{TaskRunID: taskRunID}
+	}
+	if input == nil {
+		input = original.Input
+	}
+
+	// Ensure tasks are up to date
+	if _, err := c.PopulateTasks(ctx); err != nil {
+		return nil, err
+	}
+	if c.store.GetTaskByName(original.TaskName) == nil {
+		c.statusReporter.TaskNotFound(original.TaskName)
+		return nil, &TaskNotFoundError{TaskSlug: original.TaskName}
+	}
+
+	taskRun := c.store.StartRerunTaskRun(original, input)
+	return c.enqueueTask(taskRun)
+}
+
+// enqueueTask launches taskRun if a concurrency slot is free, and otherwise
+// parks it in TaskRunStatusPending at the back of the queue. Queued runs are
+// launched in FIFO order as slots free up; a run blocked by its per-task
+// limit does not hold up runs of other tasks behind it.
+func (c *Coordinator) enqueueTask(taskRun *store.TaskRun) (*store.TaskRun, error) {
+	c.queueMu.Lock()
+	if c.hasCapacity(taskRun.TaskName) {
+		c.acquireSlot(taskRun.TaskName)
+		c.queueMu.Unlock()
+		return c.launchReserved(taskRun)
+	}
+
+	if _, err := c.store.QueueTaskRun(taskRun.ID); err != nil {
+		c.queueMu.Unlock()
+		return nil, err
+	}
+	c.queue = append(c.queue, taskRun)
+	position := len(c.queue)
+	c.queueMu.Unlock()
+
+	c.statusReporter.TaskQueued(taskRun, position)
+	return taskRun, nil
+}
+
+// QueueDepth returns the number of task runs waiting for a concurrency slot.
+func (c *Coordinator) QueueDepth() int {
+	c.queueMu.Lock()
+	defer c.queueMu.Unlock()
+	return len(c.queue)
+}
+
+// hasCapacity reports whether a run of taskName can start now. Caller must
+// hold queueMu.
+func (c *Coordinator) hasCapacity(taskName string) bool {
+	if c.maxConcurrency > 0 && c.running >= c.maxConcurrency {
+		return false
+	}
+	if limit := c.taskConcurrency[taskName]; limit > 0 && c.runningByTask[taskName] >= limit {
+		return false
+	}
+	return true
+}
+
+// acquireSlot and releaseSlot track running task runs. Caller must hold
+// queueMu.
+func (c *Coordinator) acquireSlot(taskName string) {
+	c.running++
+	c.runningByTask[taskName]++
+}
+
+func (c *Coordinator) releaseSlot(taskName string) {
+	c.running--
+	c.runningByTask[taskName]--
+}
+
+// launchReserved launches a task run that already holds a concurrency slot.
+// The slot is released by watchAttempt once the attempt ends, or here if the
+// launch fails.
+func (c *Coordinator) launchReserved(taskRun *store.TaskRun) (*store.TaskRun, error) {
+	launched, err := c.launchTask(taskRun)
+	if err != nil {
+		c.finishAttempt(taskRun)
+		return nil, err
+	}
+	return launched, nil
+}
+
+// finishAttempt frees the slot held by taskRun, unless it gave it up while
+// suspended, and launches whichever queued runs now fit. If taskRun's parent
+// is suspended waiting on it and its result is now ready, the parent takes
+// the slot back first.
+func (c *Coordinator) finishAttempt(taskRun *store.TaskRun) {
+	c.queueMu.Lock()
+	if _, ok := c.suspended[taskRun.ID]; ok {
+		c.unsuspend(taskRun.ID)
+	} else {
+		c.releaseSlot(taskRun.TaskName)
+	}
+	if taskRun.ParentTaskRunID != nil {
+		if finished := c.store.GetTaskRun(taskRun.ID); finished != nil && !c.subtaskResult(finished).StillRunning {
+			c.markResumable(*taskRun.ParentTaskRunID)
+		}
+	}
+	ready := c.dequeueReady()
+	c.queueMu.Unlock()
+
+	c.launchAll(ready)
+}
+
+// dequeueReady gives free slots to resumable suspended runs, which pick them
+// up the next time they ask for their subtask's result, then removes the
+// queued runs that fit from the queue and reserves a slot for each. Caller
+// must hold queueMu.
+func (c *Coordinator) dequeueReady() []*store.TaskRun {
+	waiting := c.resumable[:0]
+	for _, id := range c.resumable {
+		taskName, ok := c.suspended[id]
+		if !ok {
+			continue
+		}
+		if c.hasCapacity(taskName) {
+			c.acquireSlot(taskName)
+			delete(c.suspended, id)
+			continue
+		}
+		waiting = append(waiting, id)
+	}
+	c.resumable = waiting
+
+	var ready []*store.TaskRun
+	remaining := c.queue[:0]
+	for _, queued := range c.queue {
+		// Runs canceled while queued are dropped.
+		if tr := c.store.GetTaskRun(queued.ID); tr == nil || tr.Status != store.TaskRunStatusPending {
+			continue
+		}
+		if c.hasCapacity(queued.TaskName) {
+			c.acquireSlot(queued.TaskName)
+			ready = append(ready, queued)
+			continue
+		}
+		remaining = append(remaining, queued)
+	}
+	c.queue = remaining
+	return ready
+}
+
+func (c *Coordinator) launchAll(ready []*store.TaskRun) {
+	for _, taskRun := range ready {
+		go c.launchDequeued(taskRun)
+	}
+}
+
+func (c *Coordinator) launchDequeued(taskRun *store.TaskRun) {
+	if _, err := c.launchReserved(taskRun); err != nil {
+		// Nothing is waiting on the launch result, so record the failure on
+		// the run itself rather than leaving it pending forever.
+		if tr := c.store.GetTaskRun(taskRun.ID); tr != nil && tr.Status == store.TaskRunStatusPending {
+			if updated, failErr := c.store.FailTaskRun(taskRun.ID, fmt.Sprintf("failed to start queued task: %s", err)); failErr == nil {
+				c.statusReporter.TaskFailed(updated)
+			}
+		}
+	}
+}
+
+// cancelQueued cancels queued runs belonging to the given root task run and
+// returns how many were canceled.
+func (c *Coordinator) cancelQueued(rootTaskRunID string) int {
+	c.queueMu.Lock()
+	var canceled []*store.TaskRun
+	c.queue = slices.DeleteFunc(c.queue, func(tr *store.TaskRun) bool {
+		if tr.RootTaskRunID == rootTaskRunID {
+			canceled = append(canceled, tr)
+			return true
+		}
+		return false
+	})
+	c.queueMu.Unlock()
+
+	for _, tr := range canceled {
+		c.markCancelled(tr.ID)
+	}
+	return len(canceled)
 }
 
 // launchTask starts the process for a prepared task run and watches it.
@@ -197,7 +604,7 @@ func (c *Coordinator) launchTask(taskRun *store.TaskRun) (*store.TaskRun, error)
 		return nil, err
 	}
 
-	cleanupFunc, processDone, err := c.sdkExec.StartService(runCtx, taskRun.ID, socket.Addr().String(), ModeRun)
+	cleanupFunc, processDone, err := c.execFor(taskRun.TaskName).StartService(runCtx, taskRun.ID, socket.Addr().String(), ModeRun)
 	if err != nil {
 		cancelRun()
 		errMsg := fmt.Sprintf("failed to start task: %s", err)
@@ -213,10 +620,19 @@ func (c *Coordinator) launchTask(taskRun *store.TaskRun) (*store.TaskRun, error)
 	}
 
 	c.statusReporter.TaskRunning(taskRun)
-	go c.watchAttempt(cancelRun, taskRun, server, processDone, cleanupFunc)
+	go c.watchAttempt(cancelRun, taskRun, server, processDone, cleanupFunc, c.timeoutFor(taskRun.TaskName))
 	return taskRun, nil
 }
 
+// timeoutFor returns the attempt timeout registered for the task, falling back
+// to the coordinator's default.
+func (c *Coordinator) timeoutFor(taskName string) time.Duration {
+	if task := c.store.GetTaskByName(taskName); task != nil && task.Timeout > 0 {
+		return task.Timeout
+	}
+	return c.defaultTimeout
+}
+
 // watchAttempt monitors a single attempt. Caller must run it in a goroutine.
 func (c *Coordinator) watchAttempt(
 	cancelRun context.CancelFunc,
@@ -224,6 +640,7 @@ func (c *Coordinator) watchAttempt(
 	server *taskserver.ServerHandler,
 	processDone <-chan error,
 	cleanupFunc CleanupFunc,
+	timeout time.Duration,
 ) {
 	defer cleanupFunc()
 	defer func() {
@@ -231,35 +648,102 @@ func (c *Coordinator) watchAttempt(
 		delete(c.activeRuns, taskRun.ID)
 		c.activeRunsMu.Unlock()
 		cancelRun()
+		c.finishAttempt(taskRun)
 	}()
 
-	select {
-	case callback := <-server.Channels.PostCallback:
-		if err := c.completeTask(callback.Body, taskRun.ID); err != nil {
-			fmt.Println("error completing task", err)
-		}
-	case err := <-processDone:
-		// If a cancellation callsite has already transitioned this task
-		// run to a terminal state, don't overwrite it with a failure.
-		// Cancelling runCtx kills the underlying process, which fires
-		// processDone in addition to runCtx.Done().
-		if tr := c.store.GetTaskRun(taskRun.ID); tr != nil && tr.Status != store.TaskRunStatusRunning {
+	var timedOut <-chan time.Time
+	if timeout > 0 {
+		timer := time.NewTimer(timeout)
+		defer timer.Stop()
+		timedOut = timer.C
+	}
+
+	attempt := 1
+	if tr := c.store.GetTaskRun(taskRun.ID); tr != nil {
+		attempt = attemptNumber(tr)
+	}
+	var killed <-chan time.Time
+	killFault, kill := c.faults.match(taskRun.TaskName, FaultKill, attempt)
+	if kill {
+		timer := time.NewTimer(killFault.Delay)
+		defer timer.Stop()
+		killed = timer.C
+	}
+	callbackDropped := false
+
+	for {
+		select {
+		case callback := <-server.Channels.PostCallback:
+			if _, ok := c.faults.match(taskRun.TaskName, FaultDropCallback, attempt); ok {
+				// Keep waiting as if the result never arrived; the attempt
+				// ends when the process exits or times out.
+				callbackDropped = true
+				continue
+			}
+			if _, ok := c.faults.match(taskRun.TaskName, FaultFail, attempt); ok {
+				c.failAttempt(taskRun.ID, fmt.Sprintf("injected fault: failed attempt %d", attempt))
+				return
+			}
+			if err := c.completeTask(callback.Body, taskRun.ID); err != nil {
+				fmt.Println("error completing task", err)
+			}
 			return
-		}
-		errMsg := "start command exited before completing the task"
-		if err != nil {
-			errMsg = fmt.Sprintf("%s: %s", errMsg, err)
-		}
-		updated, failErr := c.store.FailTaskRun(taskRun.ID, errMsg)
-		if failErr != nil {
-			fmt.Println("error failing task", failErr)
+		case err := <-processDone:
+			// If a cancellation callsite has already transitioned this task
+			// run to a terminal state, don't overwrite it with a failure.
+			// Cancelling runCtx kills the underlying process, which fires
+			// processDone in addition to runCtx.Done().
+			if tr := c.store.GetTaskRun(taskRun.ID); tr != nil && tr.Status != store.TaskRunStatusRunning {
+				return
+			}
+			errMsg := "start command exited before completing the task"
+			if callbackDropped {
+				errMsg = "start command exited after its result was dropped by an injected fault"
+			}
+			if err != nil {
+				errMsg = fmt.Sprintf("%s: %s", errMsg, err)
+			}
+			c.failAttempt(taskRun.ID, errMsg)
+			return
+		case <-timedOut:
+			if tr := c.store.GetTaskRun(taskRun.ID); tr != nil && tr.Status != store.TaskRunStatusRunning {
+				return
+			}
+			// Record the timeout before killing the process so the resulting
+			// exit isn't reported as an ordinary failure. cleanupFunc kills the
+			// whole process tree when this function returns.
+			updated, err := c.store.TimeOutTaskRun(taskRun.ID, timeout)
+			if err != nil {
+				fmt.Println("error timing out task", err)
+				return
+			}
+			c.statusReporter.TaskFailed(updated)
+			go c.scheduleRetry(updated)
+			return
+		case <-killed:
+			if tr := c.store.GetTaskRun(taskRun.ID); tr != nil && tr.Status != store.TaskRunStatusRunning {
+				return
+			}
+			// As with timeouts, record the failure first; cleanupFunc kills
+			// the process tree on return.
+			c.failAttempt(taskRun.ID, fmt.Sprintf("injected fault: killed the task process after %s", killFault.Delay))
 			return
 		}
-		c.statusReporter.TaskFailed(updated)
-		go c.scheduleRetry(updated)
 	}
 }
 
+// failAttempt fails the current attempt of a running task run and schedules
+// a retry if the task's retry config allows one.
+func (c *Coordinator) failAttempt(taskRunID string, errMsg string) {
+	updated, err := c.store.FailTaskRun(taskRunID, errMsg)
+	if err != nil {
+		fmt.Println("error failing task", err)
+		return
+	}
+	c.statusReporter.TaskFailed(updated)
+	go c.scheduleRetry(updated)
+}
+
 // scheduleRetry checks whether the task's RetryConfig permits another attempt,
 // transitions the run back to pending, waits the configured backoff, then
 // retries the same task run in place. It runs in its own goroutine (caller does go).
@@ -286,12 +770,60 @@ func (c *Coordinator) scheduleRetry(failedTaskRun *store.TaskRun) {
 		return
 	}
 
-	if _, err := c.launchTask(failedTaskRun); err != nil {
+	if _, err := c.enqueueTask(failedTaskRun); err != nil {
 		fmt.Printf("failed to launch retry: %s\n", err)
 	}
 }
 
+// PopulateTasks registers the tasks of every source at once and replaces the
+// store's tasks with them.
 func (c *Coordinator) PopulateTasks(ctx context.Context) ([]*store.Task, error) {
+	registered := make([][]taskserver.Task, len(c.sources))
+	errs := make([]error, len(c.sources))
+	var wg sync.WaitGroup
+	for i, source := range c.sources {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			registered[i], errs[i] = c.registerSource(ctx, source)
+		}()
+	}
+	wg.Wait()
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	var tasks []taskserver.Task
+	taskSources := make(map[string]int)
+	for i, sourceTasks := range registered {
+		for _, task := range sourceTasks {
+			if other, ok := taskSources[task.Name]; ok {
+				return nil, fmt.Errorf("task %q is registered by both %s and %s", task.Name, c.sources[other].Name, c.sources[i].Name)
+			}
+			taskSources[task.Name] = i
+			tasks = append(tasks, task)
+		}
+	}
+
+	c.sourcesMu.Lock()
+	c.taskSources = taskSources
+	c.sourcesMu.Unlock()
+
+	c.store.SetTasks(tasks)
+	return c.store.GetTasks(), nil
+}
+
+// registerSource starts source in register mode and returns the tasks it
+// registers. Errors name the source when it has a name.
+func (c *Coordinator) registerSource(ctx context.Context, source Source) ([]taskserver.Task, error) {
+	tasks, err := c.register(ctx, source.Exec)
+	if err != nil && source.Name != "" {
+		return nil, fmt.Errorf("%s: %w", source.Name, err)
+	}
+	return tasks, err
+}
+
+func (c *Coordinator) register(ctx context.Context, sdkExec sdkExec) ([]taskserver.Task, error) {
 	socket, err := c.socketTracker.NewSocket()
 	if err != nil {
 		return nil, err
@@ -303,7 +835,7 @@ func (c *Coordinator) PopulateTasks(ctx context.Context) ([]*store.Task, error)
 
 	// we don't need to pass in a task run id here, because we don't need to
 	// keep track of the logs for registration
-	cleanupFunc, processDone, err := c.sdkExec.StartService(context.Background(), "", socket.Addr().String(), ModeRegister)
+	cleanupFunc, processDone, err := sdkExec.StartService(context.Background(), "", socket.Addr().String(), ModeRegister)
 	if err != nil {
 		return nil, err
 	}
@@ -319,8 +851,7 @@ func (c *Coordinator) PopulateTasks(ctx context.Context) ([]*store.Task, error)
 		}
 		return nil, fmt.Errorf("start command exited before registering tasks\nRun with --debug to see process output")
 	case tasks := <-server.Channels.PostTasks:
-		c.store.SetTasks(tasks.Body.Tasks)
-		return c.store.GetTasks(), nil
+		return tasks.Body.Tasks, nil
 	}
 }
 
@@ -344,10 +875,11 @@ func (c *Coordinator) CancelTaskRun(taskRunID string) error {
 
 	rootID := taskRun.ID
 
+	canceled := c.cancelQueued(rootID)
+
 	c.activeRunsMu.Lock()
 	defer c.activeRunsMu.Unlock()
 
-	canceled := 0
 	for id, entry := range c.activeRuns {
 		if entry.rootTaskRunID != rootID {
 			continue
@@ -14,6 +14,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net"
+	"sync"
 	"testing"
 	"time"
 
@@ -43,14 +44,15 @@ type fakeServerFactory struct {
 
 type noopStatusReporter struct{}
 
-func (noopStatusReporter) Ready()                               {}
-func (noopStatusReporter) TasksRegistered(taskNames []string)   {}
-func (noopStatusReporter) TaskEnqueued(taskRun *store.TaskRun)  {}
-func (noopStatusReporter) TaskRunning(taskRun *store.TaskRun)   {}
-func (noopStatusReporter) TaskCompleted(taskRun *store.TaskRun) {}
-func (noopStatusReporter) TaskFailed(taskRun *store.TaskRun)    {}
-func (noopStatusReporter) TaskCancelled(taskRun *store.TaskRun) {}
-func (noopStatusReporter) TaskNotFound(taskSlug string)         {}
+func (noopStatusReporter) Ready()                                          {}
+func (noopStatusReporter) TasksRegistered(taskNames []string)              {}
+func (noopStatusReporter) TaskEnqueued(taskRun *store.TaskRun)             {}
+func (noopStatusReporter) TaskQueued(taskRun *store.TaskRun, position int) {}
+func (noopStatusReporter) TaskRunning(taskRun *store.TaskRun)              {}
+func (noopStatusReporter) TaskCompleted(taskRun *store.TaskRun)            {}
+func (noopStatusReporter) TaskFailed(taskRun *store.TaskRun)               {}
+func (noopStatusReporter) TaskCancelled(taskRun *store.TaskRun)            {}
+func (noopStatusReporter) TaskNotFound(taskSlug string)                    {}
 
 func (f *fakeServerFactory) NewHandler(socket net.Listener, input taskserver.GetInput200JSONResponse, getSubtaskResultFunc taskserver.GetSubtaskResultFunc, startSubtaskFunc taskserver.StartSubtaskFunc) *taskserver.ServerHandler {
 	return f.newHandler(socket, input, getSubtaskResultFunc, startSubtaskFunc)
@@ -967,3 +969,658 @@ func TestStartTaskProcessExits(t *testing.T) {
 	tr := s.GetTaskRun(taskRun.ID)
 	require.Contains(t, *tr.Error, "start command exited before completing the task")
 }
+
+// registeringServerFactory answers every registration with tasks, so tests
+// can call StartTask repeatedly.
+func registeringServerFactory(tasks []taskserver.Task) *fakeServerFactory {
+	return &fakeServerFactory{
+		newHandler: func(socket net.Listener, input taskserver.GetInput200JSONResponse, getSubtaskResultFunc taskserver.GetSubtaskResultFunc, startSubtaskFunc taskserver.StartSubtaskFunc) *taskserver.ServerHandler {
+			postTasksChan := make(chan taskserver.PostRegisterTasksRequestObject, 1)
+			if input.TaskName == "" {
+				postTasksChan <- taskserver.PostRegisterTasksRequestObject{
+					Body: &taskserver.PostRegisterTasksJSONRequestBody{
+						Tasks: tasks,
+					},
+				}
+			}
+			return &taskserver.ServerHandler{
+				Socket: socket,
+				Input:  input,
+				Channels: taskserver.ServerChannels{
+					PostCallback: make(chan taskserver.PostCallbackRequestObject),
+					PostTasks:    postTasksChan,
+				},
+			}
+		},
+	}
+}
+
+type queueingStatusReporter struct {
+	noopStatusReporter
+	mu        sync.Mutex
+	positions map[string]int
+}
+
+func (r *queueingStatusReporter) TaskQueued(taskRun *store.TaskRun, position int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.positions[taskRun.ID] = position
+}
+
+func TestConcurrencyLimits(t *testing.T) {
+	setup := func(t *testing.T, opts ...orchestrator.CoordinatorOption) (*orchestrator.Coordinator, *store.TaskStore, chan runHandle, *queueingStatusReporter) {
+		t.Helper()
+		ctx := context.Background()
+		s := store.NewTaskStore()
+
+		socketTracker, err := orchestrator.NewSocketTracker(ctx)
+		require.NoError(t, err)
+
+		exec, runChan := controllableSdkExec()
+		reporter := &queueingStatusReporter{positions: map[string]int{}}
+		coordinator := orchestrator.NewCoordinator(
+			ctx,
+			s,
+			exec,
+			socketTracker,
+			registeringServerFactory([]taskserver.Task{{Name: "task-a"}, {Name: "task-b"}}),
+			reporter,
+			opts...,
+		)
+		return coordinator, s, runChan, reporter
+	}
+
+	t.Run("queues runs over the global limit in FIFO order", func(t *testing.T) {
+		coordinator, s, runChan, reporter := setup(t, orchestrator.WithMaxConcurrency(1))
+		ctx := context.Background()
+
+		first, err := coordinator.StartTask(ctx, "task-a", []byte{}, nil)
+		require.NoError(t, err)
+		second, err := coordinator.StartTask(ctx, "task-b", []byte{}, nil)
+		require.NoError(t, err)
+		third, err := coordinator.StartTask(ctx, "task-a", []byte{}, nil)
+		require.NoError(t, err)
+
+		require.Equal(t, store.TaskRunStatusRunning, s.GetTaskRun(first.ID).Status)
+		require.Equal(t, store.TaskRunStatusPending, s.GetTaskRun(second.ID).Status)
+		require.Equal(t, store.TaskRunStatusPending, s.GetTaskRun(third.ID).Status)
+		require.Equal(t, 2, coordinator.QueueDepth())
+		require.Equal(t, map[string]int{second.ID: 1, third.ID: 2}, reporter.positions)
+
+		firstHandle := <-runChan
+		firstHandle.done <- fmt.Errorf("exit status 1")
+
+		require.Eventually(t, func() bool {
+			return s.GetTaskRun(second.ID).Status == store.TaskRunStatusRunning
+		}, time.Second*2, time.Millisecond*10)
+		require.Equal(t, store.TaskRunStatusPending, s.GetTaskRun(third.ID).Status)
+		require.Equal(t, 1, coordinator.QueueDepth())
+	})
+
+	t.Run("per-task limits do not block other tasks", func(t *testing.T) {
+		coordinator, s, _, _ := setup(t, orchestrator.WithTaskConcurrency(map[string]int{"task-a": 1}))
+		ctx := context.Background()
+
+		_, err := coordinator.StartTask(ctx, "task-a", []byte{}, nil)
+		require.NoError(t, err)
+		queued, err := coordinator.StartTask(ctx, "task-a", []byte{}, nil)
+		require.NoError(t, err)
+		other, err := coordinator.StartTask(ctx, "task-b", []byte{}, nil)
+		require.NoError(t, err)
+
+		require.Equal(t, store.TaskRunStatusPending, s.GetTaskRun(queued.ID).Status)
+		require.Equal(t, store.TaskRunStatusRunning, s.GetTaskRun(other.ID).Status)
+		require.Equal(t, 1, coordinator.QueueDepth())
+	})
+
+	t.Run("canceling a queued run removes it from the queue", func(t *testing.T) {
+		coordinator, s, runChan, _ := setup(t, orchestrator.WithMaxConcurrency(1))
+		ctx := context.Background()
+
+		_, err := coordinator.StartTask(ctx, "task-a", []byte{}, nil)
+		require.NoError(t, err)
+		queued, err := coordinator.StartTask(ctx, "task-a", []byte{}, nil)
+		require.NoError(t, err)
+
+		require.NoError(t, coordinator.CancelTaskRun(queued.ID))
+		require.Equal(t, store.TaskRunStatusCanceled, s.GetTaskRun(queued.ID).Status)
+		require.Equal(t, 0, coordinator.QueueDepth())
+
+		// Freeing the slot must not launch the canceled run.
+		firstHandle := <-runChan
+		firstHandle.done <- fmt.Errorf("exit status 1")
+		select {
+		case <-runChan:
+			t.Fatal("canceled run was launched")
+		case <-time.After(100 * time.Millisecond):
+		}
+		require.Equal(t, store.TaskRunStatusCanceled, s.GetTaskRun(queued.ID).Status)
+	})
+}
+
+func TestConcurrencyLimitsWithSubtasks(t *testing.T) {
+	tests := []struct {
+		name    string
+		subtask string
+		opt     orchestrator.CoordinatorOption
+	}{
+		{name: "global limit of 1", subtask: "task-b", opt: orchestrator.WithMaxConcurrency(1)},
+		{name: "per-task limit of 1 on a task that starts itself", subtask: "task-a", opt: orchestrator.WithTaskConcurrency(map[string]int{"task-a": 1})},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			s := store.NewTaskStore()
+
+			socketTracker, err := orchestrator.NewSocketTracker(ctx)
+			require.NoError(t, err)
+
+			exec, _ := controllableSdkExec()
+			factory, callbacks := callbackServerFactory([]taskserver.Task{{Name: "task-a"}, {Name: "task-b"}})
+			coordinator := orchestrator.NewCoordinator(ctx, s, exec, socketTracker, factory, &noopStatusReporter{}, tt.opt)
+
+			parent, err := coordinator.StartTask(ctx, "task-a", []byte{}, nil)
+			require.NoError(t, err)
+			parentCallback := <-callbacks
+
+			// The parent holds the only slot, so its subtask is queued.
+			subtask, err := coordinator.StartTask(ctx, tt.subtask, []byte{}, parent)
+			require.NoError(t, err)
+			require.Equal(t, store.TaskRunStatusPending, s.GetTaskRun(subtask.ID).Status)
+
+			// Waiting on the subtask frees the parent's slot for it.
+			result, err := coordinator.GetSubtaskResult(subtask.ID)
+			require.NoError(t, err)
+			require.Equal(t, taskserver.PostGetSubtaskResult200JSONResponse{StillRunning: true}, result)
+
+			var subtaskCallback chan taskserver.PostCallbackRequestObject
+			select {
+			case subtaskCallback = <-callbacks:
+			case <-time.After(2 * time.Second):
+				t.Fatal("subtask was not launched while its parent waited on it")
+			}
+			subtaskCallback <- completeCallback(`[1]`)
+
+			require.Eventually(t, func() bool {
+				result, err := coordinator.GetSubtaskResult(subtask.ID)
+				require.NoError(t, err)
+				return result.(taskserver.PostGetSubtaskResult200JSONResponse).Complete != nil
+			}, time.Second*2, time.Millisecond*10)
+
+			// Receiving the result took the slot back, so another run queues.
+			queued, err := coordinator.StartTask(ctx, "task-a", []byte{}, nil)
+			require.NoError(t, err)
+			require.Equal(t, store.TaskRunStatusPending, s.GetTaskRun(queued.ID).Status)
+
+			parentCallback <- completeCallback(`[2]`)
+			require.Eventually(t, func() bool {
+				return s.GetTaskRun(parent.ID).Status == store.TaskRunStatusComplete &&
+					s.GetTaskRun(queued.ID).Status == store.TaskRunStatusRunning
+			}, time.Second*2, time.Millisecond*10)
+		})
+	}
+}
+
+// TestSuspendedParentResumesAheadOfQueue checks that a parent whose subtask
+// has finished takes the freed slot back before queued runs do, so a steady
+// stream of new runs can't keep it from receiving the result.
+func TestSuspendedParentResumesAheadOfQueue(t *testing.T) {
+	ctx := context.Background()
+	s := store.NewTaskStore()
+
+	socketTracker, err := orchestrator.NewSocketTracker(ctx)
+	require.NoError(t, err)
+
+	exec, _ := controllableSdkExec()
+	factory, callbacks := callbackServerFactory([]taskserver.Task{{Name: "task-a"}, {Name: "task-b"}})
+	coordinator := orchestrator.NewCoordinator(ctx, s, exec, socketTracker, factory, &noopStatusReporter{}, orchestrator.WithMaxConcurrency(1))
+
+	parent, err := coordinator.StartTask(ctx, "task-a", []byte{}, nil)
+	require.NoError(t, err)
+	<-callbacks
+
+	subtask, err := coordinator.StartTask(ctx, "task-b", []byte{}, parent)
+	require.NoError(t, err)
+	_, err = coordinator.GetSubtaskResult(subtask.ID)
+	require.NoError(t, err)
+	subtaskCallback := <-callbacks
+
+	// Runs keep arriving while the subtask runs, so the queue never drains.
+	var queued []*store.TaskRun
+	for range 3 {
+		run, err := coordinator.StartTask(ctx, "task-b", []byte{}, nil)
+		require.NoError(t, err)
+		queued = append(queued, run)
+	}
+	subtaskCallback <- completeCallback(`[1]`)
+
+	require.Eventually(t, func() bool {
+		result, err := coordinator.GetSubtaskResult(subtask.ID)
+		require.NoError(t, err)
+		return result.(taskserver.PostGetSubtaskResult200JSONResponse).Complete != nil
+	}, time.Second*2, time.Millisecond*10)
+
+	for _, run := range queued {
+		require.Equal(t, store.TaskRunStatusPending, s.GetTaskRun(run.ID).Status)
+	}
+	require.Equal(t, len(queued), coordinator.QueueDepth())
+}
+
+func TestTaskTimeout(t *testing.T) {
+	ctx := context.Background()
+	s := store.NewTaskStore()
+
+	socketTracker, err := orchestrator.NewSocketTracker(ctx)
+	require.NoError(t, err)
+
+	exec, runChan := controllableSdkExec()
+	coordinator := orchestrator.NewCoordinator(
+		ctx,
+		s,
+		exec,
+		socketTracker,
+		registeringServerFactory([]taskserver.Task{
+			{
+				Name: "test-task",
+				Options: &taskserver.TaskOptions{
+					Retry: &taskserver.RetryConfig{MaxRetries: pointers.From(1)},
+				},
+			},
+		}),
+		&noopStatusReporter{},
+		orchestrator.WithDefaultTimeout(50*time.Millisecond),
+	)
+
+	taskRun, err := coordinator.StartTask(ctx, "test-task", []byte{}, nil)
+	require.NoError(t, err)
+
+	// The first attempt hangs until the timeout kills it.
+	first := <-runChan
+	select {
+	case err := <-first.done:
+		require.EqualError(t, err, "signal: killed")
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed-out attempt was not killed")
+	}
+
+	// The timeout counts as a failure, so the run is retried and the
+	// timed-out attempt is archived.
+	second := <-runChan
+	tr := s.GetTaskRun(taskRun.ID)
+	require.Len(t, tr.Attempts, 1)
+	require.True(t, tr.Attempts[0].TimedOut)
+	require.Equal(t, "task run timed out after 50ms", *tr.Attempts[0].Error)
+
+	<-second.done
+	require.Eventually(t, func() bool {
+		tr := s.GetTaskRun(taskRun.ID)
+		return tr.Status == store.TaskRunStatusFailed && tr.TimedOut
+	}, time.Second*2, time.Millisecond*10)
+}
+
+func TestRerunTask(t *testing.T) {
+	ctx := context.Background()
+	s := store.NewTaskStore()
+
+	socketTracker, err := orchestrator.NewSocketTracker(ctx)
+	require.NoError(t, err)
+
+	exec, _ := controllableSdkExec()
+	coordinator := orchestrator.NewCoordinator(
+		ctx,
+		s,
+		exec,
+		socketTracker,
+		registeringServerFactory([]taskserver.Task{{Name: "test-task"}}),
+		&noopStatusReporter{},
+	)
+
+	original, err := coordinator.StartTask(ctx, "test-task", []byte(`[1]`), nil)
+	require.NoError(t, err)
+
+	t.Run("reuses the original input", func(t *testing.T) {
+		rerun, err := coordinator.RerunTask(ctx, original.ID, nil)
+		require.NoError(t, err)
+
+		require.NotEqual(t, original.ID, rerun.ID)
+		require.Equal(t, "test-task", rerun.TaskName)
+		require.JSONEq(t, `[1]`, string(rerun.Input))
+		require.Equal(t, original.ID, *rerun.RerunOfTaskRunID)
+		require.Equal(t, rerun.ID, rerun.RootTaskRunID)
+		require.Equal(t, store.TaskRunStatusRunning, s.GetTaskRun(rerun.ID).Status)
+	})
+
+	t.Run("uses edited input", func(t *testing.T) {
+		rerun, err := coordinator.RerunTask(ctx, original.ID, []byte(`[2]`))
+		require.NoError(t, err)
+
+		require.JSONEq(t, `[2]`, string(rerun.Input))
+		require.JSONEq(t, `[1]`, string(s.GetTaskRun(original.ID).Input))
+	})
+
+	t.Run("unknown task run", func(t *testing.T) {
+		_, err := coordinator.RerunTask(ctx, "trn-missing", nil)
+
+		var taskNotFoundErr *orchestrator.TaskNotFoundError
+		require.ErrorAs(t, err, &taskNotFoundErr)
+		require.Equal(t, "trn-missing", taskNotFoundErr.TaskRunID)
+	})
+}
+
+// callbackServerFactory answers every registration with tasks and sends the
+// callback channel of each run attempt's server on the returned channel, so
+// tests can report attempt results.
+func callbackServerFactory(tasks []taskserver.Task) (*fakeServerFactory, chan chan taskserver.PostCallbackRequestObject) {
+	callbacks := make(chan chan taskserver.PostCallbackRequestObject, 16)
+	factory := &fakeServerFactory{
+		newHandler: func(socket net.Listener, input taskserver.GetInput200JSONResponse, getSubtaskResultFunc taskserver.GetSubtaskResultFunc, startSubtaskFunc taskserver.StartSubtaskFunc) *taskserver.ServerHandler {
+			postTasksChan := make(chan taskserver.PostRegisterTasksRequestObject, 1)
+			postCallbackChan := make(chan taskserver.PostCallbackRequestObject)
+			if input.TaskName == "" {
+				postTasksChan <- taskserver.PostRegisterTasksRequestObject{
+					Body: &taskserver.PostRegisterTasksJSONRequestBody{
+						Tasks: tasks,
+					},
+				}
+			} else {
+				callbacks <- postCallbackChan
+			}
+			return &taskserver.ServerHandler{
+				Socket: socket,
+				Input:  input,
+				Channels: taskserver.ServerChannels{
+					PostCallback: postCallbackChan,
+					PostTasks:    postTasksChan,
+				},
+			}
+		},
+	}
+	return factory, callbacks
+}
+
+func completeCallback(output string) taskserver.PostCallbackRequestObject {
+	return taskserver.PostCallbackRequestObject{
+		Body: &taskserver.CallbackRequest{
+			Complete: &taskserver.TaskComplete{Output: []byte(output)},
+		},
+	}
+}
+
+func newFaultCoordinator(t *testing.T, faults ...orchestrator.Fault) (*orchestrator.Coordinator, *store.TaskStore, chan runHandle, chan chan taskserver.PostCallbackRequestObject) {
+	t.Helper()
+	ctx := context.Background()
+	s := store.NewTaskStore()
+
+	socketTracker, err := orchestrator.NewSocketTracker(ctx)
+	require.NoError(t, err)
+
+	injected, err := orchestrator.NewFaults(faults...)
+	require.NoError(t, err)
+
+	exec, runChan := controllableSdkExec()
+	factory, callbacks := callbackServerFactory([]taskserver.Task{
+		{
+			Name: "test-task",
+			Options: &taskserver.TaskOptions{
+				Retry: &taskserver.RetryConfig{MaxRetries: pointers.From(1)},
+			},
+		},
+	})
+	coordinator := orchestrator.NewCoordinator(
+		ctx,
+		s,
+		exec,
+		socketTracker,
+		factory,
+		&noopStatusReporter{},
+		orchestrator.WithFaults(injected),
+	)
+	return coordinator, s, runChan, callbacks
+}
+
+func TestFaultFail(t *testing.T) {
+	coordinator, s, _, callbacks := newFaultCoordinator(t, orchestrator.Fault{
+		Task:    "test-task",
+		Kind:    orchestrator.FaultFail,
+		Attempt: 1,
+	})
+
+	taskRun, err := coordinator.StartTask(context.Background(), "test-task", []byte{}, nil)
+	require.NoError(t, err)
+
+	// The first attempt's result is replaced with the injected failure, and
+	// the retry completes normally.
+	(<-callbacks) <- completeCallback(`[1]`)
+	(<-callbacks) <- completeCallback(`[2]`)
+
+	require.Eventually(t, func() bool {
+		return s.GetTaskRun(taskRun.ID).Status == store.TaskRunStatusComplete
+	}, time.Second*2, time.Millisecond*10)
+	tr := s.GetTaskRun(taskRun.ID)
+	require.JSONEq(t, `[2]`, string(tr.Output))
+	require.Len(t, tr.Attempts, 1)
+	require.Equal(t, "injected fault: failed attempt 1", *tr.Attempts[0].Error)
+}
+
+func TestFaultKill(t *testing.T) {
+	coordinator, s, runChan, _ := newFaultCoordinator(t, orchestrator.Fault{
+		Task:  "test-task",
+		Kind:  orchestrator.FaultKill,
+		Delay: 20 * time.Millisecond,
+	})
+
+	taskRun, err := coordinator.StartTask(context.Background(), "test-task", []byte{}, nil)
+	require.NoError(t, err)
+
+	first := <-runChan
+	select {
+	case err := <-first.done:
+		require.EqualError(t, err, "signal: killed")
+	case <-time.After(2 * time.Second):
+		t.Fatal("attempt was not killed")
+	}
+
+	// The fault applies to every attempt, so the retry is killed too.
+	<-runChan
+	require.Eventually(t, func() bool {
+		tr := s.GetTaskRun(taskRun.ID)
+		return tr.Status == store.TaskRunStatusFailed && len(tr.Attempts) == 1
+	}, time.Second*2, time.Millisecond*10)
+	tr := s.GetTaskRun(taskRun.ID)
+	require.Equal(t, "injected fault: killed the task process after 20ms", *tr.Attempts[0].Error)
+	require.Equal(t, "injected fault: killed the task process after 20ms", *tr.Error)
+}
+
+func TestFaultDropCallback(t *testing.T) {
+	coordinator, s, runChan, callbacks := newFaultCoordinator(t, orchestrator.Fault{
+		Task:    "test-task",
+		Kind:    orchestrator.FaultDropCallback,
+		Attempt: 1,
+	})
+
+	taskRun, err := coordinator.StartTask(context.Background(), "test-task", []byte{}, nil)
+	require.NoError(t, err)
+
+	// The dropped result leaves the attempt running until its process exits.
+	(<-callbacks) <- completeCallback(`[1]`)
+	require.Never(t, func() bool {
+		return s.GetTaskRun(taskRun.ID).Status != store.TaskRunStatusRunning
+	}, time.Millisecond*100, time.Millisecond*10)
+	(<-runChan).done <- nil
+
+	(<-callbacks) <- completeCallback(`[2]`)
+	require.Eventually(t, func() bool {
+		return s.GetTaskRun(taskRun.ID).Status == store.TaskRunStatusComplete
+	}, time.Second*2, time.Millisecond*10)
+	tr := s.GetTaskRun(taskRun.ID)
+	require.JSONEq(t, `[2]`, string(tr.Output))
+	require.Len(t, tr.Attempts, 1)
+	require.Equal(t, "start command exited after its result was dropped by an injected fault", *tr.Attempts[0].Error)
+}
+
+func TestFaultDelayResult(t *testing.T) {
+	coordinator, s, _, callbacks := newFaultCoordinator(t, orchestrator.Fault{
+		Task:  "test-task",
+		Kind:  orchestrator.FaultDelayResult,
+		Delay: 200 * time.Millisecond,
+	})
+
+	taskRun, err := coordinator.StartTask(context.Background(), "test-task", []byte{}, nil)
+	require.NoError(t, err)
+	(<-callbacks) <- completeCallback(`[1]`)
+	require.Eventually(t, func() bool {
+		return s.GetTaskRun(taskRun.ID).Status == store.TaskRunStatusComplete
+	}, time.Second*2, time.Millisecond*10)
+
+	// The run has finished, but its result is withheld until the delay has
+	// passed.
+	result, err := coordinator.GetSubtaskResult(taskRun.ID)
+	require.NoError(t, err)
+	require.Equal(t, taskserver.PostGetSubtaskResult200JSONResponse{StillRunning: true}, result)
+
+	require.Eventually(t, func() bool {
+		result, err := coordinator.GetSubtaskResult(taskRun.ID)
+		require.NoError(t, err)
+		return result.(taskserver.PostGetSubtaskResult200JSONResponse).Complete != nil
+	}, time.Second*2, time.Millisecond*10)
+}
+
+// sourceHarness wires up a coordinator whose sources register their own
+// tasks: the server factory records each registration server by socket
+// address, and a source's exec answers on the server it was started for.
+type sourceHarness struct {
+	mu           sync.Mutex
+	postTasks    map[string]chan taskserver.PostRegisterTasksRequestObject
+	runsBySource map[string][]string
+	coordinator  *orchestrator.Coordinator
+}
+
+func (h *sourceHarness) factory() *fakeServerFactory {
+	return &fakeServerFactory{
+		newHandler: func(socket net.Listener, input taskserver.GetInput200JSONResponse, getSubtaskResultFunc taskserver.GetSubtaskResultFunc, startSubtaskFunc taskserver.StartSubtaskFunc) *taskserver.ServerHandler {
+			postTasksChan := make(chan taskserver.PostRegisterTasksRequestObject, 1)
+			h.mu.Lock()
+			h.postTasks[socket.Addr().String()] = postTasksChan
+			h.mu.Unlock()
+			return &taskserver.ServerHandler{
+				Socket: socket,
+				Input:  input,
+				Channels: taskserver.ServerChannels{
+					PostCallback: make(chan taskserver.PostCallbackRequestObject),
+					PostTasks:    postTasksChan,
+				},
+			}
+		},
+	}
+}
+
+func (h *sourceHarness) source(name string, taskNames ...string) orchestrator.Source {
+	tasks := make([]taskserver.Task, len(taskNames))
+	for i, taskName := range taskNames {
+		tasks[i] = taskserver.Task{Name: taskName}
+	}
+	return orchestrator.Source{
+		Name: name,
+		Exec: &fakeSdkExec{
+			startService: func(ctx context.Context, taskRunID string, socket string, mode orchestrator.Mode) (func() error, <-chan error, error) {
+				h.mu.Lock()
+				defer h.mu.Unlock()
+				if mode == orchestrator.ModeRegister {
+					h.postTasks[socket] <- taskserver.PostRegisterTasksRequestObject{
+						Body: &taskserver.PostRegisterTasksJSONRequestBody{Tasks: tasks},
+					}
+				} else {
+					h.runsBySource[name] = append(h.runsBySource[name], taskRunID)
+				}
+				return func() error { return nil }, neverExits(), nil
+			},
+		},
+	}
+}
+
+func newSourceHarness(t *testing.T, sources func(h *sourceHarness) []orchestrator.Source) *sourceHarness {
+	t.Helper()
+	ctx := context.Background()
+
+	socketTracker, err := orchestrator.NewSocketTracker(ctx)
+	require.NoError(t, err)
+
+	h := &sourceHarness{
+		postTasks:    make(map[string]chan taskserver.PostRegisterTasksRequestObject),
+		runsBySource: make(map[string][]string),
+	}
+	h.coordinator = orchestrator.NewCoordinator(
+		ctx,
+		store.NewTaskStore(),
+		nil,
+		socketTracker,
+		h.factory(),
+		&noopStatusReporter{},
+		orchestrator.WithSources(sources(h)...),
+	)
+	return h
+}
+
+func TestSources(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("routes runs to the source that registered the task", func(t *testing.T) {
+		h := newSourceHarness(t, func(h *sourceHarness) []orchestrator.Source {
+			return []orchestrator.Source{
+				h.source("python", "py-task"),
+				h.source("node", "ts-task", "other-ts-task"),
+			}
+		})
+
+		tasks, err := h.coordinator.PopulateTasks(ctx)
+		require.NoError(t, err)
+		require.Len(t, tasks, 3)
+		require.Equal(t, []string{"python", "node"}, h.coordinator.SourceNames())
+		require.Equal(t, "python", h.coordinator.TaskSource("py-task"))
+		require.Equal(t, "node", h.coordinator.TaskSource("ts-task"))
+		require.Equal(t, "", h.coordinator.TaskSource("missing"))
+
+		tsRun, err := h.coordinator.StartTask(ctx, "ts-task", []byte{}, nil)
+		require.NoError(t, err)
+		pyRun, err := h.coordinator.StartTask(ctx, "py-task", []byte{}, nil)
+		require.NoError(t, err)
+
+		h.mu.Lock()
+		defer h.mu.Unlock()
+		require.Equal(t, []string{tsRun.ID}, h.runsBySource["node"])
+		require.Equal(t, []string{pyRun.ID}, h.runsBySource["python"])
+	})
+
+	t.Run("rejects a task registered by two sources", func(t *testing.T) {
+		h := newSourceHarness(t, func(h *sourceHarness) []orchestrator.Source {
+			return []orchestrator.Source{
+				h.source("python", "shared"),
+				h.source("node", "shared"),
+			}
+		})
+
+		_, err := h.coordinator.PopulateTasks(ctx)
+		require.EqualError(t, err, `task "shared" is registered by both python and node`)
+	})
+
+	t.Run("names the source that failed to register", func(t *testing.T) {
+		h := newSourceHarness(t, func(h *sourceHarness) []orchestrator.Source {
+			return []orchestrator.Source{
+				h.source("python", "py-task"),
+				{
+					Name: "node",
+					Exec: &fakeSdkExec{
+						startService: func(ctx context.Context, taskRunID string, socket string, mode orchestrator.Mode) (func() error, <-chan error, error) {
+							done := make(chan error, 1)
+							done <- fmt.Errorf("exit status 1")
+							return func() error { return nil }, done, nil
+						},
+					},
+				},
+			}
+		})
+
+		_, err := h.coordinator.PopulateTasks(ctx)
+		require.ErrorContains(t, err, "node: start command exited before registering tasks: exit status 1")
+	})
+}
@@ -21,6 +21,9 @@ type Exec struct {
 	// inherited from the parent process but are themselves overridden by
 	// the SDK-managed vars set in StartService.
 	extraEnv []string
+	// dir is the working directory of each subprocess. Empty means the
+	// current directory.
+	dir string
 }
 
 type Mode string
@@ -47,8 +50,15 @@ func NewExec(logsStore *logs.LogStore, debug bool, command string, extraEnv []st
 	}
 }
 
+// InDir runs the command in dir instead of the current directory.
+func (e *Exec) InDir(dir string) *Exec {
+	e.dir = dir
+	return e
+}
+
 func (e *Exec) StartService(ctx context.Context, taskRunID string, socketPath string, mode Mode) (CleanupFunc, <-chan error, error) {
 	cmd := exec.CommandContext(ctx, e.command, e.args...)
+	cmd.Dir = e.dir
 	cmd.Env = append(os.Environ(), e.extraEnv...)
 	cmd.Env = append(cmd.Env, fmt.Sprintf(SocketPathEnv+"=%s", socketPath), fmt.Sprintf(ModeEnv+"=%s", mode))
 
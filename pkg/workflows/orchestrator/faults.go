@@ -0,0 +1,203 @@
+package orchestrator
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/render-oss/cli/pkg/workflows/store"
+)
+
+// FaultKind is a way an injected fault disrupts a task run.
+type FaultKind string
+
+const (
+	// FaultFail fails the attempt when the task reports its result, as if
+	// the task had raised an error. The task's code still runs to completion,
+	// so any side effects happen before the retry.
+	FaultFail FaultKind = "fail"
+	// FaultKill kills the task's process once the attempt has run for Delay.
+	FaultKill FaultKind = "kill"
+	// FaultDelayResult withholds a finished run's result from the task that
+	// started it until Delay after the run finished.
+	FaultDelayResult FaultKind = "delay-result"
+	// FaultDropCallback discards the result the task reports, as if it were
+	// lost in transit. The attempt fails once the process exits, or when it
+	// times out.
+	FaultDropCallback FaultKind = "drop-callback"
+)
+
+var faultKinds = []FaultKind{FaultFail, FaultKill, FaultDelayResult, FaultDropCallback}
+
+// Fault injects a failure into runs of a task, so that retry and idempotency
+// behavior can be exercised locally.
+type Fault struct {
+	// ID is assigned when the fault is added.
+	ID   string
+	Task string
+	Kind FaultKind
+	// Attempt is the 1-indexed attempt the fault applies to. Zero applies
+	// it to every attempt.
+	Attempt int
+	// Delay is how long FaultKill waits before killing the process, and how
+	// long FaultDelayResult withholds a result. Other kinds ignore it.
+	Delay time.Duration
+}
+
+// Validate reports whether the fault can be injected.
+func (f Fault) Validate() error {
+	if f.Task == "" {
+		return errors.New("fault task is required")
+	}
+	if !slices.Contains(faultKinds, f.Kind) {
+		return fmt.Errorf("unknown fault kind %q: must be one of %s", f.Kind, strings.Join(faultKindNames(), ", "))
+	}
+	if f.Attempt < 0 {
+		return errors.New("fault attempt must not be negative")
+	}
+	if f.Delay < 0 {
+		return errors.New("fault delay must not be negative")
+	}
+	if f.Kind == FaultDelayResult && f.Delay == 0 {
+		return errors.New("delay-result fault requires a delay")
+	}
+	return nil
+}
+
+func (f Fault) appliesTo(taskName string, kind FaultKind, attempt int) bool {
+	return f.Task == taskName && f.Kind == kind && (f.Attempt == 0 || f.Attempt == attempt)
+}
+
+// String formats the fault as ParseFault accepts it.
+func (f Fault) String() string {
+	s := fmt.Sprintf("%s:%s", f.Kind, f.Task)
+	if f.Attempt > 0 {
+		s += fmt.Sprintf("@%d", f.Attempt)
+	}
+	if f.Delay > 0 {
+		s += fmt.Sprintf(":%s", f.Delay)
+	}
+	return s
+}
+
+// ParseFault parses a fault written as KIND:TASK[@ATTEMPT][:DELAY], for
+// example "fail:charge@1" or "kill:charge:2s".
+func ParseFault(spec string) (Fault, error) {
+	parts := strings.Split(spec, ":")
+	if len(parts) < 2 || len(parts) > 3 {
+		return Fault{}, fmt.Errorf("invalid fault %q: expected KIND:TASK[@ATTEMPT][:DELAY]", spec)
+	}
+
+	fault := Fault{Kind: FaultKind(parts[0])}
+	task, attempt, hasAttempt := strings.Cut(parts[1], "@")
+	fault.Task = task
+	if hasAttempt {
+		n, err := strconv.Atoi(attempt)
+		if err != nil || n < 1 {
+			return Fault{}, fmt.Errorf("invalid fault %q: attempt must be a positive number", spec)
+		}
+		fault.Attempt = n
+	}
+	if len(parts) == 3 {
+		delay, err := time.ParseDuration(parts[2])
+		if err != nil {
+			return Fault{}, fmt.Errorf("invalid fault %q: %w", spec, err)
+		}
+		fault.Delay = delay
+	}
+
+	if err := fault.Validate(); err != nil {
+		return Fault{}, fmt.Errorf("invalid fault %q: %w", spec, err)
+	}
+	return fault, nil
+}
+
+func faultKindNames() []string {
+	names := make([]string, len(faultKinds))
+	for i, kind := range faultKinds {
+		names[i] = string(kind)
+	}
+	return names
+}
+
+// Faults is the set of faults injected into task runs. It is safe for
+// concurrent use, so faults can be added and removed while runs are in
+// flight; each attempt sees the faults in place when it checks for them.
+type Faults struct {
+	mu     sync.Mutex
+	faults []Fault
+	nextID int
+}
+
+// NewFaults returns a fault set holding the given faults.
+func NewFaults(faults ...Fault) (*Faults, error) {
+	f := &Faults{}
+	for _, fault := range faults {
+		if _, err := f.Add(fault); err != nil {
+			return nil, err
+		}
+	}
+	return f, nil
+}
+
+// Add validates fault, assigns it an ID, and injects it into subsequent
+// attempts.
+func (f *Faults) Add(fault Fault) (Fault, error) {
+	if err := fault.Validate(); err != nil {
+		return Fault{}, err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.nextID++
+	fault.ID = fmt.Sprintf("fault-%d", f.nextID)
+	f.faults = append(f.faults, fault)
+	return fault, nil
+}
+
+// Remove removes the fault with the given ID, reporting whether it existed.
+func (f *Faults) Remove(id string) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	n := len(f.faults)
+	f.faults = slices.DeleteFunc(f.faults, func(fault Fault) bool {
+		return fault.ID == id
+	})
+	return len(f.faults) != n
+}
+
+// Clear removes every fault.
+func (f *Faults) Clear() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.faults = nil
+}
+
+// List returns the faults in the order they were added.
+func (f *Faults) List() []Fault {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return slices.Clone(f.faults)
+}
+
+// match returns the first fault of kind that applies to the given attempt
+// of taskName.
+func (f *Faults) match(taskName string, kind FaultKind, attempt int) (Fault, bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, fault := range f.faults {
+		if fault.appliesTo(taskName, kind, attempt) {
+			return fault, true
+		}
+	}
+	return Fault{}, false
+}
+
+// attemptNumber returns the 1-indexed number of the run's current attempt.
+func attemptNumber(taskRun *store.TaskRun) int {
+	return len(taskRun.Attempts) + 1
+}
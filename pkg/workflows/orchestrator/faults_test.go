@@ -0,0 +1,96 @@
+package orchestrator_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/render-oss/cli/pkg/workflows/orchestrator"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFault(t *testing.T) {
+	tests := []struct {
+		spec    string
+		want    orchestrator.Fault
+		wantErr string
+	}{
+		{
+			spec: "fail:charge",
+			want: orchestrator.Fault{Task: "charge", Kind: orchestrator.FaultFail},
+		},
+		{
+			spec: "fail:charge@2",
+			want: orchestrator.Fault{Task: "charge", Kind: orchestrator.FaultFail, Attempt: 2},
+		},
+		{
+			spec: "kill:sync@1:1.5s",
+			want: orchestrator.Fault{Task: "sync", Kind: orchestrator.FaultKill, Attempt: 1, Delay: 1500 * time.Millisecond},
+		},
+		{
+			spec: "delay-result:fetch:5s",
+			want: orchestrator.Fault{Task: "fetch", Kind: orchestrator.FaultDelayResult, Delay: 5 * time.Second},
+		},
+		{
+			spec:    "charge",
+			wantErr: `invalid fault "charge": expected KIND:TASK[@ATTEMPT][:DELAY]`,
+		},
+		{
+			spec:    "explode:charge",
+			wantErr: `invalid fault "explode:charge": unknown fault kind "explode": must be one of fail, kill, delay-result, drop-callback`,
+		},
+		{
+			spec:    "fail:charge@0",
+			wantErr: `invalid fault "fail:charge@0": attempt must be a positive number`,
+		},
+		{
+			spec:    "fail:@1",
+			wantErr: `invalid fault "fail:@1": fault task is required`,
+		},
+		{
+			spec:    "delay-result:fetch",
+			wantErr: `invalid fault "delay-result:fetch": delay-result fault requires a delay`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.spec, func(t *testing.T) {
+			got, err := orchestrator.ParseFault(tt.spec)
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+			require.Equal(t, tt.spec, got.String())
+		})
+	}
+}
+
+func TestFaults(t *testing.T) {
+	faults, err := orchestrator.NewFaults(orchestrator.Fault{Task: "charge", Kind: orchestrator.FaultFail})
+	require.NoError(t, err)
+
+	added, err := faults.Add(orchestrator.Fault{Task: "sync", Kind: orchestrator.FaultKill, Delay: time.Second})
+	require.NoError(t, err)
+	require.Equal(t, "fault-2", added.ID)
+
+	_, err = faults.Add(orchestrator.Fault{Task: "sync", Kind: "explode"})
+	require.Error(t, err)
+
+	require.Equal(t, []string{"fault-1", "fault-2"}, faultIDs(faults.List()))
+
+	require.True(t, faults.Remove("fault-1"))
+	require.False(t, faults.Remove("fault-1"))
+	require.Equal(t, []string{"fault-2"}, faultIDs(faults.List()))
+
+	faults.Clear()
+	require.Empty(t, faults.List())
+}
+
+func faultIDs(faults []orchestrator.Fault) []string {
+	ids := make([]string, len(faults))
+	for i, fault := range faults {
+		ids[i] = fault.ID
+	}
+	return ids
+}
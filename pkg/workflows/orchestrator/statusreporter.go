@@ -65,6 +65,11 @@ func (r *PrintStatusReporter) TaskEnqueued(taskRun *store.TaskRun) {
 	r.print("%s enqueued: %s", r.taskLabel(taskRun), r.describeTaskRun(taskRun))
 }
 
+func (r *PrintStatusReporter) TaskQueued(taskRun *store.TaskRun, position int) {
+	status := renderstyle.Status.Foreground(renderstyle.ColorWarning).Render("Queued")
+	r.print("%s %s: %s position=%d", r.taskLabel(taskRun), status, r.describeTaskRun(taskRun), position)
+}
+
 func (r *PrintStatusReporter) TaskRunning(taskRun *store.TaskRun) {
 	r.print("%s running: %s", r.taskLabel(taskRun), r.describeTaskRun(taskRun))
 }
@@ -109,6 +114,9 @@ func (r *PrintStatusReporter) print(format string, args ...any) {
 
 func (r *PrintStatusReporter) describeTaskRun(taskRun *store.TaskRun) string {
 	desc := formatTaskRunDescriptor(taskRun)
+	if taskRun != nil && taskRun.RerunOfTaskRunID != nil {
+		desc = fmt.Sprintf("%s rerun-of=%s", desc, *taskRun.RerunOfTaskRunID)
+	}
 	if r.includeInputs {
 		desc = fmt.Sprintf("%s input=%s", desc, formatTaskRunInput(taskRun))
 	}
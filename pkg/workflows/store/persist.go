@@ -0,0 +1,146 @@
+package store
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/render-oss/cli/pkg/pointers"
+)
+
+const taskRunsFileName = "task-runs.jsonl"
+
+// InterruptedError is recorded on task runs that were still pending or running
+// when a previous dev server stopped.
+const InterruptedError = "interrupted: the dev server stopped before this task run finished"
+
+// NewPersistentTaskStore returns a TaskStore that saves task runs and their
+// attempts to stateDir and reloads them from there. Runs that were in flight
+// when the previous server stopped are marked as failed.
+//
+// Each change to a task run appends the run's full state as one line of a
+// JSONL file, so a transition costs one small write however many runs the
+// store holds. The file is compacted to one line per run on startup.
+func NewPersistentTaskStore(stateDir string) (*TaskStore, error) {
+	if err := os.MkdirAll(stateDir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create state directory: %w", err)
+	}
+
+	path := filepath.Join(stateDir, taskRunsFileName)
+	taskRuns, err := loadTaskRuns(path)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	for _, taskRun := range taskRuns {
+		if taskRun.Status == TaskRunStatusPending || taskRun.Status == TaskRunStatusRunning {
+			taskRun.Status = TaskRunStatusFailed
+			taskRun.Error = pointers.From(InterruptedError)
+			taskRun.CompletedAt = pointers.From(now)
+		}
+	}
+
+	if err := writeTaskRuns(path, taskRuns); err != nil {
+		return nil, err
+	}
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open task runs file: %w", err)
+	}
+
+	return &TaskStore{taskRuns: taskRuns, file: file}, nil
+}
+
+// loadTaskRuns replays the task runs file. A run's latest line replaces its
+// earlier ones; runs keep the order they were first written in.
+func loadTaskRuns(path string) ([]*TaskRun, error) {
+	file, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read task runs: %w", err)
+	}
+	defer file.Close()
+
+	var taskRuns []*TaskRun
+	byID := make(map[string]int)
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		var taskRun TaskRun
+		// A partially written last line is expected if the server was
+		// killed mid-write; skip it rather than refusing to start.
+		if err := json.Unmarshal(scanner.Bytes(), &taskRun); err != nil || taskRun.ID == "" {
+			continue
+		}
+		if i, ok := byID[taskRun.ID]; ok {
+			taskRuns[i] = &taskRun
+			continue
+		}
+		byID[taskRun.ID] = len(taskRuns)
+		taskRuns = append(taskRuns, &taskRun)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read task runs: %w", err)
+	}
+	return taskRuns, nil
+}
+
+// writeTaskRuns replaces the task runs file with one line per run.
+func writeTaskRuns(path string, taskRuns []*TaskRun) error {
+	var buf bytes.Buffer
+	for _, taskRun := range taskRuns {
+		data, err := json.Marshal(taskRun)
+		if err != nil {
+			return fmt.Errorf("failed to encode task runs: %w", err)
+		}
+		buf.Write(append(data, '\n'))
+	}
+
+	// Write to a temporary file and rename it so a crash mid-write never
+	// leaves a truncated state file behind.
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, buf.Bytes(), 0o644); err != nil {
+		return fmt.Errorf("failed to write task runs: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return fmt.Errorf("failed to write task runs: %w", err)
+	}
+	return nil
+}
+
+// persist appends the state of taskRun to the task runs file. Failures are
+// reported without interrupting the task run, since the in-memory state is
+// still correct. It is a no-op for in-memory stores. Callers must hold s.mu.
+func (s *TaskStore) persist(taskRun *TaskRun) {
+	if s.file == nil {
+		return
+	}
+	data, err := json.Marshal(taskRun)
+	if err == nil {
+		_, err = s.file.Write(append(data, '\n'))
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "warning: failed to write task run: %v\n", err)
+	}
+}
+
+// Close closes the task runs file of a persistent store. It is a no-op for
+// in-memory stores.
+func (s *TaskStore) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.file == nil {
+		return nil
+	}
+	err := s.file.Close()
+	s.file = nil
+	return err
+}
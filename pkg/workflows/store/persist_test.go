@@ -0,0 +1,113 @@
+package store_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/render-oss/cli/pkg/workflows/store"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPersistentTaskStore(t *testing.T) {
+	t.Run("reloads task runs and attempts", func(t *testing.T) {
+		dir := t.TempDir()
+
+		s, err := store.NewPersistentTaskStore(dir)
+		require.NoError(t, err)
+
+		run := s.StartTaskRun("test", []byte(`[1]`), nil)
+		_, err = s.FailTaskRun(run.ID, "boom")
+		require.NoError(t, err)
+		_, err = s.RetryTaskRun(run.ID)
+		require.NoError(t, err)
+		_, err = s.MarkRunningTaskRun(run.ID)
+		require.NoError(t, err)
+		_, err = s.CompleteTaskRun(run.ID, []byte(`"ok"`))
+		require.NoError(t, err)
+
+		reloaded, err := store.NewPersistentTaskStore(dir)
+		require.NoError(t, err)
+
+		got := reloaded.GetTaskRun(run.ID)
+		require.NotNil(t, got)
+		require.Equal(t, store.TaskRunStatusComplete, got.Status)
+		require.JSONEq(t, `[1]`, string(got.Input))
+		require.JSONEq(t, `"ok"`, string(got.Output))
+		require.Equal(t, run.ID, got.RootTaskRunID)
+		require.Len(t, got.Attempts, 1)
+		require.Equal(t, "boom", *got.Attempts[0].Error)
+	})
+
+	t.Run("marks in-flight task runs as interrupted", func(t *testing.T) {
+		dir := t.TempDir()
+
+		s, err := store.NewPersistentTaskStore(dir)
+		require.NoError(t, err)
+		run := s.StartTaskRun("test", nil, nil)
+
+		reloaded, err := store.NewPersistentTaskStore(dir)
+		require.NoError(t, err)
+
+		got := reloaded.GetTaskRun(run.ID)
+		require.Equal(t, store.TaskRunStatusFailed, got.Status)
+		require.Equal(t, store.InterruptedError, *got.Error)
+		require.NotNil(t, got.CompletedAt)
+	})
+
+	t.Run("compacts the state file on startup", func(t *testing.T) {
+		dir := t.TempDir()
+
+		s, err := store.NewPersistentTaskStore(dir)
+		require.NoError(t, err)
+		first := s.StartTaskRun("test", nil, nil)
+		_, err = s.CompleteTaskRun(first.ID, nil)
+		require.NoError(t, err)
+		second := s.StartTaskRun("test", nil, nil)
+		_, err = s.CompleteTaskRun(second.ID, nil)
+		require.NoError(t, err)
+		require.NoError(t, s.Close())
+		require.Len(t, readLines(t, filepath.Join(dir, "task-runs.jsonl")), 4)
+
+		reloaded, err := store.NewPersistentTaskStore(dir)
+		require.NoError(t, err)
+		defer reloaded.Close()
+
+		require.Len(t, readLines(t, filepath.Join(dir, "task-runs.jsonl")), 2)
+		runs := reloaded.GetAllTaskRuns()
+		require.Len(t, runs, 2)
+		require.Equal(t, first.ID, runs[0].ID)
+		require.Equal(t, second.ID, runs[1].ID)
+	})
+
+	t.Run("skips a partially written last line", func(t *testing.T) {
+		dir := t.TempDir()
+
+		s, err := store.NewPersistentTaskStore(dir)
+		require.NoError(t, err)
+		run := s.StartTaskRun("test", nil, nil)
+		_, err = s.CompleteTaskRun(run.ID, nil)
+		require.NoError(t, err)
+		require.NoError(t, s.Close())
+
+		file, err := os.OpenFile(filepath.Join(dir, "task-runs.jsonl"), os.O_WRONLY|os.O_APPEND, 0o644)
+		require.NoError(t, err)
+		_, err = file.WriteString(`{"ID":"` + run.ID + `","Status":"runn`)
+		require.NoError(t, err)
+		require.NoError(t, file.Close())
+
+		reloaded, err := store.NewPersistentTaskStore(dir)
+		require.NoError(t, err)
+		defer reloaded.Close()
+
+		require.Equal(t, store.TaskRunStatusComplete, reloaded.GetTaskRun(run.ID).Status)
+	})
+}
+
+func readLines(t *testing.T, path string) []string {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	return strings.Split(strings.TrimSuffix(string(data), "\n"), "\n")
+}
@@ -3,6 +3,7 @@ package store
 import (
 	"encoding/json"
 	"fmt"
+	"os"
 	"slices"
 	"strings"
 	"sync"
@@ -17,6 +18,9 @@ type Task struct {
 	Name        string
 	CreatedAt   time.Time
 	RetryConfig *taskserver.RetryConfig
+	// Timeout is the maximum run time of a single attempt as registered by
+	// the SDK. Zero means no limit.
+	Timeout time.Duration
 }
 
 type TaskAttempt struct {
@@ -24,6 +28,7 @@ type TaskAttempt struct {
 	CompletedAt *time.Time
 	Status      TaskRunStatus
 	Error       *string
+	TimedOut    bool
 }
 
 type TaskRun struct {
@@ -33,6 +38,9 @@ type TaskRun struct {
 	Output   json.RawMessage
 	Status   TaskRunStatus
 	Error    *string
+	// TimedOut is set when the current attempt failed because it ran past
+	// its timeout.
+	TimedOut bool
 
 	// StartedAt is when the run first began; it never changes once set.
 	StartedAt *time.Time
@@ -44,6 +52,8 @@ type TaskRun struct {
 	// RootTaskRunID is the ID of the top-level task run that initiated this
 	// chain of subtasks. For root tasks, this equals ID.
 	RootTaskRunID string
+	// RerunOfTaskRunID is the ID of the task run this run re-ran, if any.
+	RerunOfTaskRunID *string
 
 	// Attempts holds the history of previous (failed) attempts for this task
 	// run. The current attempt's state is represented by the top-level fields.
@@ -63,7 +73,11 @@ const (
 type TaskStore struct {
 	tasks        map[string]*Task
 	taskRuns     []*TaskRun
-	taskRunChans []chan *TaskRun
+	taskRunChans []taskRunChan
+
+	// file is the JSONL file task run changes are appended to. It is nil for
+	// in-memory stores.
+	file *os.File
 
 	mu sync.Mutex
 }
@@ -83,15 +97,18 @@ func (s *TaskStore) SetTasks(tasks []taskserver.Task) {
 		if task.Options != nil {
 			retryConfig = task.Options.Retry
 		}
+		timeout := task.Options.GetTimeout()
 		if existing, ok := s.tasks[task.Name]; !ok {
 			newTasks[task.Name] = &Task{
 				ID:          NewTaskID(),
 				Name:        task.Name,
 				CreatedAt:   time.Now(),
 				RetryConfig: retryConfig,
+				Timeout:     timeout,
 			}
 		} else {
 			existing.RetryConfig = retryConfig
+			existing.Timeout = timeout
 			newTasks[task.Name] = existing
 		}
 	}
@@ -100,6 +117,16 @@ func (s *TaskStore) SetTasks(tasks []taskserver.Task) {
 }
 
 func (s *TaskStore) StartTaskRun(taskName string, input []byte, parentTaskRunID *string) *TaskRun {
+	return s.startTaskRun(taskName, input, parentTaskRunID, nil)
+}
+
+// StartRerunTaskRun starts a new root task run of the same task as original,
+// linked to it through RerunOfTaskRunID.
+func (s *TaskStore) StartRerunTaskRun(original *TaskRun, input []byte) *TaskRun {
+	return s.startTaskRun(original.TaskName, input, nil, &original.ID)
+}
+
+func (s *TaskStore) startTaskRun(taskName string, input []byte, parentTaskRunID *string, rerunOfTaskRunID *string) *TaskRun {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
@@ -124,11 +151,13 @@ func (s *TaskStore) StartTaskRun(taskName string, input []byte, parentTaskRunID
 		StartedAt:        &now,
 		AttemptStartedAt: &now,
 
-		ParentTaskRunID: parentTaskRunID,
-		RootTaskRunID:   rootTaskRunID,
+		ParentTaskRunID:  parentTaskRunID,
+		RootTaskRunID:    rootTaskRunID,
+		RerunOfTaskRunID: rerunOfTaskRunID,
 	}
 
 	s.taskRuns = append(s.taskRuns, taskRun)
+	s.persist(taskRun)
 
 	return taskRun
 }
@@ -143,6 +172,7 @@ func (s *TaskStore) updateTaskRun(taskRunID string, output []byte, errString *st
 			taskRun.Status = status
 			taskRun.Error = errString
 			taskRun.CompletedAt = pointers.From(time.Now())
+			s.persist(taskRun)
 
 			return taskRun, nil
 		}
@@ -173,6 +203,28 @@ func (s *TaskStore) FailTaskRun(taskRunID string, errString string) (*TaskRun, e
 	return taskRun, nil
 }
 
+// TimeOutTaskRun fails the current attempt of a task run because it ran
+// longer than timeout.
+func (s *TaskStore) TimeOutTaskRun(taskRunID string, timeout time.Duration) (*TaskRun, error) {
+	s.mu.Lock()
+	taskRun := s.getTaskRun(taskRunID)
+	if taskRun == nil {
+		s.mu.Unlock()
+		return nil, fmt.Errorf("task run not found")
+	}
+	taskRun.Output = nil
+	taskRun.Status = TaskRunStatusFailed
+	taskRun.Error = pointers.From(fmt.Sprintf("task run timed out after %s", timeout))
+	taskRun.TimedOut = true
+	taskRun.CompletedAt = pointers.From(time.Now())
+	s.persist(taskRun)
+	s.mu.Unlock()
+
+	s.sendResultsToChannels(taskRun)
+
+	return taskRun, nil
+}
+
 // RetryTaskRun archives the current (failed) attempt into Attempts, resets
 // the per-attempt state, and transitions the task run to pending so it can
 // await its next attempt. StartedAt is preserved.
@@ -193,13 +245,34 @@ func (s *TaskStore) RetryTaskRun(taskRunID string) (*TaskRun, error) {
 		CompletedAt: taskRun.CompletedAt,
 		Status:      taskRun.Status,
 		Error:       taskRun.Error,
+		TimedOut:    taskRun.TimedOut,
 	})
 
 	taskRun.Status = TaskRunStatusPending
 	taskRun.Error = nil
+	taskRun.TimedOut = false
 	taskRun.CompletedAt = nil
 	taskRun.Output = nil
 	taskRun.AttemptStartedAt = nil
+	s.persist(taskRun)
+
+	return taskRun, nil
+}
+
+// QueueTaskRun moves a task run that is waiting for a concurrency slot to
+// pending. Its attempt start time is cleared until it is launched.
+func (s *TaskStore) QueueTaskRun(taskRunID string) (*TaskRun, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	taskRun := s.getTaskRun(taskRunID)
+	if taskRun == nil {
+		return nil, fmt.Errorf("task run not found")
+	}
+
+	taskRun.Status = TaskRunStatusPending
+	taskRun.AttemptStartedAt = nil
+	s.persist(taskRun)
 
 	return taskRun, nil
 }
@@ -218,6 +291,7 @@ func (s *TaskStore) MarkRunningTaskRun(taskRunID string) (*TaskRun, error) {
 	now := time.Now()
 	taskRun.Status = TaskRunStatusRunning
 	taskRun.AttemptStartedAt = &now
+	s.persist(taskRun)
 
 	return taskRun, nil
 }
@@ -338,22 +412,41 @@ func (s *TaskStore) GetTaskByName(taskName string) *Task {
 	return nil
 }
 
+// taskRunChan is a channel subscribed to finished task runs. done is closed
+// when the channel is removed, so a send to a subscriber that has stopped
+// receiving doesn't block forever.
+type taskRunChan struct {
+	ch   chan *TaskRun
+	done chan struct{}
+}
+
 func (s *TaskStore) AddTaskRunChan(ch chan *TaskRun) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
-	s.taskRunChans = append(s.taskRunChans, ch)
+	s.taskRunChans = append(s.taskRunChans, taskRunChan{ch: ch, done: make(chan struct{})})
 }
 
 func (s *TaskStore) RemoveTaskRunChan(ch chan *TaskRun) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
-	s.taskRunChans = slices.DeleteFunc(s.taskRunChans, func(c chan *TaskRun) bool {
-		return c == ch
+	s.taskRunChans = slices.DeleteFunc(s.taskRunChans, func(c taskRunChan) bool {
+		if c.ch != ch {
+			return false
+		}
+		close(c.done)
+		return true
 	})
 }
 
 func (s *TaskStore) sendResultsToChannels(result *TaskRun) {
-	for _, ch := range s.taskRunChans {
-		ch <- result
+	s.mu.Lock()
+	chans := slices.Clone(s.taskRunChans)
+	s.mu.Unlock()
+
+	for _, c := range chans {
+		select {
+		case c.ch <- result:
+		case <-c.done:
+		}
 	}
 }
@@ -3,7 +3,9 @@ package store_test
 import (
 	"encoding/json"
 	"testing"
+	"time"
 
+	"github.com/render-oss/cli/pkg/pointers"
 	"github.com/render-oss/cli/pkg/workflows/store"
 	"github.com/render-oss/cli/pkg/workflows/taskserver"
 	"github.com/stretchr/testify/require"
@@ -130,6 +132,38 @@ func TestTaskRun(t *testing.T) {
 		require.Equal(t, store.TaskRunStatusFailed, taskRuns[0].Status)
 		require.Equal(t, "err", *taskRuns[0].Error)
 	})
+
+	t.Run("can time out task run", func(t *testing.T) {
+		s := store.NewTaskStore()
+
+		s.SetTasks([]taskserver.Task{
+			{
+				Name:    "test",
+				Options: &taskserver.TaskOptions{TimeoutSeconds: pointers.From(30)},
+			},
+		})
+
+		tasks := s.GetTasks()
+		require.Equal(t, 30*time.Second, tasks[0].Timeout)
+
+		taskRun := s.StartTaskRun(tasks[0].Name, []byte{}, nil)
+		_, err := s.TimeOutTaskRun(taskRun.ID, tasks[0].Timeout)
+		require.NoError(t, err)
+
+		got := s.GetTaskRun(taskRun.ID)
+		require.Equal(t, store.TaskRunStatusFailed, got.Status)
+		require.True(t, got.TimedOut)
+		require.Equal(t, "task run timed out after 30s", *got.Error)
+
+		// Retrying archives the timeout on the attempt and clears it from
+		// the run.
+		_, err = s.RetryTaskRun(taskRun.ID)
+		require.NoError(t, err)
+		got = s.GetTaskRun(taskRun.ID)
+		require.False(t, got.TimedOut)
+		require.Len(t, got.Attempts, 1)
+		require.True(t, got.Attempts[0].TimedOut)
+	})
 }
 
 func TestGetTaskRunsByName(t *testing.T) {
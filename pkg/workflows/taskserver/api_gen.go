@@ -81,11 +81,6 @@ type TaskError struct {
 	StackTrace *string `json:"stack_trace,omitempty"`
 }
 
-// TaskOptions defines model for TaskOptions.
-type TaskOptions struct {
-	Retry *RetryConfig `json:"retry,omitempty"`
-}
-
 // Tasks defines model for Tasks.
 type Tasks struct {
 	Tasks []Task `json:"tasks"`
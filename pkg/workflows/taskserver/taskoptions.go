@@ -0,0 +1,22 @@
+package taskserver
+
+import "time"
+
+// TaskOptions are the options a task registers with. It is excluded from code
+// generation (see oapi-generate.yaml) so it can carry TimeoutSeconds, which
+// the task server spec doesn't model.
+type TaskOptions struct {
+	Retry *RetryConfig `json:"retry,omitempty"`
+
+	// TimeoutSeconds is the maximum run time of a single attempt, in seconds.
+	TimeoutSeconds *int `json:"timeout_seconds,omitempty"`
+}
+
+// GetTimeout returns the maximum run time of a single attempt, or 0 when the
+// receiver or TimeoutSeconds is nil, meaning no limit.
+func (o *TaskOptions) GetTimeout() time.Duration {
+	if o == nil || o.TimeoutSeconds == nil || *o.TimeoutSeconds <= 0 {
+		return 0
+	}
+	return time.Duration(*o.TimeoutSeconds) * time.Second
+}
@@ -0,0 +1,30 @@
+package taskserver_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/render-oss/cli/pkg/workflows/taskserver"
+	"github.com/stretchr/testify/require"
+)
+
+// TestTaskOptionsRegistration decodes a task registration body, so renaming a
+// field breaks here rather than silently dropping the option.
+func TestTaskOptionsRegistration(t *testing.T) {
+	body := `{"tasks":[
+		{"name":"with-options","options":{"retry":{"max_retries":2,"wait_duration_ms":100},"timeout_seconds":30}},
+		{"name":"without-options"}
+	]}`
+
+	var tasks taskserver.PostRegisterTasksJSONRequestBody
+	require.NoError(t, json.Unmarshal([]byte(body), &tasks))
+	require.Len(t, tasks.Tasks, 2)
+
+	options := tasks.Tasks[0].Options
+	require.Equal(t, 30*time.Second, options.GetTimeout())
+	require.Equal(t, 2, options.Retry.GetMaxRetries())
+	require.Equal(t, int64(100), options.Retry.GetWaitDurationMs())
+
+	require.Zero(t, tasks.Tasks[1].Options.GetTimeout())
+}
@@ -0,0 +1,359 @@
+package testharness
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/render-oss/cli/pkg/workflows/logs"
+	"github.com/render-oss/cli/pkg/workflows/store"
+)
+
+const (
+	defaultTimeout      = time.Minute
+	defaultPollInterval = 50 * time.Millisecond
+	// maxFailureLogLines caps how many log lines a failed scenario reports.
+	maxFailureLogLines = 50
+)
+
+// Orchestrator starts and cancels task runs. The orchestrator.Coordinator
+// implements it.
+type Orchestrator interface {
+	StartTask(ctx context.Context, taskSlug string, input []byte, parent *store.TaskRun) (*store.TaskRun, error)
+	CancelTaskRun(taskRunID string) error
+}
+
+// Runner runs scenarios one at a time, so that each scenario's subtask runs
+// are its own and results don't depend on scheduling.
+type Runner struct {
+	orchestrator Orchestrator
+	store        *store.TaskStore
+	logs         *logs.LogStore
+
+	timeout      time.Duration
+	pollInterval time.Duration
+}
+
+type RunnerOption func(*Runner)
+
+// WithTimeout sets how long a scenario may take before its run is canceled
+// and it fails. Scenarios can override it.
+func WithTimeout(d time.Duration) RunnerOption {
+	return func(r *Runner) {
+		r.timeout = d
+	}
+}
+
+// WithPollInterval sets how often the store is checked for finished runs.
+func WithPollInterval(d time.Duration) RunnerOption {
+	return func(r *Runner) {
+		r.pollInterval = d
+	}
+}
+
+// NewRunner returns a Runner that starts runs with o and reads their results
+// from s. Logs of failed scenarios are read from logStore, which may be nil.
+func NewRunner(o Orchestrator, s *store.TaskStore, logStore *logs.LogStore, opts ...RunnerOption) *Runner {
+	r := &Runner{
+		orchestrator: o,
+		store:        s,
+		logs:         logStore,
+		timeout:      defaultTimeout,
+		pollInterval: defaultPollInterval,
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+// Report is the outcome of running a suite.
+type Report struct {
+	Results  []*Result     `json:"results"`
+	Passed   int           `json:"passed"`
+	Failed   int           `json:"failed"`
+	Duration time.Duration `json:"-"`
+	// DurationSeconds is Duration for JSON output.
+	DurationSeconds float64 `json:"durationSeconds"`
+}
+
+// Result is the outcome of one scenario.
+type Result struct {
+	Name      string        `json:"name"`
+	Task      string        `json:"task"`
+	TaskRunID string        `json:"taskRunId,omitempty"`
+	Passed    bool          `json:"passed"`
+	Failures  []string      `json:"failures,omitempty"`
+	Duration  time.Duration `json:"-"`
+	// DurationSeconds is Duration for JSON output.
+	DurationSeconds float64 `json:"durationSeconds"`
+	// Logs are the last lines logged by the scenario's runs, kept only when
+	// it failed.
+	Logs []string `json:"logs,omitempty"`
+}
+
+// Run runs every scenario in suite in order.
+func (r *Runner) Run(ctx context.Context, suite *Suite) *Report {
+	start := time.Now()
+	report := &Report{}
+	for _, scenario := range suite.Scenarios {
+		result := r.runScenario(ctx, scenario)
+		report.Results = append(report.Results, result)
+		if result.Passed {
+			report.Passed++
+		} else {
+			report.Failed++
+		}
+	}
+	report.Duration = time.Since(start)
+	report.DurationSeconds = report.Duration.Seconds()
+	return report
+}
+
+func (r *Runner) runScenario(ctx context.Context, scenario Scenario) *Result {
+	start := time.Now()
+	result := &Result{Name: scenario.Name, Task: scenario.Task}
+	defer func() {
+		result.Passed = len(result.Failures) == 0
+		result.Duration = time.Since(start)
+		result.DurationSeconds = result.Duration.Seconds()
+	}()
+
+	input, err := inputJSON(scenario.Input)
+	if err != nil {
+		result.Failures = append(result.Failures, err.Error())
+		return result
+	}
+
+	timeout := r.timeout
+	if scenario.Timeout != "" {
+		// ParseSuite has already validated the timeout.
+		timeout, _ = time.ParseDuration(scenario.Timeout)
+	}
+
+	root, err := r.orchestrator.StartTask(ctx, scenario.Task, input, nil)
+	if err != nil {
+		result.Failures = append(result.Failures, fmt.Sprintf("failed to start task: %s", err))
+		return result
+	}
+	result.TaskRunID = root.ID
+
+	runs, err := r.wait(ctx, root.ID, timeout)
+	if err != nil {
+		_ = r.orchestrator.CancelTaskRun(root.ID)
+		result.Failures = append(result.Failures, err.Error())
+	} else {
+		result.Failures = append(result.Failures, check(scenario.Expect, runs)...)
+	}
+
+	if len(result.Failures) > 0 {
+		result.Logs = r.logLines(runs)
+	}
+	return result
+}
+
+// wait polls until the run rootID and all of its subtask runs have settled,
+// returning them with the root first.
+func (r *Runner) wait(ctx context.Context, rootID string, timeout time.Duration) ([]*store.TaskRun, error) {
+	deadline := time.NewTimer(timeout)
+	defer deadline.Stop()
+	ticker := time.NewTicker(r.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		runs, settled := r.treeRuns(rootID)
+		if settled {
+			return runs, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return runs, ctx.Err()
+		case <-deadline.C:
+			return runs, fmt.Errorf("timed out after %s waiting for the task run to finish", timeout)
+		case <-ticker.C:
+		}
+	}
+}
+
+// treeRuns returns the run rootID and its subtask runs, and whether they
+// have all settled.
+func (r *Runner) treeRuns(rootID string) ([]*store.TaskRun, bool) {
+	var runs []*store.TaskRun
+	settled := true
+	for _, run := range r.store.GetAllTaskRuns() {
+		if run.RootTaskRunID != rootID {
+			continue
+		}
+		if run.ID == rootID {
+			runs = append([]*store.TaskRun{run}, runs...)
+		} else {
+			runs = append(runs, run)
+		}
+		settled = settled && r.isSettled(run)
+	}
+	return runs, settled && len(runs) > 0
+}
+
+// isSettled reports whether run has finished for good. A failed run that
+// will be retried is briefly marked failed before its retry is scheduled, so
+// it only counts as settled once its retries are used up.
+func (r *Runner) isSettled(run *store.TaskRun) bool {
+	switch run.Status {
+	case store.TaskRunStatusComplete, store.TaskRunStatusCanceled:
+		return true
+	case store.TaskRunStatusFailed:
+		task := r.store.GetTaskByName(run.TaskName)
+		return task == nil || !task.RetryConfig.ShouldRetry(len(run.Attempts))
+	}
+	return false
+}
+
+// check compares settled runs, root first, against expect and describes
+// every mismatch.
+func check(expect Expectation, runs []*store.TaskRun) []string {
+	root := runs[0]
+	var failures []string
+
+	if expect.Error != nil {
+		if root.Status != store.TaskRunStatusFailed {
+			failures = append(failures, fmt.Sprintf("expected the task run to fail, but it %s", describeStatus(root)))
+		} else if got := errorString(root); !strings.Contains(got, *expect.Error) {
+			failures = append(failures, fmt.Sprintf("expected error containing %q, got %q", *expect.Error, got))
+		}
+	} else if root.Status != store.TaskRunStatusComplete {
+		failures = append(failures, fmt.Sprintf("expected the task run to complete, but it %s", describeStatus(root)))
+	}
+
+	if expect.Output != nil && root.Status == store.TaskRunStatusComplete {
+		if !outputMatches(expect.Output, root.Output) {
+			failures = append(failures, fmt.Sprintf("expected output %s, got %s", compactJSON(expect.Output), string(root.Output)))
+		}
+	}
+
+	if expect.Subtasks != nil {
+		failures = append(failures, checkSubtasks(*expect.Subtasks, root, runs[1:])...)
+	}
+
+	return failures
+}
+
+// checkSubtasks matches each expected call with a distinct subtask run
+// started directly by root.
+func checkSubtasks(expected []SubtaskCall, root *store.TaskRun, runs []*store.TaskRun) []string {
+	var children []*store.TaskRun
+	for _, run := range runs {
+		if run.ParentTaskRunID != nil && *run.ParentTaskRunID == root.ID {
+			children = append(children, run)
+		}
+	}
+
+	var failures []string
+	matched := make([]bool, len(children))
+	for _, call := range expected {
+		found := false
+		for i, child := range children {
+			if matched[i] || child.TaskName != call.Task {
+				continue
+			}
+			if call.Input != nil && !jsonEqual(call.Input, child.Input) {
+				continue
+			}
+			matched[i] = true
+			found = true
+			break
+		}
+		if !found {
+			failures = append(failures, fmt.Sprintf("expected subtask call %s was not made", describeCall(call.Task, call.Input)))
+		}
+	}
+	for i, child := range children {
+		if !matched[i] {
+			failures = append(failures, fmt.Sprintf("unexpected subtask call %s(%s)", child.TaskName, string(child.Input)))
+		}
+	}
+	return failures
+}
+
+func describeStatus(run *store.TaskRun) string {
+	switch run.Status {
+	case store.TaskRunStatusFailed:
+		return fmt.Sprintf("failed: %s", errorString(run))
+	case store.TaskRunStatusComplete:
+		return fmt.Sprintf("completed with output %s", string(run.Output))
+	default:
+		return fmt.Sprintf("was %s", run.Status)
+	}
+}
+
+func describeCall(task string, input any) string {
+	if input == nil {
+		return task
+	}
+	return fmt.Sprintf("%s(%s)", task, compactJSON(input))
+}
+
+func errorString(run *store.TaskRun) string {
+	if run.Error == nil {
+		return ""
+	}
+	return *run.Error
+}
+
+// outputMatches compares an expected output with a run's results, allowing
+// a single result to be expected without the surrounding list.
+func outputMatches(expected any, output json.RawMessage) bool {
+	if jsonEqual(expected, output) {
+		return true
+	}
+	var results []json.RawMessage
+	if json.Unmarshal(output, &results) == nil && len(results) == 1 {
+		return jsonEqual(expected, results[0])
+	}
+	return false
+}
+
+// jsonEqual compares a value decoded from a scenario file with raw JSON by
+// round-tripping the value through JSON, so that numbers compare equal
+// regardless of how they were decoded.
+func jsonEqual(expected any, actual json.RawMessage) bool {
+	var want, got any
+	data, err := json.Marshal(expected)
+	if err != nil || json.Unmarshal(data, &want) != nil {
+		return false
+	}
+	if json.Unmarshal(actual, &got) != nil {
+		return false
+	}
+	return reflect.DeepEqual(want, got)
+}
+
+func compactJSON(v any) string {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(data)
+}
+
+func (r *Runner) logLines(runs []*store.TaskRun) []string {
+	if r.logs == nil || len(runs) == 0 {
+		return nil
+	}
+	ids := make([]string, len(runs))
+	for i, run := range runs {
+		ids[i] = run.ID
+	}
+
+	var lines []string
+	for _, log := range r.logs.GetLogs(logs.LogSearch{TaskRunID: ids}) {
+		lines = append(lines, strings.Split(strings.TrimRight(log.Message, "\n"), "\n")...)
+	}
+	if len(lines) > maxFailureLogLines {
+		lines = lines[len(lines)-maxFailureLogLines:]
+	}
+	return lines
+}
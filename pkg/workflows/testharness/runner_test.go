@@ -0,0 +1,179 @@
+package testharness_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/render-oss/cli/pkg/pointers"
+	"github.com/render-oss/cli/pkg/workflows/store"
+	"github.com/render-oss/cli/pkg/workflows/taskserver"
+	"github.com/render-oss/cli/pkg/workflows/testharness"
+)
+
+// fakeOrchestrator starts runs in a store and finishes them with behavior,
+// which is keyed by task name.
+type fakeOrchestrator struct {
+	store    *store.TaskStore
+	behavior map[string]func(o *fakeOrchestrator, run *store.TaskRun)
+	canceled []string
+}
+
+func (o *fakeOrchestrator) StartTask(_ context.Context, taskSlug string, input []byte, parent *store.TaskRun) (*store.TaskRun, error) {
+	behavior, ok := o.behavior[taskSlug]
+	if !ok {
+		return nil, errors.New("task not found")
+	}
+	var parentID *string
+	if parent != nil {
+		parentID = &parent.ID
+	}
+	run := o.store.StartTaskRun(taskSlug, input, parentID)
+	go behavior(o, run)
+	return run, nil
+}
+
+func (o *fakeOrchestrator) CancelTaskRun(taskRunID string) error {
+	o.canceled = append(o.canceled, taskRunID)
+	_, err := o.store.CancelTaskRun(taskRunID)
+	return err
+}
+
+func newFakeOrchestrator() *fakeOrchestrator {
+	s := store.NewTaskStore()
+	s.SetTasks([]taskserver.Task{{Name: "add"}, {Name: "square"}, {Name: "fan_out"}, {Name: "fail"}, {Name: "hang"}})
+	return &fakeOrchestrator{
+		store: s,
+		behavior: map[string]func(o *fakeOrchestrator, run *store.TaskRun){
+			"add": func(o *fakeOrchestrator, run *store.TaskRun) {
+				_, _ = o.store.CompleteTaskRun(run.ID, []byte(`[3]`))
+			},
+			"square": func(o *fakeOrchestrator, run *store.TaskRun) {
+				_, _ = o.store.CompleteTaskRun(run.ID, []byte(`[4]`))
+			},
+			"fan_out": func(o *fakeOrchestrator, run *store.TaskRun) {
+				_, _ = o.StartTask(context.Background(), "square", []byte(`[2]`), run)
+				_, _ = o.StartTask(context.Background(), "add", []byte(`[1,2]`), run)
+				_, _ = o.store.CompleteTaskRun(run.ID, []byte(`[]`))
+			},
+			"fail": func(o *fakeOrchestrator, run *store.TaskRun) {
+				_, _ = o.store.FailTaskRun(run.ID, "ValueError: negative input")
+			},
+			"hang": func(*fakeOrchestrator, *store.TaskRun) {},
+		},
+	}
+}
+
+func TestRunner(t *testing.T) {
+	o := newFakeOrchestrator()
+	suite, err := testharness.ParseSuite([]byte(`
+scenarios:
+  - name: output matches
+    task: add
+    input: [1, 2]
+    expect:
+      output: 3
+  - name: output differs
+    task: add
+    expect:
+      output: 4
+  - name: expected failure
+    task: fail
+    expect:
+      error: negative
+  - name: unexpected failure
+    task: fail
+  - name: subtasks match
+    task: fan_out
+    expect:
+      subtasks:
+        - task: add
+        - task: square
+          input: [2]
+  - name: subtasks differ
+    task: fan_out
+    expect:
+      subtasks:
+        - task: square
+          input: [3]
+  - name: times out
+    task: hang
+    timeout: 50ms
+  - name: unknown task
+    task: missing
+`))
+	require.NoError(t, err)
+
+	runner := testharness.NewRunner(o, o.store, nil, testharness.WithPollInterval(time.Millisecond))
+	report := runner.Run(context.Background(), suite)
+
+	byName := map[string]*testharness.Result{}
+	for _, result := range report.Results {
+		byName[result.Name] = result
+	}
+
+	assert.Equal(t, 3, report.Passed)
+	assert.Equal(t, 5, report.Failed)
+	assert.True(t, byName["output matches"].Passed)
+	assert.True(t, byName["expected failure"].Passed)
+	assert.True(t, byName["subtasks match"].Passed)
+
+	assert.Equal(t, []string{"expected output 4, got [3]"}, byName["output differs"].Failures)
+	assert.Equal(t, []string{"expected the task run to complete, but it failed: ValueError: negative input"}, byName["unexpected failure"].Failures)
+	assert.Equal(t, []string{
+		"expected subtask call square([3]) was not made",
+		"unexpected subtask call square([2])",
+		"unexpected subtask call add([1,2])",
+	}, byName["subtasks differ"].Failures)
+	assert.Contains(t, byName["times out"].Failures[0], "timed out after 50ms")
+	assert.Equal(t, []string{byName["times out"].TaskRunID}, o.canceled)
+	assert.Contains(t, byName["unknown task"].Failures[0], "failed to start task")
+}
+
+func TestRunnerWaitsForRetries(t *testing.T) {
+	o := newFakeOrchestrator()
+	o.store.SetTasks([]taskserver.Task{{Name: "flaky", Options: &taskserver.TaskOptions{Retry: &taskserver.RetryConfig{MaxRetries: pointers.From(1)}}}})
+	o.behavior["flaky"] = func(o *fakeOrchestrator, run *store.TaskRun) {
+		_, _ = o.store.FailTaskRun(run.ID, "first attempt fails")
+		// Leave the failed state visible for a while, as the coordinator
+		// does before it schedules the retry.
+		time.Sleep(20 * time.Millisecond)
+		_, _ = o.store.RetryTaskRun(run.ID)
+		_, _ = o.store.MarkRunningTaskRun(run.ID)
+		_, _ = o.store.CompleteTaskRun(run.ID, []byte(`["ok"]`))
+	}
+
+	suite, err := testharness.ParseSuite([]byte(`scenarios: [{task: flaky, expect: {output: ok}}]`))
+	require.NoError(t, err)
+
+	report := testharness.NewRunner(o, o.store, nil, testharness.WithPollInterval(time.Millisecond)).Run(context.Background(), suite)
+	require.Len(t, report.Results, 1)
+	assert.True(t, report.Results[0].Passed, "failures: %v", report.Results[0].Failures)
+}
+
+func TestWriteJUnit(t *testing.T) {
+	report := &testharness.Report{
+		Results: []*testharness.Result{
+			{Name: "passes", Task: "add", Passed: true, DurationSeconds: 0.5},
+			{Name: "fails", Task: "fail", Failures: []string{"expected <a> & <b>", "second"}, Logs: []string{"boom"}, DurationSeconds: 1},
+		},
+		Passed:          1,
+		Failed:          1,
+		DurationSeconds: 1.5,
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, testharness.WriteJUnit(&buf, "scenarios.yaml", report))
+	out := buf.String()
+
+	assert.Contains(t, out, `<testsuites tests="2" failures="1" time="1.500">`)
+	assert.Contains(t, out, `<testsuite name="scenarios.yaml" tests="2" failures="1" time="1.500">`)
+	assert.Contains(t, out, `<testcase name="passes" classname="add" time="0.500"></testcase>`)
+	assert.Contains(t, out, `<failure message="expected &lt;a&gt; &amp; &lt;b&gt;">expected &lt;a&gt; &amp; &lt;b&gt;&#xA;second</failure>`)
+	assert.Contains(t, out, `<system-out>boom</system-out>`)
+}
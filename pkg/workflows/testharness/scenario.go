@@ -0,0 +1,111 @@
+// Package testharness runs workflow tasks against the local orchestrator and
+// checks their results against scenarios, so task code can be tested in CI
+// without a running dev server.
+package testharness
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Suite is a list of scenarios loaded from a YAML or JSON file.
+type Suite struct {
+	Scenarios []Scenario `yaml:"scenarios" json:"scenarios"`
+}
+
+// Scenario runs one task with an input and describes what the run should
+// produce.
+type Scenario struct {
+	Name  string `yaml:"name" json:"name"`
+	Task  string `yaml:"task" json:"task"`
+	Input any    `yaml:"input" json:"input"`
+	// Timeout overrides the runner's timeout for this scenario, as a Go
+	// duration such as "30s".
+	Timeout string      `yaml:"timeout" json:"timeout"`
+	Expect  Expectation `yaml:"expect" json:"expect"`
+}
+
+// Expectation describes the outcome of a scenario's task run. Only the
+// fields that are set are checked, except that a run is always expected to
+// complete unless Error is set.
+type Expectation struct {
+	// Output is compared with the run's results. Tasks report their results
+	// as a list, so a single result may be given without the list.
+	Output any `yaml:"output" json:"output"`
+	// Error, when set, expects the run to fail with an error containing it.
+	// An empty string accepts any failure.
+	Error *string `yaml:"error" json:"error"`
+	// Subtasks, when set, are the subtask runs the task is expected to start
+	// directly, in any order.
+	Subtasks *[]SubtaskCall `yaml:"subtasks" json:"subtasks"`
+}
+
+// SubtaskCall is a subtask run a scenario expects its task to start. Input
+// is only checked when it is set.
+type SubtaskCall struct {
+	Task  string `yaml:"task" json:"task"`
+	Input any    `yaml:"input" json:"input"`
+}
+
+// LoadSuite reads and validates the scenarios in path. YAML is a superset of
+// JSON, so either format is accepted.
+func LoadSuite(path string) (*Suite, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read scenarios: %w", err)
+	}
+	return ParseSuite(data)
+}
+
+// ParseSuite parses and validates scenarios in YAML or JSON.
+func ParseSuite(data []byte) (*Suite, error) {
+	var suite Suite
+	if err := yaml.Unmarshal(data, &suite); err != nil {
+		return nil, fmt.Errorf("failed to parse scenarios: %w", err)
+	}
+	if len(suite.Scenarios) == 0 {
+		return nil, errors.New("no scenarios found")
+	}
+
+	names := map[string]bool{}
+	for i := range suite.Scenarios {
+		scenario := &suite.Scenarios[i]
+		if scenario.Task == "" {
+			return nil, fmt.Errorf("scenario %d: task is required", i+1)
+		}
+		if scenario.Name == "" {
+			scenario.Name = scenario.Task
+		}
+		if names[scenario.Name] {
+			return nil, fmt.Errorf("scenario %d: duplicate name %q", i+1, scenario.Name)
+		}
+		names[scenario.Name] = true
+		if scenario.Timeout != "" {
+			if _, err := time.ParseDuration(scenario.Timeout); err != nil {
+				return nil, fmt.Errorf("scenario %q: invalid timeout: %w", scenario.Name, err)
+			}
+		}
+		if _, err := inputJSON(scenario.Input); err != nil {
+			return nil, fmt.Errorf("scenario %q: %w", scenario.Name, err)
+		}
+	}
+	return &suite, nil
+}
+
+// inputJSON encodes a scenario input as task input: a list of positional
+// arguments or an object of named ones. A missing input means no arguments.
+func inputJSON(input any) ([]byte, error) {
+	switch input.(type) {
+	case nil:
+		return []byte("[]"), nil
+	case []any, map[string]any:
+		return json.Marshal(input)
+	default:
+		return nil, errors.New("input must be a list or an object")
+	}
+}
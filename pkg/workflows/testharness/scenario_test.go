@@ -0,0 +1,70 @@
+package testharness_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/render-oss/cli/pkg/workflows/testharness"
+)
+
+func TestParseSuite(t *testing.T) {
+	t.Run("parses YAML", func(t *testing.T) {
+		suite, err := testharness.ParseSuite([]byte(`
+scenarios:
+  - name: adds
+    task: add
+    input: [1, 2]
+    timeout: 5s
+    expect:
+      output: 3
+      subtasks:
+        - task: square
+  - task: fail
+    expect:
+      error: ""
+`))
+		require.NoError(t, err)
+		require.Len(t, suite.Scenarios, 2)
+
+		adds := suite.Scenarios[0]
+		assert.Equal(t, "adds", adds.Name)
+		assert.Equal(t, []any{1, 2}, adds.Input)
+		assert.Equal(t, 3, adds.Expect.Output)
+		require.NotNil(t, adds.Expect.Subtasks)
+		assert.Equal(t, "square", (*adds.Expect.Subtasks)[0].Task)
+		assert.Nil(t, adds.Expect.Error)
+
+		fail := suite.Scenarios[1]
+		assert.Equal(t, "fail", fail.Name, "name defaults to the task")
+		require.NotNil(t, fail.Expect.Error)
+		assert.Equal(t, "", *fail.Expect.Error)
+		assert.Nil(t, fail.Expect.Subtasks)
+	})
+
+	t.Run("parses JSON", func(t *testing.T) {
+		suite, err := testharness.ParseSuite([]byte(`{"scenarios": [{"task": "greet", "input": {"name": "Ada"}, "expect": {"output": "hi Ada"}}]}`))
+		require.NoError(t, err)
+		require.Len(t, suite.Scenarios, 1)
+		assert.Equal(t, map[string]any{"name": "Ada"}, suite.Scenarios[0].Input)
+	})
+
+	for name, tc := range map[string]struct {
+		input   string
+		wantErr string
+	}{
+		"no scenarios":      {input: `scenarios: []`, wantErr: "no scenarios"},
+		"missing task":      {input: `scenarios: [{name: a}]`, wantErr: "task is required"},
+		"duplicate names":   {input: `scenarios: [{task: a}, {task: a}]`, wantErr: "duplicate name"},
+		"invalid timeout":   {input: `scenarios: [{task: a, timeout: soon}]`, wantErr: "invalid timeout"},
+		"scalar input":      {input: `scenarios: [{task: a, input: 3}]`, wantErr: "input must be a list or an object"},
+		"malformed content": {input: `scenarios: {`, wantErr: "failed to parse"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := testharness.ParseSuite([]byte(tc.input))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.wantErr)
+		})
+	}
+}
@@ -0,0 +1,266 @@
+// Package watcher detects changes to the files of a local workflow project.
+//
+// It polls the project directories rather than relying on OS file notifications
+// so it behaves the same on every platform and across network filesystems.
+// Files matched by .gitignore rules are not watched.
+package watcher
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-git/go-git/v5/plumbing/format/gitignore"
+)
+
+const (
+	defaultInterval = 500 * time.Millisecond
+	defaultMaxDelay = 5 * time.Second
+)
+
+type fileState struct {
+	modTime time.Time
+	size    int64
+}
+
+type Watcher struct {
+	roots    []root
+	interval time.Duration
+	maxDelay time.Duration
+	ignored  []string
+}
+
+// root is a watched directory. Changes under it are reported relative to it,
+// prefixed with name when more than one directory is watched.
+type root struct {
+	name string
+	abs  string
+}
+
+type Option func(*Watcher)
+
+// WithInterval sets how often the project directories are scanned.
+func WithInterval(interval time.Duration) Option {
+	return func(w *Watcher) {
+		w.interval = interval
+	}
+}
+
+// WithMaxDelay caps how long changes are held back while the project keeps
+// changing, so a file that is rewritten on every scan, such as a log a
+// running process appends to, doesn't delay reloads forever.
+func WithMaxDelay(maxDelay time.Duration) Option {
+	return func(w *Watcher) {
+		w.maxDelay = maxDelay
+	}
+}
+
+// WithIgnoredPaths excludes paths from watching in addition to those matched
+// by .gitignore, e.g. a state directory the dev server writes to.
+func WithIgnoredPaths(paths ...string) Option {
+	return func(w *Watcher) {
+		for _, p := range paths {
+			if abs, err := filepath.Abs(p); err == nil {
+				w.ignored = append(w.ignored, abs)
+			}
+		}
+	}
+}
+
+// New returns a Watcher for the given directories. A directory inside another
+// one is watched as part of it.
+func New(dirs []string, opts ...Option) (*Watcher, error) {
+	if len(dirs) == 0 {
+		return nil, errors.New("no directories to watch")
+	}
+	roots := make([]root, 0, len(dirs))
+	for _, dir := range dirs {
+		abs, err := filepath.Abs(dir)
+		if err != nil {
+			return nil, err
+		}
+		roots = append(roots, root{name: dir, abs: abs})
+	}
+	// A directory sorts before the directories inside it.
+	slices.SortStableFunc(roots, func(a, b root) int { return strings.Compare(a.abs, b.abs) })
+
+	w := &Watcher{interval: defaultInterval, maxDelay: defaultMaxDelay}
+	for _, r := range roots {
+		if !slices.ContainsFunc(w.roots, func(other root) bool { return isWithin(r.abs, other.abs) }) {
+			w.roots = append(w.roots, r)
+		}
+	}
+	if len(w.roots) == 1 {
+		w.roots[0].name = ""
+	}
+	for _, opt := range opts {
+		opt(w)
+	}
+	return w, nil
+}
+
+// Watch blocks until ctx is done, calling onChange with the paths that were
+// added, modified or removed. Paths are relative to the watched directory, or
+// joined to the directory as passed to New when several are watched. Bursts
+// of changes, such as an editor saving several files, are reported together
+// once the project has been stable for one interval, or once the first of
+// them has waited the maximum delay.
+func (w *Watcher) Watch(ctx context.Context, onChange func(changed []string)) error {
+	previous, err := w.snapshot()
+	if err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	var (
+		pending      []string
+		pendingSince time.Time
+	)
+	for {
+		var now time.Time
+		select {
+		case <-ctx.Done():
+			return nil
+		case now = <-ticker.C:
+		}
+
+		current, err := w.snapshot()
+		if err != nil {
+			return err
+		}
+
+		changed := diff(previous, current)
+		previous = current
+		if len(changed) > 0 {
+			if len(pending) == 0 {
+				pendingSince = now
+			}
+			pending = append(pending, changed...)
+			if now.Sub(pendingSince) < w.maxDelay {
+				continue
+			}
+		}
+		if len(pending) > 0 {
+			slices.Sort(pending)
+			onChange(slices.Compact(pending))
+			pending = nil
+		}
+	}
+}
+
+func diff(previous, current map[string]fileState) []string {
+	var changed []string
+	for path, state := range current {
+		if prev, ok := previous[path]; !ok || prev != state {
+			changed = append(changed, path)
+		}
+	}
+	for path := range previous {
+		if _, ok := current[path]; !ok {
+			changed = append(changed, path)
+		}
+	}
+	return changed
+}
+
+func (w *Watcher) snapshot() (map[string]fileState, error) {
+	files := map[string]fileState{}
+	for _, r := range w.roots {
+		if err := w.scan(r, files); err != nil {
+			return nil, err
+		}
+	}
+	return files, nil
+}
+
+func (w *Watcher) scan(r root, files map[string]fileState) error {
+	var patterns []gitignore.Pattern
+
+	return filepath.WalkDir(r.abs, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			// Files can disappear between listing a directory and visiting
+			// them; the next scan will pick up the removal.
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+
+		rel, err := filepath.Rel(r.abs, path)
+		if err != nil {
+			return err
+		}
+		var parts []string
+		if rel != "." {
+			parts = strings.Split(filepath.ToSlash(rel), "/")
+		}
+
+		if d.IsDir() {
+			if d.Name() == ".git" || w.isIgnoredPath(path) ||
+				(len(parts) > 0 && gitignore.NewMatcher(patterns).Match(parts, true)) {
+				return filepath.SkipDir
+			}
+			dirPatterns, err := readIgnoreFile(filepath.Join(path, ".gitignore"), parts)
+			if err != nil {
+				return err
+			}
+			patterns = append(patterns, dirPatterns...)
+			return nil
+		}
+
+		if w.isIgnoredPath(path) || gitignore.NewMatcher(patterns).Match(parts, false) {
+			return nil
+		}
+
+		info, err := d.Info()
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		files[filepath.ToSlash(filepath.Join(r.name, rel))] = fileState{modTime: info.ModTime(), size: info.Size()}
+		return nil
+	})
+}
+
+func (w *Watcher) isIgnoredPath(path string) bool {
+	return slices.ContainsFunc(w.ignored, func(ignored string) bool {
+		return isWithin(path, ignored)
+	})
+}
+
+// isWithin reports whether path is dir or inside it.
+func isWithin(path, dir string) bool {
+	return path == dir || strings.HasPrefix(path, dir+string(filepath.Separator))
+}
+
+func readIgnoreFile(path string, domain []string) ([]gitignore.Pattern, error) {
+	f, err := os.Open(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var patterns []gitignore.Pattern
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), " \t\r")
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		patterns = append(patterns, gitignore.ParsePattern(line, domain))
+	}
+	return patterns, scanner.Err()
+}
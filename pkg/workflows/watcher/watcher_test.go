@@ -0,0 +1,143 @@
+package watcher_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/render-oss/cli/pkg/workflows/watcher"
+	"github.com/stretchr/testify/require"
+)
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+}
+
+func TestWatch(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, ".gitignore"), "*.pyc\nbuild/\n")
+	writeFile(t, filepath.Join(dir, "main.py"), "print('hi')")
+	writeFile(t, filepath.Join(dir, "sub", ".gitignore"), "local.txt\n")
+
+	w, err := watcher.New([]string{dir}, watcher.WithInterval(10*time.Millisecond), watcher.WithIgnoredPaths(filepath.Join(dir, "state")))
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	changes := make(chan []string, 10)
+	go func() {
+		_ = w.Watch(ctx, func(changed []string) {
+			changes <- changed
+		})
+	}()
+	// Let the watcher take its initial snapshot.
+	time.Sleep(50 * time.Millisecond)
+
+	// None of these should be reported.
+	writeFile(t, filepath.Join(dir, "main.pyc"), "bytecode")
+	writeFile(t, filepath.Join(dir, "build", "out.js"), "built")
+	writeFile(t, filepath.Join(dir, "sub", "local.txt"), "local")
+	writeFile(t, filepath.Join(dir, "state", "task-runs.jsonl"), "{}")
+	writeFile(t, filepath.Join(dir, ".git", "HEAD"), "ref")
+
+	writeFile(t, filepath.Join(dir, "main.py"), "print('hello')")
+	writeFile(t, filepath.Join(dir, "sub", "tasks.py"), "def task(): pass")
+
+	select {
+	case changed := <-changes:
+		require.Equal(t, []string{"main.py", "sub/tasks.py"}, changed)
+	case <-time.After(3 * time.Second):
+		t.Fatal("expected a change notification")
+	}
+
+	require.NoError(t, os.Remove(filepath.Join(dir, "sub", "tasks.py")))
+
+	select {
+	case changed := <-changes:
+		require.Equal(t, []string{"sub/tasks.py"}, changed)
+	case <-time.After(3 * time.Second):
+		t.Fatal("expected a removal notification")
+	}
+}
+
+func TestWatchMaxDelay(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "main.py"), "print('hi')")
+
+	w, err := watcher.New([]string{dir}, watcher.WithInterval(10*time.Millisecond), watcher.WithMaxDelay(100*time.Millisecond))
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	changes := make(chan []string, 10)
+	go func() {
+		_ = w.Watch(ctx, func(changed []string) {
+			changes <- changed
+		})
+	}()
+	time.Sleep(50 * time.Millisecond)
+
+	// Keep rewriting a file on every scan so the project never settles.
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		for i := 0; ctx.Err() == nil; i++ {
+			_ = os.WriteFile(filepath.Join(dir, "out.log"), []byte(strings.Repeat("x", i)), 0o644)
+			time.Sleep(5 * time.Millisecond)
+		}
+	}()
+	defer func() {
+		cancel()
+		<-stopped
+	}()
+	writeFile(t, filepath.Join(dir, "main.py"), "print('hello')")
+
+	select {
+	case changed := <-changes:
+		require.Contains(t, changed, "main.py")
+	case <-time.After(3 * time.Second):
+		t.Fatal("expected a change notification despite constant changes")
+	}
+}
+
+func TestWatchMultipleDirs(t *testing.T) {
+	base := t.TempDir()
+	t.Chdir(base)
+	writeFile(t, filepath.Join(base, "api", "main.py"), "print('hi')")
+	writeFile(t, filepath.Join(base, "api", "lib", "util.py"), "")
+	writeFile(t, filepath.Join(base, "worker", "main.go"), "package main")
+	writeFile(t, filepath.Join(base, "other", "notes.txt"), "")
+
+	// api/lib is inside api, so it's only watched once.
+	w, err := watcher.New([]string{"api", "worker", "api/lib"}, watcher.WithInterval(10*time.Millisecond))
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	changes := make(chan []string, 10)
+	go func() {
+		_ = w.Watch(ctx, func(changed []string) {
+			changes <- changed
+		})
+	}()
+	time.Sleep(50 * time.Millisecond)
+
+	writeFile(t, filepath.Join(base, "other", "notes.txt"), "not watched")
+	writeFile(t, filepath.Join(base, "api", "lib", "util.py"), "def util(): pass")
+	writeFile(t, filepath.Join(base, "worker", "main.go"), "package main\n")
+
+	select {
+	case changed := <-changes:
+		require.Equal(t, []string{"api/lib/util.py", "worker/main.go"}, changed)
+	case <-time.After(3 * time.Second):
+		t.Fatal("expected a change notification")
+	}
+}
@@ -0,0 +1,451 @@
+// Dashboard for the local workflow development server. Everything here goes
+// through the same /v1 API the CLI uses with --local.
+"use strict";
+
+const state = {
+  tasks: [],
+  taskNames: new Map(),
+  runs: [],
+  selectedTaskId: null,
+  selectedRunId: null,
+  logSocket: null,
+  logRunId: null,
+  events: null,
+  eventRunIds: "",
+};
+
+const $ = (id) => document.getElementById(id);
+
+function el(tag, attrs = {}, ...children) {
+  const node = document.createElement(tag);
+  for (const [key, value] of Object.entries(attrs)) {
+    if (key === "class") {
+      node.className = value;
+    } else if (key === "style") {
+      Object.assign(node.style, value);
+    } else if (key.startsWith("on")) {
+      node.addEventListener(key.slice(2), value);
+    } else {
+      node.setAttribute(key, value);
+    }
+  }
+  for (const child of children) {
+    if (child !== null && child !== undefined) {
+      node.append(child);
+    }
+  }
+  return node;
+}
+
+async function api(path, options = {}) {
+  const resp = await fetch("/v1" + path, {
+    ...options,
+    headers: options.body ? { "Content-Type": "application/json" } : {},
+  });
+  if (!resp.ok) {
+    let message = resp.statusText;
+    try {
+      const body = await resp.json();
+      message = body.message || message;
+    } catch (_) {
+      // Not a JSON error body.
+    }
+    throw new Error(message);
+  }
+  return { resp, body: resp.status === 204 ? null : await resp.json() };
+}
+
+function setConnection(ok, message) {
+  const node = $("connection");
+  node.textContent = message;
+  node.className = "connection " + (ok ? "ok" : "down");
+}
+
+// Formatting
+
+function statusBadge(status) {
+  return el("span", { class: "status status-" + status }, status);
+}
+
+function taskName(taskId) {
+  return state.taskNames.get(taskId) || taskId || "(unregistered task)";
+}
+
+function formatTime(value) {
+  return value ? new Date(value).toLocaleTimeString() : "";
+}
+
+function formatDuration(ms) {
+  if (ms < 1000) {
+    return Math.round(ms) + "ms";
+  }
+  const seconds = ms / 1000;
+  if (seconds < 60) {
+    return seconds.toFixed(seconds < 10 ? 2 : 1) + "s";
+  }
+  const minutes = Math.floor(seconds / 60);
+  return minutes + "m" + Math.round(seconds % 60) + "s";
+}
+
+function runDuration(run, now) {
+  if (!run.startedAt) {
+    return "";
+  }
+  const end = run.completedAt ? new Date(run.completedAt) : now;
+  return formatDuration(end - new Date(run.startedAt));
+}
+
+function attemptCount(run) {
+  const attempts = (run.attempts || []).length;
+  return attempts === 0 && run.startedAt ? 1 : attempts;
+}
+
+function isRoot(run) {
+  return !run.parentTaskRunId || run.parentTaskRunId === run.id;
+}
+
+function pretty(value) {
+  return JSON.stringify(value, null, 2);
+}
+
+// Tasks
+
+async function loadTasks() {
+  const { body } = await api("/tasks");
+  state.tasks = body.map((item) => item.task);
+  state.taskNames = new Map(state.tasks.map((task) => [task.id, task.name]));
+  renderTasks();
+}
+
+function renderTasks() {
+  const list = $("tasks");
+  list.replaceChildren(
+    ...state.tasks.map((task) =>
+      el(
+        "li",
+        {
+          class: task.id === state.selectedTaskId ? "selected" : "",
+          title: task.id,
+          onclick: () => selectTask(task.id === state.selectedTaskId ? null : task.id),
+        },
+        task.name,
+      ),
+    ),
+  );
+  if (state.tasks.length === 0) {
+    list.append(el("li", { class: "muted" }, "No tasks registered"));
+  }
+}
+
+function selectTask(taskId) {
+  state.selectedTaskId = taskId;
+  $("run-form").hidden = taskId === null;
+  $("run-task-name").textContent = taskId ? taskName(taskId) : "";
+  $("run-error").hidden = true;
+  renderTasks();
+  renderRuns();
+}
+
+async function startRun(event) {
+  event.preventDefault();
+  const errorNode = $("run-error");
+  errorNode.hidden = true;
+
+  let input;
+  try {
+    input = JSON.parse($("run-input").value || "[]");
+  } catch (err) {
+    errorNode.textContent = "Input must be valid JSON: " + err.message;
+    errorNode.hidden = false;
+    return;
+  }
+
+  try {
+    const { body } = await api("/task-runs", {
+      method: "POST",
+      body: JSON.stringify({ task: state.selectedTaskId, input }),
+    });
+    await loadRuns();
+    selectRun(body.id);
+  } catch (err) {
+    errorNode.textContent = err.message;
+    errorNode.hidden = false;
+  }
+}
+
+// Runs
+
+async function loadRuns() {
+  const { resp, body } = await api("/task-runs");
+  state.runs = body.map((item) => item.taskRun);
+  subscribeEvents();
+  const queueDepth = Number(resp.headers.get("X-Queue-Depth") || 0);
+  $("queue-depth").textContent = queueDepth > 0 ? queueDepth + " run(s) waiting for a concurrency slot" : "";
+  renderRuns();
+}
+
+function renderRuns() {
+  const rootsOnly = $("roots-only").checked;
+  const now = new Date();
+  const runs = state.runs
+    .filter((run) => !rootsOnly || isRoot(run))
+    .filter((run) => !state.selectedTaskId || run.taskId === state.selectedTaskId)
+    .sort((a, b) => new Date(b.startedAt || now) - new Date(a.startedAt || now));
+
+  $("runs").tBodies[0].replaceChildren(
+    ...runs.map((run) =>
+      el(
+        "tr",
+        { class: "run" + (run.id === state.selectedRunId ? " selected" : ""), onclick: () => selectRun(run.id) },
+        el("td", { class: "mono", title: run.id }, run.id),
+        el("td", {}, taskName(run.taskId)),
+        el("td", {}, statusBadge(run.status)),
+        el("td", {}, String(attemptCount(run))),
+        el("td", {}, formatTime(run.startedAt)),
+        el("td", {}, runDuration(run, now)),
+      ),
+    ),
+  );
+  $("runs-empty").hidden = runs.length > 0;
+}
+
+// Run detail
+
+async function selectRun(runId) {
+  state.selectedRunId = runId;
+  $("detail-panel").hidden = false;
+  renderRuns();
+  await loadDetail();
+  subscribeLogs(runId);
+}
+
+async function loadDetail() {
+  const runId = state.selectedRunId;
+  if (!runId) {
+    return;
+  }
+  const { body: run } = await api("/task-runs/" + encodeURIComponent(runId));
+  if (runId !== state.selectedRunId) {
+    return;
+  }
+  renderDetail(run);
+
+  const rootId = run.rootTaskRunId || run.id;
+  const { body: treeRuns } = await api("/task-runs?rootTaskRunId=" + encodeURIComponent(rootId));
+  if (runId === state.selectedRunId) {
+    renderTree(rootId, treeRuns.map((item) => item.taskRun));
+  }
+}
+
+function renderDetail(run) {
+  $("detail-title").textContent = taskName(run.taskId) + " · " + run.id;
+  $("cancel").disabled = run.status !== "pending" && run.status !== "running";
+
+  const rows = [
+    ["Status", statusBadge(run.status)],
+    ["Attempts", String(attemptCount(run))],
+    ["Started", run.startedAt ? new Date(run.startedAt).toLocaleString() : ""],
+    ["Completed", run.completedAt ? new Date(run.completedAt).toLocaleString() : ""],
+    ["Duration", runDuration(run, new Date())],
+    ["Input", el("pre", { class: "mono" }, pretty(run.input))],
+  ];
+  if (!isRoot(run)) {
+    rows.splice(1, 0, ["Parent", el("a", { href: "#", onclick: (e) => { e.preventDefault(); selectRun(run.parentTaskRunId); } }, run.parentTaskRunId)]);
+  }
+  if (run.rerunOfTaskRunId) {
+    rows.splice(1, 0, ["Re-run of", el("a", { href: "#", onclick: (e) => { e.preventDefault(); selectRun(run.rerunOfTaskRunId); } }, run.rerunOfTaskRunId)]);
+  }
+  if (run.results) {
+    rows.push(["Results", el("pre", { class: "mono" }, pretty(run.results))]);
+  }
+  if (run.error) {
+    rows.push(["Error", el("pre", { class: "mono error" }, run.error)]);
+  }
+
+  $("detail").replaceChildren(...rows.flatMap(([key, value]) => [el("dt", {}, key), el("dd", {}, value)]));
+}
+
+function renderTree(rootId, runs) {
+  const byId = new Map(runs.map((run) => [run.id, { run, children: [] }]));
+  const root = byId.get(rootId);
+  if (!root) {
+    $("tree").replaceChildren(el("p", { class: "muted" }, "Root run not found"));
+    return;
+  }
+  for (const node of byId.values()) {
+    if (node === root) {
+      continue;
+    }
+    const parent = byId.get(node.run.parentTaskRunId) || root;
+    parent.children.push(node);
+  }
+
+  const now = new Date();
+  let start = Infinity;
+  let end = -Infinity;
+  for (const { run } of byId.values()) {
+    if (!run.startedAt) {
+      continue;
+    }
+    start = Math.min(start, new Date(run.startedAt));
+    end = Math.max(end, run.completedAt ? new Date(run.completedAt) : now);
+  }
+  const span = end > start ? end - start : 1;
+  const percent = (t) => ((new Date(t) - start) / span) * 100;
+
+  const rows = [];
+  const visit = (node, depth) => {
+    const { run } = node;
+    const timeline = el("div", { class: "timeline", title: runDuration(run, now) });
+    const attempts = run.attempts && run.attempts.length > 0
+      ? run.attempts
+      : run.startedAt ? [{ startedAt: run.startedAt, completedAt: run.completedAt, status: run.status }] : [];
+    attempts.forEach((attempt, i) => {
+      const left = percent(attempt.startedAt);
+      const right = percent(attempt.completedAt || now);
+      const retried = i < attempts.length - 1 ? " retried" : "";
+      timeline.append(el("div", {
+        class: "attempt status-" + attempt.status + retried,
+        title: "attempt " + (i + 1) + ": " + attempt.status,
+        style: { left: left + "%", width: Math.max(right - left, 0) + "%" },
+      }));
+    });
+
+    rows.push(el(
+      "div",
+      { class: "tree-row" + (run.id === state.selectedRunId ? " selected" : ""), onclick: () => selectRun(run.id) },
+      el("div", { class: "tree-label mono", style: { paddingLeft: depth * 16 + "px" } },
+        (depth > 0 ? "└ " : "") + run.id,
+        el("span", { class: "task" }, taskName(run.taskId))),
+      statusBadge(run.status),
+      timeline,
+    ));
+
+    node.children
+      .sort((a, b) => new Date(a.run.startedAt || now) - new Date(b.run.startedAt || now))
+      .forEach((child) => visit(child, depth + 1));
+  };
+  visit(root, 0);
+
+  $("tree").replaceChildren(...rows);
+}
+
+async function cancelRun() {
+  try {
+    await api("/task-runs/" + encodeURIComponent(state.selectedRunId), { method: "DELETE" });
+    await Promise.all([loadRuns(), loadDetail()]);
+  } catch (err) {
+    alert("Failed to cancel task run: " + err.message);
+  }
+}
+
+async function rerunRun() {
+  try {
+    const { body } = await api("/task-runs/" + encodeURIComponent(state.selectedRunId) + "/rerun", {
+      method: "POST",
+      body: "{}",
+    });
+    await loadRuns();
+    selectRun(body.id);
+  } catch (err) {
+    alert("Failed to re-run task run: " + err.message);
+  }
+}
+
+// Logs
+
+function subscribeLogs(runId) {
+  if (state.logRunId === runId) {
+    return;
+  }
+  if (state.logSocket) {
+    state.logSocket.close();
+  }
+  state.logRunId = runId;
+  const logs = $("logs");
+  logs.replaceChildren();
+
+  // A start time makes the server replay earlier logs before streaming new
+  // ones, without sending any twice.
+  const params = new URLSearchParams({ taskRunID: runId, startTime: "1970-01-01T00:00:00Z" });
+  const scheme = location.protocol === "https:" ? "wss:" : "ws:";
+  const socket = new WebSocket(scheme + "//" + location.host + "/v1/logs/subscribe?" + params);
+  socket.onmessage = (event) => {
+    const log = JSON.parse(event.data);
+    const atBottom = logs.scrollTop + logs.clientHeight >= logs.scrollHeight - 4;
+    logs.append(
+      el("span", { class: "timestamp" }, new Date(log.timestamp).toLocaleTimeString() + " "),
+      log.message.endsWith("\n") ? log.message : log.message + "\n",
+    );
+    if (atBottom) {
+      logs.scrollTop = logs.scrollHeight;
+    }
+  };
+  state.logSocket = socket;
+}
+
+// Events
+
+function isFinished(run) {
+  return run.status === "completed" || run.status === "failed" || run.status === "canceled";
+}
+
+// subscribeEvents keeps one event stream open for the runs that haven't
+// finished, and refreshes whenever one of them does. The server replays runs
+// that finished before the stream opened, so none are missed.
+function subscribeEvents() {
+  const ids = state.runs.filter((run) => !isFinished(run)).map((run) => run.id).sort().join(",");
+  if (ids === state.eventRunIds) {
+    return;
+  }
+  if (state.events) {
+    state.events.close();
+    state.events = null;
+  }
+  state.eventRunIds = ids;
+  if (!ids) {
+    return;
+  }
+
+  const events = new EventSource("/v1/task-runs/events?" + new URLSearchParams({ taskRunIds: ids }));
+  events.addEventListener("task.completed", refresh);
+  events.onopen = () => setConnection(true, "connected");
+  // EventSource reconnects on its own.
+  events.onerror = () => setConnection(false, "disconnected: reconnecting");
+  state.events = events;
+}
+
+async function refresh() {
+  try {
+    await loadRuns();
+    // Reload the selected run even once it has finished, since its subtasks
+    // may still be running.
+    await loadDetail();
+    setConnection(true, "connected");
+  } catch (err) {
+    setConnection(false, "disconnected: " + err.message);
+  }
+}
+
+async function init() {
+  $("run-form").addEventListener("submit", startRun);
+  $("roots-only").addEventListener("change", renderRuns);
+  $("cancel").addEventListener("click", cancelRun);
+  $("rerun").addEventListener("click", rerunRun);
+  // Listing tasks makes the dev server start the workflow service to
+  // register them, so tasks are only reloaded on request.
+  $("reload-tasks").addEventListener("click", () =>
+    loadTasks().catch((err) => setConnection(false, "failed to load tasks: " + err.message)));
+
+  try {
+    await loadTasks();
+  } catch (err) {
+    setConnection(false, "failed to load tasks: " + err.message);
+  }
+  await refresh();
+  // Only runs already listed are streamed, so pick up runs started from the
+  // CLI when the tab comes back into focus.
+  window.addEventListener("focus", refresh);
+}
+
+init();
@@ -0,0 +1,23 @@
+// Package webui embeds the browser dashboard served by the local workflow
+// development server. The dashboard is a static single-page app that talks to
+// the dev server's /v1 API, so it needs no build step.
+package webui
+
+import (
+	"embed"
+	"io/fs"
+	"net/http"
+)
+
+//go:embed static
+var static embed.FS
+
+// Handler serves the dashboard's files, with index.html at /.
+func Handler() http.Handler {
+	files, err := fs.Sub(static, "static")
+	if err != nil {
+		// The embedded directory is fixed at build time, so this can't happen.
+		panic(err)
+	}
+	return http.FileServerFS(files)
+}
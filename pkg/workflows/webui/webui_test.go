@@ -0,0 +1,35 @@
+package webui_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/render-oss/cli/pkg/workflows/webui"
+)
+
+func TestHandler(t *testing.T) {
+	srv := httptest.NewServer(webui.Handler())
+	defer srv.Close()
+
+	for path, contentType := range map[string]string{
+		"/":          "text/html",
+		"/app.js":    "text/javascript",
+		"/style.css": "text/css",
+	} {
+		resp, err := http.Get(srv.URL + path)
+		require.NoError(t, err)
+		resp.Body.Close()
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode, path)
+		assert.Contains(t, resp.Header.Get("Content-Type"), contentType, path)
+	}
+
+	resp, err := http.Get(srv.URL + "/missing")
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}